@@ -40,7 +40,10 @@ func run() error {
 		return fmt.Errorf("failed to run database migrations: %w", err)
 	}
 
-	server := server.New(conf)
+	server, err := server.New(conf)
+	if err != nil {
+		return fmt.Errorf("failed to create server: %w", err)
+	}
 
 	app, err := application.New(ctx, conf)
 	if err != nil {
@@ -0,0 +1,234 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type repository struct {
+	pool *pgxpool.Pool
+	q    *Queries
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) repository {
+	return repository{
+		pool: pool,
+		q:    New(pool),
+	}
+}
+
+func (r repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		case "P0002":
+			return fmt.Errorf("%w: %v", adapterrors.ErrConflict, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := r.q.WithTx(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
+func (r repository) CreateOrganization(ctx context.Context, o *model.Organization) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		err := q.createOrganization(ctx, createOrganizationParams{
+			ID:   o.ID,
+			Name: o.Name,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		for userID, role := range o.Members {
+			err = q.saveOrganizationMember(ctx, saveOrganizationMemberParams{
+				OrganizationID: o.ID,
+				UserID:         userID,
+				Role:           role.String(),
+			})
+			if err != nil {
+				return r.errorDecoder(err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r repository) RetrieveOrganization(ctx context.Context, id uuid.UUID) (*model.Organization, error) {
+	o, err := r.q.organizationById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	members, err := r.q.organizationMembers(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	return o.domain(members), nil
+}
+
+func (r repository) SaveOrganizationMember(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	userID uuid.UUID,
+	role model.Role,
+) error {
+	err := r.q.saveOrganizationMember(ctx, saveOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role.String(),
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) DeleteOrganizationMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) error {
+	err := r.q.deleteOrganizationMember(ctx, deleteOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) SaveProjectMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID, role model.Role) error {
+	err := r.q.saveProjectMember(ctx, saveProjectMemberParams{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      role.String(),
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) DeleteProjectMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error {
+	deleted, err := r.q.deleteProjectMember(ctx, deleteProjectMemberParams{
+		ProjectID: projectID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: project member %s", adapterrors.ErrNotFound, userID)
+	}
+	return nil
+}
+
+func (r repository) ProjectRoles(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) ([]model.Role, error) {
+	roles, err := r.q.projectRoles(ctx, projectRolesParams{
+		ProjectID: projectID,
+		UserID:    userID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainRoles := make([]model.Role, len(roles))
+	for i, role := range roles {
+		domainRoles[i] = model.Role(role)
+	}
+	return domainRoles, nil
+}
+
+func (r repository) ProjectHasWorkflow(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) (bool, error) {
+	exists, err := r.q.projectHasWorkflow(ctx, projectHasWorkflowParams{
+		ProjectID: projectID,
+		ID:        workflowID.String(),
+	})
+	if err != nil {
+		return false, r.errorDecoder(err)
+	}
+	return exists, nil
+}
+
+func (r repository) ListOrganizations(ctx context.Context, userID uuid.UUID) ([]query.Organization, error) {
+	organizations, err := r.q.organizationsByMember(ctx, userID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]query.Organization, len(organizations))
+	for i, o := range organizations {
+		result[i] = o.query()
+	}
+	return result, nil
+}
+
+func (r repository) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]query.Member, error) {
+	members, err := r.q.organizationMemberUsers(ctx, organizationID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]query.Member, len(members))
+	for i, m := range members {
+		result[i] = m.query()
+	}
+	return result, nil
+}
+
+func (r repository) ListProjectMembers(ctx context.Context, projectID uuid.UUID) ([]query.Member, error) {
+	members, err := r.q.projectMemberUsers(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]query.Member, len(members))
+	for i, m := range members {
+		result[i] = m.query()
+	}
+	return result, nil
+}
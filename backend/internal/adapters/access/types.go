@@ -0,0 +1,50 @@
+package access
+
+import (
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (o Organization) domain(members []OrganizationMember) *model.Organization {
+	roles := make(map[uuid.UUID]model.Role, len(members))
+	for _, m := range members {
+		roles[m.UserID] = model.Role(m.Role)
+	}
+
+	return &model.Organization{
+		ID:      o.ID,
+		Name:    o.Name,
+		Members: roles,
+	}
+}
+
+func (o organizationsByMemberRow) query() query.Organization {
+	return query.Organization{
+		ID:        o.ID,
+		Name:      o.Name,
+		Role:      model.Role(o.Role),
+		CreatedAt: o.CreatedAt.Time,
+		UpdatedAt: o.UpdatedAt.Time,
+	}
+}
+
+func (m organizationMemberUsersRow) query() query.Member {
+	return query.Member{
+		UserID:    m.UserID,
+		Email:     m.Email,
+		Name:      m.Name,
+		Role:      model.Role(m.Role),
+		CreatedAt: m.CreatedAt.Time,
+	}
+}
+
+func (m projectMemberUsersRow) query() query.Member {
+	return query.Member{
+		UserID:    m.UserID,
+		Email:     m.Email,
+		Name:      m.Name,
+		Role:      model.Role(m.Role),
+		CreatedAt: m.CreatedAt.Time,
+	}
+}
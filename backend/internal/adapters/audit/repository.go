@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+// Repository appends the audit events to the audit log, which rejects
+// any update or deletion of the recorded events.
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) Record(ctx context.Context, event model.AuditEvent) error {
+	params, err := insertParams(event)
+	if err != nil {
+		return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+	}
+
+	if err = r.queries.insertAuditEvent(ctx, params); err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ListAuditEvents(
+	ctx context.Context,
+	filter query.AuditEventFilter,
+	limit int,
+) ([]query.AuditEvent, error) {
+	rows, err := r.queries.auditEvents(ctx, auditEventsParams{
+		ProjectID: pgUUID(filter.ProjectID),
+		Action:    text(filter.Action),
+		Resource:  text(filter.Resource),
+		ActorID:   pgUUID(filter.ActorID),
+		FromAt:    timestamptz(filter.From),
+		ToAt:      timestamptz(filter.To),
+		BeforeID:  pgUUID(filter.Before),
+		RowLimit:  int32(limit), //nolint:gosec // bounded by the queries
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	events := make([]query.AuditEvent, len(rows))
+	for i, row := range rows {
+		events[i], err = row.query()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", adapterrors.ErrUnmarshal, err.Error())
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func insertParams(event model.AuditEvent) (insertAuditEventParams, error) {
+	before, err := marshalFields(event.Before)
+	if err != nil {
+		return insertAuditEventParams{}, err
+	}
+	after, err := marshalFields(event.After)
+	if err != nil {
+		return insertAuditEventParams{}, err
+	}
+	details, err := marshalFields(event.Details)
+	if err != nil {
+		return insertAuditEventParams{}, err
+	}
+
+	return insertAuditEventParams{
+		ID:         event.ID,
+		ProjectID:  pgUUID(event.ProjectID),
+		ActorType:  event.ActorType,
+		ActorID:    pgUUID(event.ActorID),
+		IpAddress:  event.IPAddress,
+		Action:     event.Action,
+		Resource:   event.Resource,
+		ResourceID: event.ResourceID,
+		Before:     before,
+		After:      after,
+		Details:    details,
+		OccurredAt: timestamptz(&event.OccurredAt),
+	}, nil
+}
+
+func (e AuditEvent) query() (query.AuditEvent, error) {
+	before, err := unmarshalFields(e.Before)
+	if err != nil {
+		return query.AuditEvent{}, err
+	}
+	after, err := unmarshalFields(e.After)
+	if err != nil {
+		return query.AuditEvent{}, err
+	}
+	details, err := unmarshalFields(e.Details)
+	if err != nil {
+		return query.AuditEvent{}, err
+	}
+
+	return query.AuditEvent{
+		ID:         e.ID,
+		ProjectID:  uuidPtr(e.ProjectID),
+		ActorType:  e.ActorType,
+		ActorID:    uuidPtr(e.ActorID),
+		IPAddress:  e.IpAddress,
+		Action:     e.Action,
+		Resource:   e.Resource,
+		ResourceID: e.ResourceID,
+		Before:     before,
+		After:      after,
+		Details:    details,
+		OccurredAt: e.OccurredAt.Time,
+	}, nil
+}
+
+// marshalFields stores nil fields as NULL rather than a JSON null.
+func marshalFields(fields map[string]any) ([]byte, error) {
+	if fields == nil {
+		return nil, nil
+	}
+	return json.Marshal(fields)
+}
+
+func unmarshalFields(raw []byte) (map[string]any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func pgUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func uuidPtr(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func text(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
@@ -0,0 +1,347 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := New(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) CreateBatch(ctx context.Context, batch *model.Batch) error {
+	indexes := make([]int32, len(batch.Items))
+	triggerIDs := make([]uuid.UUID, len(batch.Items))
+	inputs := make([][]byte, len(batch.Items))
+	for i, item := range batch.Items {
+		in, err := json.Marshal(item.Inputs)
+		if err != nil {
+			return err
+		}
+
+		indexes[i] = int32(item.Index) //nolint:gosec // bounded by the batch size
+		triggerIDs[i] = item.TriggerID
+		inputs[i] = in
+	}
+
+	return r.withTx(ctx, func(q *Queries) error {
+		err := q.storeBatch(ctx, storeBatchParams{
+			ID:          batch.ID,
+			ProjectID:   batch.ProjectID,
+			WorkflowID:  batch.WorkflowID.String(),
+			Revision:    batch.Revision,
+			Status:      string(batch.Status),
+			Concurrency: int32(batch.Concurrency), //nolint:gosec // bounded by the max concurrency
+			Total:       int32(len(batch.Items)),  //nolint:gosec // bounded by the batch size
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		err = q.storeBatchItems(ctx, storeBatchItemsParams{
+			BatchID:    batch.ID,
+			Indexes:    indexes,
+			TriggerIds: triggerIDs,
+			Inputs:     inputs,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+		return nil
+	})
+}
+
+func (r Repository) ListRunningBatchIDs(ctx context.Context) ([]uuid.UUID, error) {
+	ids, err := r.queries.runningBatchIds(ctx)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return ids, nil
+}
+
+func (r Repository) ClaimBatchItems(
+	ctx context.Context,
+	batchID uuid.UUID,
+	lease time.Duration,
+	timeout time.Duration,
+) (*model.Batch, []*model.BatchItem, error) {
+	var (
+		batch *model.Batch
+		items []*model.BatchItem
+	)
+
+	err := r.withTx(ctx, func(q *Queries) error {
+		b, err := q.lockRunningBatch(ctx, batchID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return r.errorDecoder(err)
+		}
+
+		now := time.Now()
+		claimedBefore := now.Add(-lease)
+		_, err = q.requeueBatchItems(ctx, requeueBatchItemsParams{
+			BatchID:       batchID,
+			ClaimedBefore: timestamptz(&claimedBefore),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		queuedBefore := now.Add(-timeout)
+		timedOut, err := q.timeoutBatchItems(ctx, timeoutBatchItemsParams{
+			BatchID:      batchID,
+			Error:        "workflow did not finish in " + timeout.String(),
+			QueuedBefore: timestamptz(&queuedBefore),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		if timedOut > 0 {
+			err = q.countBatchItem(ctx, countBatchItemParams{
+				Succeeded: 0,
+				Failed:    int32(timedOut), //nolint:gosec // bounded by the max concurrency
+				ID:        batchID,
+			})
+			if err != nil {
+				return r.errorDecoder(err)
+			}
+
+			// the batch may be completed by the items failed
+			b, err = q.lockRunningBatch(ctx, batchID)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return nil
+				}
+				return r.errorDecoder(err)
+			}
+		}
+
+		batch = b.domain()
+		running, err := q.countRunningBatchItems(ctx, batchID)
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		slots := batch.Slots(int(running))
+		if slots == 0 {
+			return nil
+		}
+
+		claimed, err := q.claimBatchItems(ctx, claimBatchItemsParams{
+			BatchID: batchID,
+			Limit:   int32(slots), //nolint:gosec // bounded by the max concurrency
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		items = make([]*model.BatchItem, len(claimed))
+		for i, item := range claimed {
+			items[i], err = item.domain()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return batch, items, nil
+}
+
+func (r Repository) MarkBatchItemQueued(ctx context.Context, triggerID uuid.UUID) error {
+	if err := r.queries.markBatchItemQueued(ctx, triggerID); err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveBatchItem(ctx context.Context, triggerID uuid.UUID) (*model.BatchItem, error) {
+	item, err := r.queries.batchItemByTriggerId(ctx, triggerID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return item.domain()
+}
+
+func (r Repository) FinishBatchItem(ctx context.Context, item *model.BatchItem) error {
+	var result json.RawMessage
+	if item.Result != nil {
+		var err error
+		result, err = json.Marshal(item.Result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.withTx(ctx, func(q *Queries) error {
+		finished, err := q.finishBatchItem(ctx, finishBatchItemParams{
+			TriggerID:  item.TriggerID,
+			Status:     string(item.Status),
+			Result:     result,
+			Error:      item.Error,
+			FinishedAt: timestamptz(item.FinishedAt),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		if finished == 0 {
+			return nil
+		}
+
+		params := countBatchItemParams{
+			Succeeded: 0,
+			Failed:    0,
+			ID:        item.BatchID,
+		}
+		if item.Status == model.BatchItemSucceeded {
+			params.Succeeded = 1
+		} else {
+			params.Failed = 1
+		}
+
+		if err = q.countBatchItem(ctx, params); err != nil {
+			return r.errorDecoder(err)
+		}
+		return nil
+	})
+}
+
+func (r Repository) ReadBatch(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (query.Batch, error) {
+	batch, err := r.queries.batchById(ctx, id)
+	if err != nil {
+		return query.Batch{}, r.errorDecoder(err)
+	}
+
+	if batch.ProjectID != projectID {
+		return query.Batch{}, fmt.Errorf("%w: batch %s", adapterrors.ErrNotFound, id)
+	}
+
+	return r.queryBatch(ctx, batch)
+}
+
+func (r Repository) ListBatches(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) ([]query.Batch, error) {
+	batches, err := r.queries.batchesByWorkflowId(ctx, batchesByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryBatches := make([]query.Batch, len(batches))
+	for i, batch := range batches {
+		queryBatches[i], err = r.queryBatch(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryBatches, nil
+}
+
+func (r Repository) ListBatchItems(ctx context.Context, batchID uuid.UUID) ([]query.BatchItem, error) {
+	items, err := r.queries.batchItemsByBatchId(ctx, batchID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryItems := make([]query.BatchItem, len(items))
+	for i, item := range items {
+		queryItems[i], err = item.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryItems, nil
+}
+
+func (r Repository) queryBatch(ctx context.Context, batch Batch) (query.Batch, error) {
+	var running int64
+	if batch.Status == string(model.BatchRunning) {
+		var err error
+		running, err = r.queries.countRunningBatchItems(ctx, batch.ID)
+		if err != nil {
+			return query.Batch{}, r.errorDecoder(err)
+		}
+	}
+	return batch.query(int(running)), nil
+}
@@ -0,0 +1,110 @@
+package batch
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (b Batch) domain() *model.Batch {
+	return &model.Batch{
+		ID:          b.ID,
+		ProjectID:   b.ProjectID,
+		WorkflowID:  model.WorkflowID(b.WorkflowID),
+		Revision:    b.Revision,
+		Status:      model.BatchStatus(b.Status),
+		Concurrency: int(b.Concurrency),
+		Items:       nil,
+	}
+}
+
+func (b Batch) query(running int) query.Batch {
+	return query.Batch{
+		ID:          b.ID,
+		WorkflowID:  b.WorkflowID,
+		Status:      b.Status,
+		Concurrency: int(b.Concurrency),
+		Total:       int(b.Total),
+		Succeeded:   int(b.Succeeded),
+		Failed:      int(b.Failed),
+		Running:     running,
+		CompletedAt: timePtr(b.CompletedAt),
+		CreatedAt:   b.CreatedAt.Time,
+		UpdatedAt:   b.UpdatedAt.Time,
+	}
+}
+
+func (i BatchItem) domain() (*model.BatchItem, error) {
+	var inputs map[string]any
+	if err := json.Unmarshal(i.Inputs, &inputs); err != nil {
+		return nil, err
+	}
+
+	result, err := unmarshalResult(i.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.BatchItem{
+		BatchID:    i.BatchID,
+		Index:      int(i.Idx),
+		TriggerID:  i.TriggerID,
+		Inputs:     inputs,
+		Status:     model.BatchItemStatus(i.Status),
+		Result:     result,
+		Error:      i.Error,
+		FinishedAt: timePtr(i.FinishedAt),
+	}, nil
+}
+
+func (i BatchItem) query() (query.BatchItem, error) {
+	var inputs map[string]any
+	if err := json.Unmarshal(i.Inputs, &inputs); err != nil {
+		return query.BatchItem{}, err
+	}
+
+	result, err := unmarshalResult(i.Result)
+	if err != nil {
+		return query.BatchItem{}, err
+	}
+
+	return query.BatchItem{
+		Index:      int(i.Idx),
+		TriggerID:  i.TriggerID,
+		Inputs:     inputs,
+		Status:     i.Status,
+		Result:     result,
+		Error:      i.Error,
+		StartedAt:  timePtr(i.StartedAt),
+		FinishedAt: timePtr(i.FinishedAt),
+	}, nil
+}
+
+func unmarshalResult(raw json.RawMessage) (any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var result any
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
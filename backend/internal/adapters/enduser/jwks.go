@@ -0,0 +1,273 @@
+package enduser
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/supallm/core/internal/adapters/webhook"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	fetchTimeout = 10 * time.Second
+	keysTTL      = 10 * time.Minute
+	minRefresh   = time.Minute
+	maxKeysSize  = 1 << 20
+)
+
+type (
+	// jwk is a public key of a JSON Web Key Set.
+	jwk struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+
+	// keySet is the last set fetched from an issuer, fetchedAt is also
+	// bumped by the failed fetches so an issuer down is not retried at once.
+	keySet struct {
+		keys      map[string]crypto.PublicKey
+		fetchedAt time.Time
+	}
+
+	// keyCache fetches the key sets published by the auth providers
+	// and keeps them for keysTTL, or until an unknown key shows up.
+	// Concurrent fetches of a set are merged into one.
+	keyCache struct {
+		client *http.Client
+		group  singleflight.Group
+
+		mu   sync.Mutex
+		sets map[string]keySet
+	}
+)
+
+// newKeyCache fetches the key sets with the webhook guard, the JWKS URLs
+// are given by the projects and must not reach the internal network.
+func newKeyCache(allowPrivateNetworks bool) *keyCache {
+	return &keyCache{
+		client: webhook.NewGuardedClient(fetchTimeout, allowPrivateNetworks),
+		group:  singleflight.Group{},
+		mu:     sync.Mutex{},
+		sets:   make(map[string]keySet),
+	}
+}
+
+// key returns the key identified by kid in the set published at url,
+// the only key of the set when kid is empty. A kid missing from the cached
+// set is taken as a key rotation and the set is fetched again, at most once
+// per minRefresh so tokens with unknown kids do not flood the issuer.
+// The cached set is kept when the issuer is unreachable.
+func (c *keyCache) key(ctx context.Context, url string, kid string) (crypto.PublicKey, error) {
+	set, ok := c.cached(url)
+	if !ok || set.stale(kid) {
+		// the fetch is shared by the callers waiting on it, it is not
+		// canceled with the request of the one making it
+		refreshed, err, _ := c.group.Do(url, func() (any, error) {
+			return c.refresh(context.WithoutCancel(ctx), url, kid)
+		})
+		if err != nil {
+			return nil, err
+		}
+		set, _ = refreshed.(keySet)
+	}
+
+	return lookupKey(set.keys, kid)
+}
+
+func (c *keyCache) cached(url string) (keySet, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	set, ok := c.sets[url]
+	return set, ok
+}
+
+// refresh fetches the set published at url, unless a fetch that just
+// completed already did.
+func (c *keyCache) refresh(ctx context.Context, url string, kid string) (keySet, error) {
+	set, ok := c.cached(url)
+	if ok && !set.stale(kid) {
+		return set, nil
+	}
+
+	keys, err := c.fetch(ctx, url)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		set.fetchedAt = time.Now()
+		c.sets[url] = set
+		if !ok {
+			return keySet{}, err
+		}
+		slog.Warn("unable to refresh signing keys, using the cached ones", "url", url, "error", err)
+		return set, nil
+	}
+
+	set = keySet{keys: keys, fetchedAt: time.Now()}
+	c.sets[url] = set
+	return set, nil
+}
+
+// stale reports whether the set is expired, or misses kid and was fetched
+// more than minRefresh ago.
+func (s keySet) stale(kid string) bool {
+	if time.Since(s.fetchedAt) > keysTTL {
+		return true
+	}
+
+	_, err := lookupKey(s.keys, kid)
+	return err != nil && time.Since(s.fetchedAt) > minRefresh
+}
+
+func (c *keyCache) fetch(ctx context.Context, url string) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch signing keys: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch signing keys: status %d", res.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.NewDecoder(io.LimitReader(res.Body, maxKeysSize)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("invalid signing keys: %w", err)
+	}
+
+	return publicKeys(set.Keys), nil
+}
+
+// parseKeys reads the static keys of a provider given as JSON Web Keys.
+func parseKeys(raw []map[string]any) (map[string]crypto.PublicKey, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var set []jwk
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid signing keys: %w", err)
+	}
+
+	keys := publicKeys(set)
+	if len(keys) == 0 {
+		return nil, errors.New("no supported signing key")
+	}
+	return keys, nil
+}
+
+func publicKeys(set []jwk) map[string]crypto.PublicKey {
+	keys := make(map[string]crypto.PublicKey, len(set))
+	for _, k := range set {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			// keys of unsupported types are skipped, not the whole set
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys
+}
+
+func lookupKey(keys map[string]crypto.PublicKey, kid string) (crypto.PublicKey, error) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+
+	key, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q not found", kid)
+	}
+	return key, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,194 @@
+package enduser
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/supallm/core/internal/application/domain/model"
+)
+
+// supabaseAudience is the audience of the access tokens of signed in users,
+// the anonymous and service role keys are also tokens of the project.
+const supabaseAudience = "authenticated"
+
+//nolint:gochecknoglobals // signing methods of the tokens issued with published keys
+var asymmetricMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+type claims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// Verifier authenticates end-users with the tokens issued by the auth
+// provider of their project.
+type Verifier struct {
+	keys *keyCache
+}
+
+// NewVerifier fetches the signing keys from public addresses only,
+// unless private networks are allowed.
+func NewVerifier(allowPrivateNetworks bool) *Verifier {
+	return &Verifier{
+		keys: newKeyCache(allowPrivateNetworks),
+	}
+}
+
+func (v *Verifier) Verify(ctx context.Context, provider model.AuthProvider, token string) (*model.EndUser, error) {
+	switch p := provider.(type) {
+	case model.SupabaseAuthProvider:
+		return v.verifySupabase(ctx, p, token)
+	case model.ClerkAuthProvider:
+		return v.verifyClerk(ctx, p, token)
+	case model.OIDCProvider:
+		return v.verifyOIDC(ctx, p.OIDC(), token)
+	default:
+		return nil, fmt.Errorf("unsupported auth provider type: %s", provider.GetType())
+	}
+}
+
+func (v *Verifier) verifySupabase(
+	ctx context.Context,
+	provider model.SupabaseAuthProvider,
+	token string,
+) (*model.EndUser, error) {
+	methods := asymmetricMethods
+	if provider.JWTSecret != "" {
+		methods = append([]string{"HS256"}, asymmetricMethods...)
+	}
+
+	return v.verify(token, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+			return []byte(provider.JWTSecret), nil
+		}
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.key(ctx, provider.KeysURL(), kid)
+	}, jwt.WithValidMethods(methods), jwt.WithIssuer(provider.Issuer()), jwt.WithAudience(supabaseAudience))
+}
+
+func (v *Verifier) verifyClerk(
+	ctx context.Context,
+	provider model.ClerkAuthProvider,
+	token string,
+) (*model.EndUser, error) {
+	url, err := provider.KeysURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// the issuer is checked even when the keys are published elsewhere
+	issuer, err := provider.Issuer()
+	if err != nil {
+		return nil, err
+	}
+
+	return v.verify(token, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.key(ctx, url, kid)
+	}, jwt.WithValidMethods(asymmetricMethods), jwt.WithIssuer(issuer))
+}
+
+// verifyOIDC verifies the tokens of an OpenID Connect issuer and reads the
+// end-user from the claims mapped by the provider.
+func (v *Verifier) verifyOIDC(
+	ctx context.Context,
+	provider model.OIDCAuthProvider,
+	token string,
+) (*model.EndUser, error) {
+	var static map[string]crypto.PublicKey
+	if len(provider.Keys) > 0 {
+		keys, err := parseKeys(provider.Keys)
+		if err != nil {
+			return nil, err
+		}
+		static = keys
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(asymmetricMethods),
+		jwt.WithIssuer(provider.Issuer),
+		jwt.WithExpirationRequired(),
+	}
+	if provider.Audience != "" {
+		options = append(options, jwt.WithAudience(provider.Audience))
+	}
+
+	parsed, err := jwt.ParseWithClaims(token, jwt.MapClaims{}, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		if static != nil {
+			key, err := lookupKey(static, kid)
+			if err == nil || provider.JWKSURL == "" {
+				return key, err
+			}
+		}
+		return v.keys.key(ctx, provider.JWKSURL, kid)
+	}, options...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	c, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok || !parsed.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+
+	names := provider.ClaimNames()
+	id, _ := claim(c, names.UserID).(string)
+	if id == "" {
+		return nil, fmt.Errorf("token has no %s claim", names.UserID)
+	}
+	email, _ := claim(c, names.Email).(string)
+
+	return &model.EndUser{
+		ID:    id,
+		Email: email,
+	}, nil
+}
+
+func (v *Verifier) verify(token string, keyFunc jwt.Keyfunc, options ...jwt.ParserOption) (*model.EndUser, error) {
+	options = append(options, jwt.WithExpirationRequired())
+
+	parsed, err := jwt.ParseWithClaims(token, new(claims), keyFunc, options...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	c, ok := parsed.Claims.(*claims)
+	if !ok || !parsed.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+
+	if c.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	return &model.EndUser{
+		ID:    c.Subject,
+		Email: c.Email,
+	}, nil
+}
+
+// claim returns the claim named name, or found at the dotted path name
+// for nested claims. Namespaced claims often contain dots, so the whole
+// name is looked up first.
+func claim(c jwt.MapClaims, name string) any {
+	if name == "" {
+		return nil
+	}
+	if value, ok := c[name]; ok {
+		return value
+	}
+
+	var value any = map[string]any(c)
+	for _, part := range strings.Split(name, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[part]
+	}
+	return value
+}
@@ -0,0 +1,364 @@
+package enduser
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com/"
+	testAudience = "https://api.example.com"
+)
+
+// testKey signs tokens with a generated key published by the test issuer.
+type testKey struct {
+	kid    string
+	method jwt.SigningMethod
+	signer crypto.Signer
+}
+
+func newRSAKey(t *testing.T, kid string) testKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	return testKey{kid: kid, method: jwt.SigningMethodRS256, signer: key}
+}
+
+func newECKey(t *testing.T, kid string) testKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ec key: %v", err)
+	}
+	return testKey{kid: kid, method: jwt.SigningMethodES256, signer: key}
+}
+
+func (k testKey) jwk() map[string]any {
+	encode := func(i *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(i.Bytes())
+	}
+
+	switch pub := k.signer.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]any{
+			"kid": k.kid,
+			"kty": "RSA",
+			"use": "sig",
+			"n":   encode(pub.N),
+			"e":   encode(big.NewInt(int64(pub.E))),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]any{
+			"kid": k.kid,
+			"kty": "EC",
+			"use": "sig",
+			"crv": "P-256",
+			"x":   encode(pub.X),
+			"y":   encode(pub.Y),
+		}
+	default:
+		return nil
+	}
+}
+
+func (k testKey) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = k.kid
+	signed, err := token.SignedString(k.signer)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+// serveKeys publishes the keys as a JSON Web Key Set.
+func serveKeys(t *testing.T, keys ...testKey) *httptest.Server {
+	t.Helper()
+
+	set := make([]map[string]any, 0, len(keys))
+	for _, key := range keys {
+		set = append(set, key.jwk())
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": set})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":   testIssuer,
+		"aud":   testAudience,
+		"sub":   "user_123",
+		"email": "user@example.com",
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}
+}
+
+func oidcProvider(jwksURL string) model.OIDCAuthProvider {
+	return model.OIDCAuthProvider{
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		JWKSURL:  jwksURL,
+		Keys:     nil,
+		Claims:   model.ClaimMapping{UserID: "", Email: ""},
+	}
+}
+
+func TestVerifyOIDC(t *testing.T) {
+	rsaKey := newRSAKey(t, "rsa-1")
+	ecKey := newECKey(t, "ec-1")
+	server := serveKeys(t, rsaKey, ecKey)
+
+	tests := []struct {
+		name    string
+		key     testKey
+		claims  func(jwt.MapClaims)
+		wantErr bool
+	}{
+		{name: "rsa key", key: rsaKey, claims: func(jwt.MapClaims) {}, wantErr: false},
+		{name: "ec key", key: ecKey, claims: func(jwt.MapClaims) {}, wantErr: false},
+		{
+			name: "expired token",
+			key:  rsaKey,
+			claims: func(c jwt.MapClaims) {
+				c["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			wantErr: true,
+		},
+		{
+			name:    "token without expiration",
+			key:     rsaKey,
+			claims:  func(c jwt.MapClaims) { delete(c, "exp") },
+			wantErr: true,
+		},
+		{
+			name:    "wrong issuer",
+			key:     rsaKey,
+			claims:  func(c jwt.MapClaims) { c["iss"] = "https://attacker.example.com/" },
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			key:     ecKey,
+			claims:  func(c jwt.MapClaims) { c["aud"] = "https://other.example.com" },
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			key:     newRSAKey(t, "rsa-1"),
+			claims:  func(jwt.MapClaims) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			tt.claims(claims)
+
+			verifier := NewVerifier(true)
+			endUser, err := verifier.Verify(context.Background(), oidcProvider(server.URL), tt.key.sign(t, claims))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got end-user %+v", endUser)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if endUser.ID != "user_123" || endUser.Email != "user@example.com" {
+				t.Fatalf("unexpected end-user %+v", endUser)
+			}
+		})
+	}
+}
+
+func TestVerifyOIDCStaticKeys(t *testing.T) {
+	key := newECKey(t, "static")
+	provider := oidcProvider("")
+	provider.Keys = []map[string]any{key.jwk()}
+	provider.Claims = model.ClaimMapping{UserID: "app.user_id", Email: ""}
+
+	claims := validClaims()
+	claims["app"] = map[string]any{"user_id": "mapped_1"}
+
+	endUser, err := NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if endUser.ID != "mapped_1" {
+		t.Fatalf("expected the mapped user id, got %q", endUser.ID)
+	}
+}
+
+func TestVerifyRotatedKey(t *testing.T) {
+	old := newRSAKey(t, "old")
+	rotated := newRSAKey(t, "rotated")
+
+	var current atomic.Pointer[testKey]
+	current.Store(&old)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]any{current.Load().jwk()}})
+	}))
+	t.Cleanup(server.Close)
+
+	verifier := NewVerifier(true)
+	provider := oidcProvider(server.URL)
+	if _, err := verifier.Verify(context.Background(), provider, old.sign(t, validClaims())); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the cached set is fetched again once it is older than minRefresh
+	current.Store(&rotated)
+	verifier.keys.mu.Lock()
+	set := verifier.keys.sets[server.URL]
+	set.fetchedAt = time.Now().Add(-2 * minRefresh)
+	verifier.keys.sets[server.URL] = set
+	verifier.keys.mu.Unlock()
+
+	if _, err := verifier.Verify(context.Background(), provider, rotated.sign(t, validClaims())); err != nil {
+		t.Fatalf("unexpected error after rotation: %v", err)
+	}
+}
+
+func TestVerifySupabase(t *testing.T) {
+	key := newRSAKey(t, "supabase")
+	server := serveKeys(t, key)
+	provider := model.SupabaseAuthProvider{
+		URL:       "https://project.supabase.co",
+		Key:       "anon",
+		JWTSecret: "",
+		JWKSURL:   server.URL,
+	}
+
+	claims := validClaims()
+	claims["iss"] = provider.Issuer()
+	claims["aud"] = "authenticated"
+
+	endUser, err := NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if endUser.ID != "user_123" {
+		t.Fatalf("unexpected end-user %+v", endUser)
+	}
+
+	claims["aud"] = "anon"
+	if _, err = NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims)); err == nil {
+		t.Fatal("expected a token for another audience to be rejected")
+	}
+
+	delete(claims, "aud")
+	if _, err = NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims)); err == nil {
+		t.Fatal("expected a token without audience to be rejected")
+	}
+
+	claims["aud"] = "authenticated"
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	if _, err = NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims)); err == nil {
+		t.Fatal("expected an expired token to be rejected")
+	}
+}
+
+func TestVerifySupabaseSecret(t *testing.T) {
+	provider := model.SupabaseAuthProvider{
+		URL:       "https://project.supabase.co",
+		Key:       "anon",
+		JWTSecret: "super-secret-jwt-token",
+		JWKSURL:   "",
+	}
+
+	claims := validClaims()
+	claims["iss"] = provider.Issuer()
+	claims["aud"] = "authenticated"
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(provider.JWTSecret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	if _, err = NewVerifier(true).Verify(context.Background(), provider, token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("another-secret"))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	if _, err = NewVerifier(true).Verify(context.Background(), provider, forged); err == nil {
+		t.Fatal("expected a token signed with another secret to be rejected")
+	}
+}
+
+func clerkProvider(host string, jwksURL string) model.ClerkAuthProvider {
+	return model.ClerkAuthProvider{
+		PublishableKey: secret.APIKey("pk_test_" + base64.StdEncoding.EncodeToString([]byte(host+"$"))),
+		SecretKey:      "sk_test",
+		JWKSURL:        jwksURL,
+	}
+}
+
+func TestVerifyClerk(t *testing.T) {
+	key := newECKey(t, "clerk")
+	server := serveKeys(t, key)
+	provider := clerkProvider("clerk.example.com", server.URL)
+
+	claims := validClaims()
+	claims["iss"] = "https://clerk.example.com"
+	delete(claims, "aud")
+
+	if _, err := NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	claims["iss"] = "https://attacker.example.com"
+	if _, err := NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims)); err == nil {
+		t.Fatal("expected a token from another issuer to be rejected")
+	}
+
+	claims["iss"] = "https://clerk.example.com"
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	if _, err := NewVerifier(true).Verify(context.Background(), provider, key.sign(t, claims)); err == nil {
+		t.Fatal("expected an expired token to be rejected")
+	}
+}
+
+func TestVerifyPrivateKeySet(t *testing.T) {
+	key := newRSAKey(t, "private")
+	server := serveKeys(t, key)
+
+	// the test server listens on a loopback address
+	_, err := NewVerifier(false).Verify(context.Background(), oidcProvider(server.URL), key.sign(t, validClaims()))
+	if err == nil {
+		t.Fatal("expected a key set on a private address to be refused")
+	}
+}
@@ -0,0 +1,447 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := New(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) CreateDataset(ctx context.Context, dataset *model.Dataset, cases []model.DatasetCase) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		err := q.storeDataset(ctx, storeDatasetParams{
+			ID:          dataset.ID,
+			ProjectID:   dataset.ProjectID,
+			Name:        dataset.Name,
+			Description: dataset.Description,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		if len(cases) == 0 {
+			return nil
+		}
+		return r.storeDatasetCases(ctx, q, dataset.ID, cases)
+	})
+}
+
+func (r Repository) RetrieveDataset(ctx context.Context, id uuid.UUID) (*model.Dataset, error) {
+	dataset, err := r.queries.datasetById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return dataset.domain(), nil
+}
+
+func (r Repository) UpdateDataset(ctx context.Context, dataset *model.Dataset) error {
+	err := r.queries.updateDataset(ctx, updateDatasetParams{
+		ID:          dataset.ID,
+		Name:        dataset.Name,
+		Description: dataset.Description,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DeleteDataset(ctx context.Context, id uuid.UUID) error {
+	err := r.queries.deleteDataset(ctx, id)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) CountDatasetCases(ctx context.Context, datasetID uuid.UUID) (int, error) {
+	count, err := r.queries.countDatasetCases(ctx, datasetID)
+	if err != nil {
+		return 0, r.errorDecoder(err)
+	}
+	return int(count), nil
+}
+
+func (r Repository) AddDatasetCases(ctx context.Context, datasetID uuid.UUID, cases []model.DatasetCase) error {
+	return r.storeDatasetCases(ctx, r.queries, datasetID, cases)
+}
+
+func (r Repository) storeDatasetCases(
+	ctx context.Context,
+	q *Queries,
+	datasetID uuid.UUID,
+	cases []model.DatasetCase,
+) error {
+	inputs := make([][]byte, len(cases))
+	expected := make([][]byte, len(cases))
+	for i, c := range cases {
+		var err error
+		inputs[i], err = json.Marshal(c.Inputs)
+		if err != nil {
+			return err
+		}
+
+		expected[i], err = marshalOptional(c.Expected)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := q.storeDatasetCases(ctx, storeDatasetCasesParams{
+		DatasetID: datasetID,
+		Inputs:    inputs,
+		Expected:  expected,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ListDatasetCases(ctx context.Context, datasetID uuid.UUID) ([]model.DatasetCase, error) {
+	cases, err := r.queries.datasetCasesByDatasetId(ctx, datasetID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainCases := make([]model.DatasetCase, len(cases))
+	for i, c := range cases {
+		domainCases[i], err = c.domain()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domainCases, nil
+}
+
+func (r Repository) CreateEvalRun(ctx context.Context, run *model.EvalRun, batch *model.Batch) error {
+	scorers, err := json.Marshal(run.Scorers)
+	if err != nil {
+		return err
+	}
+
+	itemIndexes := make([]int32, len(batch.Items))
+	triggerIDs := make([]uuid.UUID, len(batch.Items))
+	inputs := make([][]byte, len(batch.Items))
+	for i, item := range batch.Items {
+		in, err := json.Marshal(item.Inputs)
+		if err != nil {
+			return err
+		}
+
+		itemIndexes[i] = int32(item.Index) //nolint:gosec // bounded by the batch size
+		triggerIDs[i] = item.TriggerID
+		inputs[i] = in
+	}
+
+	indexes := make([]int32, len(run.Expected))
+	expected := make([][]byte, len(run.Expected))
+	for i, e := range run.Expected {
+		indexes[i] = int32(i) //nolint:gosec // bounded by the dataset size
+		expected[i], err = marshalOptional(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.withTx(ctx, func(q *Queries) error {
+		err := q.storeEvalBatch(ctx, storeEvalBatchParams{
+			ID:          batch.ID,
+			ProjectID:   batch.ProjectID,
+			WorkflowID:  batch.WorkflowID.String(),
+			Revision:    batch.Revision,
+			Status:      string(batch.Status),
+			Concurrency: int32(batch.Concurrency), //nolint:gosec // bounded by the max concurrency
+			Total:       int32(len(batch.Items)),  //nolint:gosec // bounded by the batch size
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		err = q.storeEvalBatchItems(ctx, storeEvalBatchItemsParams{
+			BatchID:    batch.ID,
+			Indexes:    itemIndexes,
+			TriggerIds: triggerIDs,
+			Inputs:     inputs,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		err = q.storeEvalRun(ctx, storeEvalRunParams{
+			ID:         run.ID,
+			ProjectID:  run.ProjectID,
+			WorkflowID: run.WorkflowID.String(),
+			DatasetID:  pgtype.UUID{Bytes: run.DatasetID, Valid: true},
+			BatchID:    run.BatchID,
+			Revision:   run.Revision,
+			Scorers:    scorers,
+			Status:     string(run.Status),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		err = q.storeEvalCases(ctx, storeEvalCasesParams{
+			EvalRunID: run.ID,
+			Indexes:   indexes,
+			Expected:  expected,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+		return nil
+	})
+}
+
+func (r Repository) ClaimScorableEvalRuns(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*model.EvalRun, error) {
+	until := time.Now().Add(lease)
+	runs, err := r.queries.claimScorableEvalRuns(ctx, claimScorableEvalRunsParams{
+		ScoringUntil: timestamptz(&until),
+		Limit:        int32(limit), //nolint:gosec // small batch size
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainRuns := make([]*model.EvalRun, len(runs))
+	for i, run := range runs {
+		domainRuns[i], err = run.domain()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domainRuns, nil
+}
+
+func (r Repository) ListEvalCases(ctx context.Context, runID uuid.UUID) ([]*model.EvalCase, error) {
+	cases, err := r.queries.evalCasesByRunId(ctx, runID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainCases := make([]*model.EvalCase, len(cases))
+	for i, c := range cases {
+		domainCases[i], err = c.domain()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domainCases, nil
+}
+
+func (r Repository) CompleteEvalRun(ctx context.Context, run *model.EvalRun, cases []*model.EvalCase) error {
+	metrics, err := json.Marshal(run.Metrics)
+	if err != nil {
+		return err
+	}
+
+	return r.withTx(ctx, func(q *Queries) error {
+		for _, c := range cases {
+			scores, err := json.Marshal(c.Scores)
+			if err != nil {
+				return err
+			}
+
+			err = q.scoreEvalCase(ctx, scoreEvalCaseParams{
+				EvalRunID: run.ID,
+				Idx:       int32(c.Index), //nolint:gosec // bounded by the dataset size
+				Scores:    scores,
+				Passed:    c.Passed,
+			})
+			if err != nil {
+				return r.errorDecoder(err)
+			}
+		}
+
+		if err = q.completeEvalRun(ctx, completeEvalRunParams{
+			ID:      run.ID,
+			Metrics: metrics,
+		}); err != nil {
+			return r.errorDecoder(err)
+		}
+		return nil
+	})
+}
+
+func (r Repository) ReadDataset(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (query.Dataset, error) {
+	dataset, err := r.queries.datasetById(ctx, id)
+	if err != nil {
+		return query.Dataset{}, r.errorDecoder(err)
+	}
+
+	if dataset.ProjectID != projectID {
+		return query.Dataset{}, fmt.Errorf("%w: dataset %s", adapterrors.ErrNotFound, id)
+	}
+
+	return r.queryDataset(ctx, dataset)
+}
+
+func (r Repository) ListDatasets(ctx context.Context, projectID uuid.UUID) ([]query.Dataset, error) {
+	datasets, err := r.queries.datasetsByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryDatasets := make([]query.Dataset, len(datasets))
+	for i, dataset := range datasets {
+		queryDatasets[i], err = r.queryDataset(ctx, dataset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryDatasets, nil
+}
+
+func (r Repository) queryDataset(ctx context.Context, dataset Dataset) (query.Dataset, error) {
+	count, err := r.queries.countDatasetCases(ctx, dataset.ID)
+	if err != nil {
+		return query.Dataset{}, r.errorDecoder(err)
+	}
+	return dataset.query(int(count)), nil
+}
+
+func (r Repository) ReadDatasetCases(ctx context.Context, datasetID uuid.UUID) ([]query.DatasetCase, error) {
+	cases, err := r.queries.datasetCasesByDatasetId(ctx, datasetID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryCases := make([]query.DatasetCase, len(cases))
+	for i, c := range cases {
+		queryCases[i], err = c.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryCases, nil
+}
+
+func (r Repository) ReadEvalRun(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (query.EvalRun, error) {
+	run, err := r.queries.evalRunById(ctx, id)
+	if err != nil {
+		return query.EvalRun{}, r.errorDecoder(err)
+	}
+
+	if run.ProjectID != projectID {
+		return query.EvalRun{}, fmt.Errorf("%w: eval run %s", adapterrors.ErrNotFound, id)
+	}
+
+	return run.query()
+}
+
+func (r Repository) ListEvalRuns(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) ([]query.EvalRun, error) {
+	runs, err := r.queries.evalRunsByWorkflowId(ctx, evalRunsByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryRuns := make([]query.EvalRun, len(runs))
+	for i, run := range runs {
+		queryRuns[i], err = run.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryRuns, nil
+}
+
+func (r Repository) ReadEvalCases(ctx context.Context, runID uuid.UUID) ([]query.EvalCase, error) {
+	cases, err := r.queries.evalCasesByRunId(ctx, runID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryCases := make([]query.EvalCase, len(cases))
+	for i, c := range cases {
+		queryCases[i], err = c.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryCases, nil
+}
@@ -0,0 +1,213 @@
+package eval
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (d Dataset) domain() *model.Dataset {
+	return &model.Dataset{
+		ID:          d.ID,
+		ProjectID:   d.ProjectID,
+		Name:        d.Name,
+		Description: d.Description,
+	}
+}
+
+func (d Dataset) query(caseCount int) query.Dataset {
+	return query.Dataset{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		CaseCount:   caseCount,
+		CreatedAt:   d.CreatedAt.Time,
+		UpdatedAt:   d.UpdatedAt.Time,
+	}
+}
+
+func (c DatasetCase) domain() (model.DatasetCase, error) {
+	var inputs map[string]any
+	if err := json.Unmarshal(c.Inputs, &inputs); err != nil {
+		return model.DatasetCase{}, err
+	}
+
+	expected, err := unmarshalOptional(c.Expected)
+	if err != nil {
+		return model.DatasetCase{}, err
+	}
+
+	return model.DatasetCase{
+		Inputs:   inputs,
+		Expected: expected,
+	}, nil
+}
+
+func (c DatasetCase) query() (query.DatasetCase, error) {
+	dc, err := c.domain()
+	if err != nil {
+		return query.DatasetCase{}, err
+	}
+
+	return query.DatasetCase{
+		Index:    int(c.Idx),
+		Inputs:   dc.Inputs,
+		Expected: dc.Expected,
+	}, nil
+}
+
+func (r EvalRun) domain() (*model.EvalRun, error) {
+	var scorers []model.ScorerConfig
+	if err := json.Unmarshal(r.Scorers, &scorers); err != nil {
+		return nil, err
+	}
+
+	var datasetID uuid.UUID
+	if r.DatasetID.Valid {
+		datasetID = r.DatasetID.Bytes
+	}
+
+	return &model.EvalRun{
+		ID:         r.ID,
+		ProjectID:  r.ProjectID,
+		WorkflowID: model.WorkflowID(r.WorkflowID),
+		DatasetID:  datasetID,
+		BatchID:    r.BatchID,
+		Revision:   r.Revision,
+		Scorers:    scorers,
+		Status:     model.EvalRunStatus(r.Status),
+		Metrics:    nil,
+		Expected:   nil,
+	}, nil
+}
+
+func (r EvalRun) query() (query.EvalRun, error) {
+	var scorers []model.ScorerConfig
+	if err := json.Unmarshal(r.Scorers, &scorers); err != nil {
+		return query.EvalRun{}, err
+	}
+
+	var metrics *model.EvalMetrics
+	if len(r.Metrics) > 0 {
+		metrics = new(model.EvalMetrics)
+		if err := json.Unmarshal(r.Metrics, metrics); err != nil {
+			return query.EvalRun{}, err
+		}
+	}
+
+	var datasetID *uuid.UUID
+	if r.DatasetID.Valid {
+		id := uuid.UUID(r.DatasetID.Bytes)
+		datasetID = &id
+	}
+
+	return query.EvalRun{
+		ID:          r.ID,
+		WorkflowID:  r.WorkflowID,
+		DatasetID:   datasetID,
+		BatchID:     r.BatchID,
+		Revision:    r.Revision,
+		Scorers:     scorers,
+		Status:      r.Status,
+		Metrics:     metrics,
+		CompletedAt: timePtr(r.CompletedAt),
+		CreatedAt:   r.CreatedAt.Time,
+		UpdatedAt:   r.UpdatedAt.Time,
+	}, nil
+}
+
+func (c evalCasesByRunIdRow) domain() (*model.EvalCase, error) {
+	var inputs map[string]any
+	if err := json.Unmarshal(c.Inputs, &inputs); err != nil {
+		return nil, err
+	}
+
+	expected, err := unmarshalOptional(c.Expected)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := unmarshalOptional(c.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	var scores map[string]float64
+	if err = json.Unmarshal(c.Scores, &scores); err != nil {
+		return nil, err
+	}
+
+	var duration time.Duration
+	if c.StartedAt.Valid && c.FinishedAt.Valid {
+		duration = c.FinishedAt.Time.Sub(c.StartedAt.Time)
+	}
+
+	return &model.EvalCase{
+		Index:    int(c.Idx),
+		Inputs:   inputs,
+		Expected: expected,
+		Status:   model.BatchItemStatus(c.Status),
+		Result:   result,
+		Error:    c.Error,
+		Duration: duration,
+		Scores:   scores,
+		Passed:   c.Passed,
+	}, nil
+}
+
+func (c evalCasesByRunIdRow) query() (query.EvalCase, error) {
+	ec, err := c.domain()
+	if err != nil {
+		return query.EvalCase{}, err
+	}
+
+	return query.EvalCase{
+		Index:      ec.Index,
+		Inputs:     ec.Inputs,
+		Expected:   ec.Expected,
+		Status:     string(ec.Status),
+		Result:     ec.Result,
+		Error:      ec.Error,
+		DurationMs: ec.Duration.Milliseconds(),
+		Scores:     ec.Scores,
+		Passed:     ec.Passed,
+	}, nil
+}
+
+// marshalOptional stores nil values as NULL rather than a JSON null.
+func marshalOptional(v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func unmarshalOptional(raw json.RawMessage) (any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
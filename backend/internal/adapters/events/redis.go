@@ -14,6 +14,9 @@ import (
 	"github.com/supallm/core/internal/application/event"
 )
 
+// endUserTTL covers the time a trigger waits in the queue before its first event.
+const endUserTTL = 24 * time.Hour
+
 type RedisEventStore struct {
 	client *redis.Client
 	ttl    time.Duration
@@ -26,30 +29,54 @@ func NewRedisEventStore(client *redis.Client, ttl time.Duration) RedisEventStore
 	}
 }
 
+// BindEndUser records the end-user who made the trigger, its events are
+// stored with the end-user ID.
+func (s RedisEventStore) BindEndUser(
+	ctx context.Context,
+	workflowID model.WorkflowID,
+	triggerID uuid.UUID,
+	endUserID string,
+) error {
+	endUserKey := fmt.Sprintf("workflow:%s:%s:end_user", workflowID, triggerID)
+	if err := s.client.Set(ctx, endUserKey, endUserID, endUserTTL).Err(); err != nil {
+		return fmt.Errorf("failed to bind end-user: %w", err)
+	}
+	return nil
+}
+
 func (s RedisEventStore) StoreEvent(ctx context.Context, event *event.WorkflowEventMessage) ([]byte, error) {
 	seqKey := fmt.Sprintf("workflow:%s:%s:sequence", event.WorkflowID, event.TriggerID)
 	eventKey := fmt.Sprintf("workflow:%s:%s:events", event.WorkflowID, event.TriggerID)
+	endUserKey := fmt.Sprintf("workflow:%s:%s:end_user", event.WorkflowID, event.TriggerID)
 
-	sequence, err := s.client.Incr(ctx, seqKey).Result()
-	if err != nil {
+	pipe := s.client.Pipeline()
+	incr := pipe.Incr(ctx, seqKey)
+	endUser := pipe.Get(ctx, endUserKey)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
 		return nil, fmt.Errorf("failed to generate sequence: %w", err)
 	}
+
+	sequence := incr.Val()
 	if sequence <= 0 {
 		return nil, fmt.Errorf("invalid sequence number: %d", sequence)
 	}
 	event.Sequence = uint64(sequence)
+	event.EndUserID = endUser.Val()
 	eventJSON, err := json.Marshal(event)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	pipe := s.client.Pipeline()
+	pipe = s.client.Pipeline()
 	pipe.ZAdd(ctx, eventKey, redis.Z{
 		Score:  float64(sequence),
 		Member: string(eventJSON),
 	})
 	pipe.Expire(ctx, eventKey, s.ttl)
 	pipe.Expire(ctx, seqKey, s.ttl)
+	if event.EndUserID != "" {
+		pipe.Expire(ctx, endUserKey, s.ttl)
+	}
 
 	if _, err = pipe.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("failed to store event: %w", err)
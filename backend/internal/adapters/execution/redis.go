@@ -86,6 +86,8 @@ func toQueryExecution(e Execution) query.Execution {
 		NodeExecutions: toQueryNodeExecutions(e.NodeExecutions),
 		CompletedNodes: e.CompletedNodes,
 		AllNodes:       e.AllNodes,
+		Variant:        nil,
+		EndUserID:      "",
 	}
 }
 
@@ -0,0 +1,92 @@
+package login
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+)
+
+const keyPrefix = "login:attempts:"
+
+// reserveAttemptScript counts an attempt as a failure made at ARGV[1] and
+// returns the failures preceding it along with the time of the last one.
+//
+//nolint:gochecknoglobals // compiled once
+var reserveAttemptScript = redis.NewScript(`
+local state = redis.call('HMGET', KEYS[1], 'failures', 'last_failure')
+redis.call('HINCRBY', KEYS[1], 'failures', 1)
+redis.call('HSET', KEYS[1], 'last_failure', ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return {tonumber(state[1]) or 0, tonumber(state[2]) or 0}
+`)
+
+// releaseAttemptScript uncounts an attempt reserved at ARGV[1], the time of
+// the last failure is restored to ARGV[2] unless another attempt followed.
+//
+//nolint:gochecknoglobals // compiled once
+var releaseAttemptScript = redis.NewScript(`
+local failures = redis.call('HINCRBY', KEYS[1], 'failures', -1)
+if failures <= 0 then
+	redis.call('DEL', KEYS[1])
+elseif redis.call('HGET', KEYS[1], 'last_failure') == ARGV[1] then
+	redis.call('HSET', KEYS[1], 'last_failure', ARGV[2])
+end
+return failures
+`)
+
+type RedisAttemptRepository struct {
+	redis *redis.Client
+}
+
+func NewRedisAttemptRepository(redis *redis.Client) *RedisAttemptRepository {
+	return &RedisAttemptRepository{redis: redis}
+}
+
+func (r *RedisAttemptRepository) ReserveLoginAttempt(
+	ctx context.Context,
+	key string,
+	now time.Time,
+	retention time.Duration,
+) (model.LoginAttempts, error) {
+	values, err := reserveAttemptScript.Run(
+		ctx, r.redis, []string{keyPrefix + key}, now.UnixMilli(), retention.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return model.LoginAttempts{}, fmt.Errorf("%w: %w", adapterrors.ErrInternal, err)
+	}
+
+	attempts := model.LoginAttempts{
+		Failures:      int(values[0]),
+		LastFailureAt: time.Time{},
+	}
+	if attempts.Failures > 0 {
+		attempts.LastFailureAt = time.UnixMilli(values[1])
+	}
+	return attempts, nil
+}
+
+func (r *RedisAttemptRepository) ReleaseLoginAttempt(
+	ctx context.Context,
+	key string,
+	now time.Time,
+	previous model.LoginAttempts,
+) error {
+	err := releaseAttemptScript.Run(
+		ctx, r.redis, []string{keyPrefix + key}, now.UnixMilli(), previous.LastFailureAt.UnixMilli(),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("%w: %w", adapterrors.ErrInternal, err)
+	}
+	return nil
+}
+
+func (r *RedisAttemptRepository) ResetLoginAttempts(ctx context.Context, key string) error {
+	if err := r.redis.Del(ctx, keyPrefix+key).Err(); err != nil {
+		return fmt.Errorf("%w: %w", adapterrors.ErrInternal, err)
+	}
+	return nil
+}
@@ -7,13 +7,15 @@ package project
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	secret "github.com/supallm/core/internal/pkg/secret"
 )
 
 const credentialById = `-- name: credentialById :one
-SELECT id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated, created_at, updated_at
+SELECT id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated, created_at, updated_at, verification_status, verification_error, last_verified_at, payload_encrypted, payload_preview
 FROM credentials
 WHERE id = $1
 `
@@ -30,12 +32,64 @@ func (q *Queries) credentialById(ctx context.Context, id uuid.UUID) (Credential,
 		&i.ApiKeyObfuscated,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VerificationStatus,
+		&i.VerificationError,
+		&i.LastVerifiedAt,
+		&i.PayloadEncrypted,
+		&i.PayloadPreview,
 	)
 	return i, err
 }
 
+const credentialReferences = `-- name: credentialReferences :many
+SELECT r.credential_id, r.workflow_id, w.name AS workflow_name, r.node_id, r.node_type
+FROM credential_references r
+JOIN workflows w ON w.id = r.workflow_id
+WHERE r.project_id = $1 AND r.credential_id = $2
+ORDER BY w.name, r.workflow_id, r.node_id
+`
+
+type credentialReferencesParams struct {
+	ProjectID    uuid.UUID `json:"project_id"`
+	CredentialID uuid.UUID `json:"credential_id"`
+}
+
+type credentialReferencesRow struct {
+	CredentialID uuid.UUID `json:"credential_id"`
+	WorkflowID   string    `json:"workflow_id"`
+	WorkflowName string    `json:"workflow_name"`
+	NodeID       string    `json:"node_id"`
+	NodeType     string    `json:"node_type"`
+}
+
+func (q *Queries) credentialReferences(ctx context.Context, arg credentialReferencesParams) ([]credentialReferencesRow, error) {
+	rows, err := q.db.Query(ctx, credentialReferences, arg.ProjectID, arg.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []credentialReferencesRow
+	for rows.Next() {
+		var i credentialReferencesRow
+		if err := rows.Scan(
+			&i.CredentialID,
+			&i.WorkflowID,
+			&i.WorkflowName,
+			&i.NodeID,
+			&i.NodeType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const credentialsByProjectId = `-- name: credentialsByProjectId :many
-SELECT id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated, created_at, updated_at
+SELECT id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated, created_at, updated_at, verification_status, verification_error, last_verified_at, payload_encrypted, payload_preview
 FROM credentials
 WHERE project_id = $1
 `
@@ -58,6 +112,11 @@ func (q *Queries) credentialsByProjectId(ctx context.Context, projectID uuid.UUI
 			&i.ApiKeyObfuscated,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.VerificationStatus,
+			&i.VerificationError,
+			&i.LastVerifiedAt,
+			&i.PayloadEncrypted,
+			&i.PayloadPreview,
 		); err != nil {
 			return nil, err
 		}
@@ -79,9 +138,22 @@ func (q *Queries) deleteCredential(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteWorkflowCredentialReferences = `-- name: deleteWorkflowCredentialReferences :exec
+DELETE FROM credential_references
+WHERE workflow_id = $1
+`
+
+func (q *Queries) deleteWorkflowCredentialReferences(ctx context.Context, workflowID string) error {
+	_, err := q.db.Exec(ctx, deleteWorkflowCredentialReferences, workflowID)
+	return err
+}
+
 const storeCredential = `-- name: storeCredential :exec
-INSERT INTO credentials (id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO credentials (
+    id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated,
+    payload_encrypted, payload_preview
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type storeCredentialParams struct {
@@ -91,6 +163,8 @@ type storeCredentialParams struct {
 	ProviderType     string           `json:"provider_type"`
 	ApiKeyEncrypted  secret.Encrypted `json:"api_key_encrypted"`
 	ApiKeyObfuscated string           `json:"api_key_obfuscated"`
+	PayloadEncrypted secret.Encrypted `json:"payload_encrypted"`
+	PayloadPreview   json.RawMessage  `json:"payload_preview"`
 }
 
 func (q *Queries) storeCredential(ctx context.Context, arg storeCredentialParams) error {
@@ -101,29 +175,97 @@ func (q *Queries) storeCredential(ctx context.Context, arg storeCredentialParams
 		arg.ProviderType,
 		arg.ApiKeyEncrypted,
 		arg.ApiKeyObfuscated,
+		arg.PayloadEncrypted,
+		arg.PayloadPreview,
+	)
+	return err
+}
+
+const storeCredentialReference = `-- name: storeCredentialReference :exec
+INSERT INTO credential_references (project_id, credential_id, workflow_id, node_id, node_type)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (workflow_id, node_id) DO NOTHING
+`
+
+type storeCredentialReferenceParams struct {
+	ProjectID    uuid.UUID `json:"project_id"`
+	CredentialID uuid.UUID `json:"credential_id"`
+	WorkflowID   string    `json:"workflow_id"`
+	NodeID       string    `json:"node_id"`
+	NodeType     string    `json:"node_type"`
+}
+
+func (q *Queries) storeCredentialReference(ctx context.Context, arg storeCredentialReferenceParams) error {
+	_, err := q.db.Exec(ctx, storeCredentialReference,
+		arg.ProjectID,
+		arg.CredentialID,
+		arg.WorkflowID,
+		arg.NodeID,
+		arg.NodeType,
+	)
+	return err
+}
+
+const updateCredentialVerification = `-- name: updateCredentialVerification :exec
+UPDATE credentials
+SET verification_status = $2,
+    verification_error = $3,
+    last_verified_at = $4
+WHERE id = $1 AND api_key_encrypted = $5
+`
+
+type updateCredentialVerificationParams struct {
+	ID                 uuid.UUID          `json:"id"`
+	VerificationStatus string             `json:"verification_status"`
+	VerificationError  string             `json:"verification_error"`
+	LastVerifiedAt     pgtype.Timestamptz `json:"last_verified_at"`
+	ApiKeyEncrypted    secret.Encrypted   `json:"api_key_encrypted"`
+}
+
+func (q *Queries) updateCredentialVerification(ctx context.Context, arg updateCredentialVerificationParams) error {
+	_, err := q.db.Exec(ctx, updateCredentialVerification,
+		arg.ID,
+		arg.VerificationStatus,
+		arg.VerificationError,
+		arg.LastVerifiedAt,
+		arg.ApiKeyEncrypted,
 	)
 	return err
 }
 
 const upsertCredential = `-- name: upsertCredential :exec
-INSERT INTO credentials (id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO credentials (
+    id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated,
+    verification_status, verification_error, last_verified_at,
+    payload_encrypted, payload_preview
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT (id) 
 DO UPDATE SET
     name = EXCLUDED.name,
     provider_type = EXCLUDED.provider_type,
     api_key_encrypted = EXCLUDED.api_key_encrypted,
     api_key_obfuscated = EXCLUDED.api_key_obfuscated,
+    verification_status = EXCLUDED.verification_status,
+    verification_error = EXCLUDED.verification_error,
+    last_verified_at = EXCLUDED.last_verified_at,
+    payload_encrypted = EXCLUDED.payload_encrypted,
+    payload_preview = EXCLUDED.payload_preview,
     updated_at = NOW()
 `
 
 type upsertCredentialParams struct {
-	ID               uuid.UUID        `json:"id"`
-	ProjectID        uuid.UUID        `json:"project_id"`
-	Name             string           `json:"name"`
-	ProviderType     string           `json:"provider_type"`
-	ApiKeyEncrypted  secret.Encrypted `json:"api_key_encrypted"`
-	ApiKeyObfuscated string           `json:"api_key_obfuscated"`
+	ID                 uuid.UUID          `json:"id"`
+	ProjectID          uuid.UUID          `json:"project_id"`
+	Name               string             `json:"name"`
+	ProviderType       string             `json:"provider_type"`
+	ApiKeyEncrypted    secret.Encrypted   `json:"api_key_encrypted"`
+	ApiKeyObfuscated   string             `json:"api_key_obfuscated"`
+	VerificationStatus string             `json:"verification_status"`
+	VerificationError  string             `json:"verification_error"`
+	LastVerifiedAt     pgtype.Timestamptz `json:"last_verified_at"`
+	PayloadEncrypted   secret.Encrypted   `json:"payload_encrypted"`
+	PayloadPreview     json.RawMessage    `json:"payload_preview"`
 }
 
 func (q *Queries) upsertCredential(ctx context.Context, arg upsertCredentialParams) error {
@@ -134,6 +276,11 @@ func (q *Queries) upsertCredential(ctx context.Context, arg upsertCredentialPara
 		arg.ProviderType,
 		arg.ApiKeyEncrypted,
 		arg.ApiKeyObfuscated,
+		arg.VerificationStatus,
+		arg.VerificationError,
+		arg.LastVerifiedAt,
+		arg.PayloadEncrypted,
+		arg.PayloadPreview,
 	)
 	return err
 }
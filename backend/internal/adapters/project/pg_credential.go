@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -10,17 +11,20 @@ import (
 )
 
 func (r Repository) AddCredential(ctx context.Context, projectID uuid.UUID, credential *model.Credential) error {
-	decrypted, err := credential.APIKey.Decrypt()
+	preview, err := credentialPreview(credential)
 	if err != nil {
-		return fmt.Errorf("unable to decrypt api key: %w", err)
+		return err
 	}
+
 	err = r.queries.storeCredential(ctx, storeCredentialParams{
 		ID:               credential.ID,
 		ProjectID:        projectID,
 		Name:             credential.Name,
 		ProviderType:     credential.ProviderType.String(),
 		ApiKeyEncrypted:  credential.APIKey,
-		ApiKeyObfuscated: decrypted.Obfuscate(),
+		ApiKeyObfuscated: preview.apiKey,
+		PayloadEncrypted: credential.Payload,
+		PayloadPreview:   preview.payload,
 	})
 	if err != nil {
 		return r.errorDecoder(err)
@@ -34,18 +38,23 @@ func (r Repository) updateCredentials(ctx context.Context, q *Queries, project *
 			continue
 		}
 
-		decrypted, err := llmCredential.APIKey.Decrypt()
+		preview, err := credentialPreview(llmCredential)
 		if err != nil {
-			return fmt.Errorf("unable to decrypt api key: %w", err)
+			return err
 		}
 
 		err = q.upsertCredential(ctx, upsertCredentialParams{
-			ID:               id,
-			ProjectID:        project.ID,
-			Name:             llmCredential.Name,
-			ProviderType:     llmCredential.ProviderType.String(),
-			ApiKeyEncrypted:  llmCredential.APIKey,
-			ApiKeyObfuscated: decrypted.Obfuscate(),
+			ID:                 id,
+			ProjectID:          project.ID,
+			Name:               llmCredential.Name,
+			ProviderType:       llmCredential.ProviderType.String(),
+			ApiKeyEncrypted:    llmCredential.APIKey,
+			ApiKeyObfuscated:   preview.apiKey,
+			VerificationStatus: string(llmCredential.Status),
+			VerificationError:  llmCredential.VerificationError,
+			LastVerifiedAt:     timestamptz(llmCredential.LastVerifiedAt),
+			PayloadEncrypted:   llmCredential.Payload,
+			PayloadPreview:     preview.payload,
 		})
 		if err != nil {
 			return r.errorDecoder(err)
@@ -54,6 +63,22 @@ func (r Repository) updateCredentials(ctx context.Context, q *Queries, project *
 	return nil
 }
 
+// UpdateCredentialVerification stores the outcome of a test of the credential,
+// unless its key was replaced during the test.
+func (r Repository) UpdateCredentialVerification(ctx context.Context, credential *model.Credential) error {
+	err := r.queries.updateCredentialVerification(ctx, updateCredentialVerificationParams{
+		ID:                 credential.ID,
+		VerificationStatus: string(credential.Status),
+		VerificationError:  credential.VerificationError,
+		LastVerifiedAt:     timestamptz(credential.LastVerifiedAt),
+		ApiKeyEncrypted:    credential.APIKey,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
 func (r Repository) DeleteCredential(ctx context.Context, id uuid.UUID) error {
 	err := r.queries.deleteCredential(ctx, id)
 	if err != nil {
@@ -73,3 +98,52 @@ func (r Repository) ReadCredential(
 	}
 	return credential.query(), nil
 }
+
+// preview is what is stored of a credential in clear: its obfuscated API
+// key and its payload with the secrets obfuscated.
+type preview struct {
+	apiKey  string
+	payload json.RawMessage
+}
+
+func credentialPreview(credential *model.Credential) (preview, error) {
+	apiKey := ""
+	if credential.APIKey != "" {
+		decrypted, err := credential.APIKey.Decrypt()
+		if err != nil {
+			return preview{}, fmt.Errorf("unable to decrypt api key: %w", err)
+		}
+		apiKey = decrypted.Obfuscate()
+	}
+
+	payload, err := credential.Preview()
+	if err != nil {
+		return preview{}, err
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return preview{}, fmt.Errorf("unable to marshal payload preview: %w", err)
+	}
+
+	return preview{apiKey: apiKey, payload: b}, nil
+}
+
+func (r Repository) ListCredentialReferences(
+	ctx context.Context,
+	projectID uuid.UUID,
+	credentialID uuid.UUID,
+) ([]query.CredentialReference, error) {
+	rows, err := r.queries.credentialReferences(ctx, credentialReferencesParams{
+		ProjectID:    projectID,
+		CredentialID: credentialID,
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	references := make([]query.CredentialReference, len(rows))
+	for i, row := range rows {
+		references[i] = row.query()
+	}
+	return references, nil
+}
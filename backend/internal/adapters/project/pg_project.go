@@ -11,9 +11,10 @@ import (
 func (r Repository) Create(ctx context.Context, project *model.Project) error {
 	return r.withTx(ctx, func(q *Queries) error {
 		err := q.storeProject(ctx, storeProjectParams{
-			ID:     project.ID,
-			UserID: project.UserID,
-			Name:   project.Name,
+			ID:             project.ID,
+			UserID:         project.UserID,
+			Name:           project.Name,
+			OrganizationID: pgUUID(project.OrganizationID),
 		})
 		if err != nil {
 			return r.errorDecoder(err)
@@ -126,7 +127,7 @@ func (r Repository) ReadProject(ctx context.Context, id uuid.UUID) (query.Projec
 }
 
 func (r Repository) ListProjects(ctx context.Context, userID string) ([]query.Project, error) {
-	projects, err := r.queries.projectsByUserId(ctx, userID)
+	projects, err := r.queries.projectsByMember(ctx, userID)
 	if err != nil {
 		return nil, r.errorDecoder(err)
 	}
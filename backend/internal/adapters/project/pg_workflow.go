@@ -16,18 +16,21 @@ func (r Repository) AddWorkflow(ctx context.Context, projectID uuid.UUID, workfl
 		return fmt.Errorf("unable to marshal builder flow: %w", err)
 	}
 
-	err = r.queries.storeWorkflow(ctx, storeWorkflowParams{
-		ID:          workflow.ID.String(),
-		ProjectID:   projectID,
-		Name:        workflow.Name,
-		Status:      workflow.Status.String(),
-		BuilderFlow: builderFlow,
-		RunnerFlow:  workflow.RunnerFlow,
+	return r.withTx(ctx, func(q *Queries) error {
+		err = q.storeWorkflow(ctx, storeWorkflowParams{
+			ID:          workflow.ID.String(),
+			ProjectID:   projectID,
+			Name:        workflow.Name,
+			Status:      workflow.Status.String(),
+			BuilderFlow: builderFlow,
+			RunnerFlow:  workflow.RunnerFlow,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+
+		return r.updateCredentialReferences(ctx, q, projectID, workflow)
 	})
-	if err != nil {
-		return r.errorDecoder(err)
-	}
-	return nil
 }
 
 func (r Repository) updateWorkflows(ctx context.Context, q *Queries, project *model.Project) error {
@@ -52,6 +55,37 @@ func (r Repository) updateWorkflows(ctx context.Context, q *Queries, project *mo
 		if err != nil {
 			return r.errorDecoder(err)
 		}
+
+		if err = r.updateCredentialReferences(ctx, q, project.ID, workflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateCredentialReferences replaces the nodes of the workflow in the index
+// of the credential references.
+func (r Repository) updateCredentialReferences(
+	ctx context.Context,
+	q *Queries,
+	projectID uuid.UUID,
+	workflow *model.Workflow,
+) error {
+	if err := q.deleteWorkflowCredentialReferences(ctx, workflow.ID.String()); err != nil {
+		return r.errorDecoder(err)
+	}
+
+	for _, reference := range workflow.CredentialReferences() {
+		err := q.storeCredentialReference(ctx, storeCredentialReferenceParams{
+			ProjectID:    projectID,
+			CredentialID: reference.CredentialID,
+			WorkflowID:   reference.WorkflowID.String(),
+			NodeID:       reference.NodeID,
+			NodeType:     reference.NodeType,
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
 	}
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const deleteProject = `-- name: deleteProject :exec
@@ -22,7 +23,7 @@ func (q *Queries) deleteProject(ctx context.Context, id uuid.UUID) error {
 }
 
 const projectById = `-- name: projectById :one
-SELECT id, user_id, name, auth_provider, version, created_at, updated_at
+SELECT id, user_id, name, auth_provider, version, created_at, updated_at, organization_id
 FROM projects
 WHERE id = $1
 `
@@ -38,18 +39,22 @@ func (q *Queries) projectById(ctx context.Context, id uuid.UUID) (Project, error
 		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
-const projectsByUserId = `-- name: projectsByUserId :many
-SELECT id, user_id, name, auth_provider, version, created_at, updated_at
+const projectsByMember = `-- name: projectsByMember :many
+SELECT id, user_id, name, auth_provider, version, created_at, updated_at, organization_id
 FROM projects
-WHERE user_id = $1
+WHERE user_id = $1::text
+   OR id IN (SELECT project_id FROM project_members WHERE project_members.user_id::text = $1::text)
+   OR organization_id IN (SELECT organization_id FROM organization_members WHERE organization_members.user_id::text = $1::text)
+ORDER BY created_at
 `
 
-func (q *Queries) projectsByUserId(ctx context.Context, userID string) ([]Project, error) {
-	rows, err := q.db.Query(ctx, projectsByUserId, userID)
+func (q *Queries) projectsByMember(ctx context.Context, userID string) ([]Project, error) {
+	rows, err := q.db.Query(ctx, projectsByMember, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +70,7 @@ func (q *Queries) projectsByUserId(ctx context.Context, userID string) ([]Projec
 			&i.Version,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -77,18 +83,24 @@ func (q *Queries) projectsByUserId(ctx context.Context, userID string) ([]Projec
 }
 
 const storeProject = `-- name: storeProject :exec
-INSERT INTO projects (id, user_id, name)
-VALUES ($1, $2, $3)
+INSERT INTO projects (id, user_id, name, organization_id)
+VALUES ($1, $2, $3, $4)
 `
 
 type storeProjectParams struct {
-	ID     uuid.UUID `json:"id"`
-	UserID string    `json:"user_id"`
-	Name   string    `json:"name"`
+	ID             uuid.UUID   `json:"id"`
+	UserID         string      `json:"user_id"`
+	Name           string      `json:"name"`
+	OrganizationID pgtype.UUID `json:"organization_id"`
 }
 
 func (q *Queries) storeProject(ctx context.Context, arg storeProjectParams) error {
-	_, err := q.db.Exec(ctx, storeProject, arg.ID, arg.UserID, arg.Name)
+	_, err := q.db.Exec(ctx, storeProject,
+		arg.ID,
+		arg.UserID,
+		arg.Name,
+		arg.OrganizationID,
+	)
 	return err
 }
 
@@ -21,24 +21,30 @@ type ApiKey struct {
 }
 
 type Credential struct {
-	ID               uuid.UUID          `json:"id"`
-	ProjectID        uuid.UUID          `json:"project_id"`
-	Name             string             `json:"name"`
-	ProviderType     string             `json:"provider_type"`
-	ApiKeyEncrypted  secret.Encrypted   `json:"api_key_encrypted"`
-	ApiKeyObfuscated string             `json:"api_key_obfuscated"`
-	CreatedAt        pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ID                 uuid.UUID          `json:"id"`
+	ProjectID          uuid.UUID          `json:"project_id"`
+	Name               string             `json:"name"`
+	ProviderType       string             `json:"provider_type"`
+	ApiKeyEncrypted    secret.Encrypted   `json:"api_key_encrypted"`
+	ApiKeyObfuscated   string             `json:"api_key_obfuscated"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	VerificationStatus string             `json:"verification_status"`
+	VerificationError  string             `json:"verification_error"`
+	LastVerifiedAt     pgtype.Timestamptz `json:"last_verified_at"`
+	PayloadEncrypted   secret.Encrypted   `json:"payload_encrypted"`
+	PayloadPreview     json.RawMessage    `json:"payload_preview"`
 }
 
 type Project struct {
-	ID           uuid.UUID          `json:"id"`
-	UserID       string             `json:"user_id"`
-	Name         string             `json:"name"`
-	AuthProvider authProvider       `json:"auth_provider"`
-	Version      int64              `json:"version"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID             uuid.UUID          `json:"id"`
+	UserID         string             `json:"user_id"`
+	Name           string             `json:"name"`
+	AuthProvider   authProvider       `json:"auth_provider"`
+	Version        int64              `json:"version"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	OrganizationID pgtype.UUID        `json:"organization_id"`
 }
 
 type Workflow struct {
@@ -3,8 +3,10 @@ package project
 import (
 	"encoding/json"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/query"
 )
@@ -43,21 +45,47 @@ func (a authProvider) query() query.AuthProvider {
 
 func (l Credential) domain() *model.Credential {
 	return &model.Credential{
-		ID:           l.ID,
-		Name:         l.Name,
-		ProviderType: model.ProviderType(l.ProviderType),
-		APIKey:       l.ApiKeyEncrypted,
+		ID:                l.ID,
+		Name:              l.Name,
+		ProviderType:      model.ProviderType(l.ProviderType),
+		APIKey:            l.ApiKeyEncrypted,
+		Payload:           l.PayloadEncrypted,
+		Status:            model.CredentialStatus(l.VerificationStatus),
+		VerificationError: l.VerificationError,
+		LastVerifiedAt:    timePtr(l.LastVerifiedAt),
 	}
 }
 
 func (l Credential) query() query.Credential {
+	payload := map[string]any{}
+	if err := json.Unmarshal(l.PayloadPreview, &payload); err != nil {
+		slog.Error("unable to unmarshal credential payload preview", "credential", l.ID, "error", err)
+	}
+	// credentials stored before the payloads hold an API key only
+	if len(payload) == 0 && l.ApiKeyObfuscated != "" {
+		payload[model.CredentialAPIKeyField] = l.ApiKeyObfuscated
+	}
+
 	return query.Credential{
-		ID:               l.ID,
-		Name:             l.Name,
-		Provider:         l.ProviderType,
-		ObfuscatedAPIKey: l.ApiKeyObfuscated,
-		CreatedAt:        l.CreatedAt.Time,
-		UpdatedAt:        l.UpdatedAt.Time,
+		ID:                l.ID,
+		Name:              l.Name,
+		Provider:          l.ProviderType,
+		ObfuscatedAPIKey:  l.ApiKeyObfuscated,
+		Payload:           payload,
+		Status:            l.VerificationStatus,
+		VerificationError: l.VerificationError,
+		LastVerifiedAt:    timePtr(l.LastVerifiedAt),
+		CreatedAt:         l.CreatedAt.Time,
+		UpdatedAt:         l.UpdatedAt.Time,
+	}
+}
+
+func (c credentialReferencesRow) query() query.CredentialReference {
+	return query.CredentialReference{
+		WorkflowID:   model.WorkflowID(c.WorkflowID),
+		WorkflowName: c.WorkflowName,
+		NodeID:       c.NodeID,
+		NodeType:     c.NodeType,
 	}
 }
 
@@ -147,13 +175,14 @@ func (p Project) domain(cs []Credential, ws []Workflow, as []ApiKey) (*model.Pro
 	}
 
 	return &model.Project{
-		ID:           p.ID,
-		UserID:       p.UserID,
-		Name:         p.Name,
-		AuthProvider: ap,
-		Credentials:  llmCredentials,
-		Workflows:    workflows,
-		APIKeys:      apiKeys,
+		ID:             p.ID,
+		UserID:         p.UserID,
+		OrganizationID: uuidPtr(p.OrganizationID),
+		Name:           p.Name,
+		AuthProvider:   ap,
+		Credentials:    llmCredentials,
+		Workflows:      workflows,
+		APIKeys:        apiKeys,
 	}, nil
 }
 
@@ -181,13 +210,43 @@ func (p Project) query(cs []Credential, ws []Workflow, as []ApiKey) (query.Proje
 	}
 
 	return query.Project{
-		ID:           p.ID,
-		Name:         p.Name,
-		AuthProvider: ap,
-		Credentials:  llmCredentials,
-		Workflows:    workflows,
-		APIKeys:      apiKeys,
-		CreatedAt:    p.CreatedAt.Time,
-		UpdatedAt:    p.UpdatedAt.Time,
+		ID:             p.ID,
+		OrganizationID: uuidPtr(p.OrganizationID),
+		Name:           p.Name,
+		AuthProvider:   ap,
+		Credentials:    llmCredentials,
+		Workflows:      workflows,
+		APIKeys:        apiKeys,
+		CreatedAt:      p.CreatedAt.Time,
+		UpdatedAt:      p.UpdatedAt.Time,
 	}, nil
 }
+
+func pgUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func uuidPtr(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
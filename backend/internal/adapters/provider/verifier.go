@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+const (
+	verifyTimeout   = 10 * time.Second
+	maxResponseRead = 4096
+	userAgent       = "Supallm-Credentials/1.0"
+)
+
+type VerifyError string
+
+func (e VerifyError) Error() string {
+	return string(e)
+}
+
+const (
+	ErrRejected    VerifyError = "the provider rejected the credential"
+	ErrUnsupported VerifyError = "the provider cannot be tested"
+)
+
+// Check is the lightweight authenticated call, such as listing the models,
+// made to verify the credential of a provider.
+type Check struct {
+	BaseURL string
+	Path    string
+	// Authorize sets the credential on the request.
+	Authorize func(req *http.Request, apiKey secret.APIKey)
+}
+
+// Verifier tests credentials against their provider with the check
+// registered for its provider type.
+type Verifier struct {
+	client *http.Client
+	checks map[model.ProviderType]Check
+}
+
+// NewVerifier registers the checks of the built-in providers. The base URLs,
+// by provider type, replace the public endpoints, to test against a proxy
+// or a local fake of the provider.
+func NewVerifier(baseURLs map[string]string) *Verifier {
+	v := &Verifier{
+		client: &http.Client{Timeout: verifyTimeout},
+		checks: defaultChecks(),
+	}
+
+	for providerType, baseURL := range baseURLs {
+		check, ok := v.checks[model.ProviderType(providerType)]
+		if !ok {
+			slog.Warn("no credential check for provider", "provider", providerType)
+			continue
+		}
+		check.BaseURL = baseURL
+		v.checks[model.ProviderType(providerType)] = check
+	}
+
+	return v
+}
+
+// Register adds or replaces the check of a provider type.
+func (v *Verifier) Register(providerType model.ProviderType, check Check) {
+	v.checks[providerType] = check
+}
+
+func (v *Verifier) Supports(providerType model.ProviderType) bool {
+	_, ok := v.checks[providerType]
+	return ok
+}
+
+// Verify makes the check of the provider with the key. The error tells
+// whether the provider rejected the key or could not be reached.
+func (v *Verifier) Verify(ctx context.Context, providerType model.ProviderType, apiKey secret.APIKey) error {
+	check, ok := v.checks[providerType]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupported, providerType)
+	}
+
+	url := strings.TrimSuffix(check.BaseURL, "/") + check.Path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("invalid check of %s: %w", providerType, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	check.Authorize(req, apiKey)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to reach %s: %w", providerType, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseRead))
+
+	switch {
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("%w (status %d)", ErrRejected, resp.StatusCode)
+	default:
+		return fmt.Errorf("%s responded with status %d", providerType, resp.StatusCode)
+	}
+}
+
+func defaultChecks() map[model.ProviderType]Check {
+	return map[model.ProviderType]Check{
+		"openai": {
+			BaseURL:   "https://api.openai.com/v1",
+			Path:      "/models",
+			Authorize: bearer,
+		},
+		"anthropic": {
+			BaseURL: "https://api.anthropic.com/v1",
+			Path:    "/models",
+			Authorize: func(req *http.Request, apiKey secret.APIKey) {
+				req.Header.Set("x-api-key", apiKey.String())
+				req.Header.Set("anthropic-version", "2023-06-01")
+			},
+		},
+		"mistral": {
+			BaseURL:   "https://api.mistral.ai/v1",
+			Path:      "/models",
+			Authorize: bearer,
+		},
+		"google": {
+			BaseURL: "https://generativelanguage.googleapis.com/v1beta",
+			Path:    "/models",
+			// the header keeps the key out of the URL, and of the errors
+			Authorize: func(req *http.Request, apiKey secret.APIKey) {
+				req.Header.Set("x-goog-api-key", apiKey.String())
+			},
+		},
+		"notion": {
+			BaseURL: "https://api.notion.com/v1",
+			Path:    "/users/me",
+			Authorize: func(req *http.Request, apiKey secret.APIKey) {
+				bearer(req, apiKey)
+				req.Header.Set("Notion-Version", "2022-06-28")
+			},
+		},
+		"airtable": {
+			BaseURL:   "https://api.airtable.com/v0",
+			Path:      "/meta/whoami",
+			Authorize: bearer,
+		},
+	}
+}
+
+func bearer(req *http.Request, apiKey secret.APIKey) {
+	req.Header.Set("Authorization", "Bearer "+apiKey.String())
+}
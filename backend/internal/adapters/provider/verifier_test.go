@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+const testKey secret.APIKey = "sk-test"
+
+// fakeProvider answers with the status when the request carries the
+// expected path and credential header, and with 404 otherwise.
+func fakeProvider(t *testing.T, path, header, value string, status int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != path || r.Header.Get(header) != value {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVerifyBuiltInChecks(t *testing.T) {
+	tests := []struct {
+		provider string
+		path     string
+		header   string
+		value    string
+	}{
+		{provider: "openai", path: "/models", header: "Authorization", value: "Bearer sk-test"},
+		{provider: "anthropic", path: "/models", header: "x-api-key", value: "sk-test"},
+		{provider: "mistral", path: "/models", header: "Authorization", value: "Bearer sk-test"},
+		{provider: "google", path: "/models", header: "x-goog-api-key", value: "sk-test"},
+		{provider: "notion", path: "/users/me", header: "Authorization", value: "Bearer sk-test"},
+		{provider: "airtable", path: "/meta/whoami", header: "Authorization", value: "Bearer sk-test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			server := fakeProvider(t, tt.path, tt.header, tt.value, http.StatusOK)
+			verifier := NewVerifier(map[string]string{tt.provider: server.URL + "/"})
+
+			if err := verifier.Verify(context.Background(), model.ProviderType(tt.provider), testKey); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantErr      bool
+		wantRejected bool
+	}{
+		{name: "accepted", status: http.StatusOK, wantErr: false, wantRejected: false},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: true, wantRejected: true},
+		{name: "forbidden", status: http.StatusForbidden, wantErr: true, wantRejected: true},
+		{name: "unavailable", status: http.StatusServiceUnavailable, wantErr: true, wantRejected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := fakeProvider(t, "/models", "Authorization", "Bearer sk-test", tt.status)
+			verifier := NewVerifier(map[string]string{"openai": server.URL})
+
+			err := verifier.Verify(context.Background(), "openai", testKey)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if errors.Is(err, ErrRejected) != tt.wantRejected {
+				t.Fatalf("unexpected rejection: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyUnreachable(t *testing.T) {
+	server := fakeProvider(t, "/models", "Authorization", "Bearer sk-test", http.StatusOK)
+	verifier := NewVerifier(map[string]string{"openai": server.URL})
+	server.Close()
+
+	err := verifier.Verify(context.Background(), "openai", testKey)
+	if err == nil || errors.Is(err, ErrRejected) {
+		t.Fatalf("expected an unreachable provider error, got %v", err)
+	}
+}
+
+func TestVerifyUnsupported(t *testing.T) {
+	verifier := NewVerifier(map[string]string{"unknown": "http://localhost"})
+	if verifier.Supports("unknown") {
+		t.Fatal("expected no check for an unknown provider")
+	}
+
+	err := verifier.Verify(context.Background(), "unknown", testKey)
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("expected an unsupported provider error, got %v", err)
+	}
+}
+
+func TestVerifyRegisteredCheck(t *testing.T) {
+	server := fakeProvider(t, "/ping", "X-Token", "sk-test", http.StatusNoContent)
+	verifier := NewVerifier(nil)
+	verifier.Register("custom", Check{
+		BaseURL: server.URL,
+		Path:    "/ping",
+		Authorize: func(req *http.Request, apiKey secret.APIKey) {
+			req.Header.Set("X-Token", apiKey.String())
+		},
+	})
+
+	if !verifier.Supports("custom") {
+		t.Fatal("expected the registered check to be supported")
+	}
+	if err := verifier.Verify(context.Background(), "custom", testKey); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+)
+
+const keyPrefix = "ratelimit:"
+
+// takeTokensScript refills the buckets then takes a token from every bucket,
+// or from none of them when one is empty. It returns whether the trigger is
+// allowed, the index of the most restrictive bucket, its remaining tokens,
+// the milliseconds before it holds a token again and before it is full.
+//
+//nolint:gochecknoglobals // compiled once
+var takeTokensScript = redis.NewScript(`
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local tokens = {}
+local denied = 0
+local retry = 0
+for i = 1, #KEYS do
+	local rate = tonumber(ARGV[2 * i - 1]) / 60000
+	local burst = tonumber(ARGV[2 * i])
+	local state = redis.call('HMGET', KEYS[i], 'tokens', 'ts')
+	local t = tonumber(state[1]) or burst
+	local ts = tonumber(state[2]) or now
+	t = math.min(burst, t + math.max(0, now - ts) * rate)
+	tokens[i] = t
+	if t < 1 then
+		local wait = math.ceil((1 - t) / rate)
+		if wait > retry then
+			denied = i
+			retry = wait
+		end
+	end
+end
+
+local tightest = 1
+local remaining = -1
+local reset = 0
+for i = 1, #KEYS do
+	local rate = tonumber(ARGV[2 * i - 1]) / 60000
+	local burst = tonumber(ARGV[2 * i])
+	local t = tokens[i]
+	if denied == 0 then
+		t = t - 1
+	end
+	redis.call('HSET', KEYS[i], 'tokens', tostring(t), 'ts', now)
+	redis.call('PEXPIRE', KEYS[i], math.ceil(burst / rate) + 1000)
+
+	if denied == i or (denied == 0 and (remaining < 0 or math.floor(t) < remaining)) then
+		tightest = i
+		remaining = math.max(0, math.floor(t))
+		reset = math.ceil((burst - t) / rate)
+	end
+end
+
+if denied > 0 then
+	return {0, denied, 0, retry, reset}
+end
+return {1, tightest, remaining, 0, reset}
+`)
+
+// RedisLimiter counts the triggers in token buckets shared by the replicas.
+type RedisLimiter struct {
+	redis *redis.Client
+}
+
+func NewRedisLimiter(redis *redis.Client) *RedisLimiter {
+	return &RedisLimiter{redis: redis}
+}
+
+func (l *RedisLimiter) Take(ctx context.Context, buckets []model.RateBucket) (model.RateDecision, error) {
+	if len(buckets) == 0 {
+		return model.RateDecision{Allowed: true}, nil
+	}
+
+	keys := make([]string, len(buckets))
+	args := make([]any, 0, 2*len(buckets))
+	for i, bucket := range buckets {
+		keys[i] = keyPrefix + bucket.Key
+		args = append(args, bucket.PerMinute, bucket.Burst)
+	}
+
+	values, err := takeTokensScript.Run(ctx, l.redis, keys, args...).Int64Slice()
+	if err != nil {
+		return model.RateDecision{}, fmt.Errorf("%w: %w", adapterrors.ErrInternal, err)
+	}
+
+	return model.RateDecision{
+		Allowed:    values[0] == 1,
+		Bucket:     buckets[values[1]-1],
+		Remaining:  int(values[2]),
+		RetryAfter: time.Duration(values[3]) * time.Millisecond,
+		Reset:      time.Duration(values[4]) * time.Millisecond,
+	}, nil
+}
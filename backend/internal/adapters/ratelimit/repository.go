@@ -0,0 +1,145 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) SaveRateLimit(ctx context.Context, limit *model.RateLimit) error {
+	err := r.queries.upsertRateLimit(ctx, upsertRateLimitParams{
+		ProjectID:                limit.ProjectID,
+		WorkflowID:               limit.WorkflowID.String(),
+		RequestsPerMinute:        int32(limit.RequestsPerMinute),        //nolint:gosec // validated by the api
+		ApiKeyRequestsPerMinute:  int32(limit.APIKeyRequestsPerMinute),  //nolint:gosec // validated by the api
+		EndUserRequestsPerMinute: int32(limit.EndUserRequestsPerMinute), //nolint:gosec // validated by the api
+		Burst:                    int32(limit.Burst),                    //nolint:gosec // validated by the api
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DeleteRateLimit(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) error {
+	rows, err := r.queries.deleteRateLimit(ctx, deleteRateLimitParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	if rows == 0 {
+		return r.errorDecoder(pgx.ErrNoRows)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveRateLimit(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) (*model.RateLimit, error) {
+	row, err := r.queries.rateLimitByWorkflowId(ctx, rateLimitByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return row.domain(), nil
+}
+
+func (r Repository) ListProjectRateLimits(ctx context.Context, projectID uuid.UUID) ([]query.RateLimit, error) {
+	rows, err := r.queries.rateLimitsByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	limits := make([]query.RateLimit, len(rows))
+	for i, row := range rows {
+		limits[i] = row.query()
+	}
+	return limits, nil
+}
+
+func (r Repository) ReadWorkflowRateLimit(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) (query.RateLimit, error) {
+	row, err := r.queries.rateLimitByWorkflowId(ctx, rateLimitByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return query.RateLimit{}, r.errorDecoder(err)
+	}
+	return row.query(), nil
+}
+
+func (l RateLimit) domain() *model.RateLimit {
+	return &model.RateLimit{
+		ProjectID:                l.ProjectID,
+		WorkflowID:               model.WorkflowID(l.WorkflowID),
+		RequestsPerMinute:        int(l.RequestsPerMinute),
+		APIKeyRequestsPerMinute:  int(l.ApiKeyRequestsPerMinute),
+		EndUserRequestsPerMinute: int(l.EndUserRequestsPerMinute),
+		Burst:                    int(l.Burst),
+	}
+}
+
+func (l RateLimit) query() query.RateLimit {
+	return query.RateLimit{
+		WorkflowID:               l.WorkflowID,
+		RequestsPerMinute:        int(l.RequestsPerMinute),
+		APIKeyRequestsPerMinute:  int(l.ApiKeyRequestsPerMinute),
+		EndUserRequestsPerMinute: int(l.EndUserRequestsPerMinute),
+		Burst:                    int(l.Burst),
+		CreatedAt:                l.CreatedAt.Time,
+		UpdatedAt:                l.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,217 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := New(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) SaveTrafficSplit(ctx context.Context, split *model.TrafficSplit) error {
+	variants, err := json.Marshal(split.Variants)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.upsertTrafficSplit(ctx, upsertTrafficSplitParams{
+		WorkflowID: split.WorkflowID.String(),
+		ProjectID:  split.ProjectID,
+		Variants:   variants,
+		Enabled:    split.Enabled,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveTrafficSplit(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) (*model.TrafficSplit, error) {
+	split, err := r.queries.trafficSplitByWorkflowId(ctx, trafficSplitByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return split.domain()
+}
+
+func (r Repository) DeleteTrafficSplit(ctx context.Context, workflowID model.WorkflowID) error {
+	err := r.queries.deleteTrafficSplit(ctx, workflowID.String())
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) CreateExecution(ctx context.Context, execution *model.Execution) error {
+	err := r.queries.storeExecution(ctx, storeExecutionParams{
+		TriggerID:         execution.TriggerID,
+		ProjectID:         execution.ProjectID,
+		WorkflowID:        execution.WorkflowID.String(),
+		VariantWorkflowID: execution.VariantID.String(),
+		Revision:          execution.Revision,
+		SessionID:         execution.SessionID,
+		EndUserID:         execution.EndUserID,
+		Status:            string(execution.Status),
+		StartedAt:         timestamptz(&execution.StartedAt),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveExecution(ctx context.Context, triggerID uuid.UUID) (*model.Execution, error) {
+	execution, err := r.queries.executionByTriggerId(ctx, triggerID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return execution.domain(), nil
+}
+
+func (r Repository) FinishExecution(ctx context.Context, execution *model.Execution) error {
+	_, err := r.queries.finishExecution(ctx, finishExecutionParams{
+		TriggerID:  execution.TriggerID,
+		Status:     string(execution.Status),
+		Error:      execution.Error,
+		FinishedAt: timestamptz(execution.FinishedAt),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ReadTrafficSplit(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) (query.TrafficSplit, error) {
+	split, err := r.queries.trafficSplitByWorkflowId(ctx, trafficSplitByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return query.TrafficSplit{}, r.errorDecoder(err)
+	}
+	return split.query()
+}
+
+func (r Repository) ReadExecutionVariants(
+	ctx context.Context,
+	triggerIDs []uuid.UUID,
+) (map[uuid.UUID]query.ExecutionVariant, error) {
+	executions, err := r.queries.executionsByTriggerIds(ctx, triggerIDs)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	variants := make(map[uuid.UUID]query.ExecutionVariant, len(executions))
+	for _, execution := range executions {
+		variants[execution.TriggerID] = query.ExecutionVariant{
+			WorkflowID: execution.VariantWorkflowID,
+			Revision:   execution.Revision,
+			EndUserID:  execution.EndUserID,
+		}
+	}
+	return variants, nil
+}
+
+func (r Repository) ListVariantStats(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	since time.Time,
+) ([]query.VariantStats, error) {
+	rows, err := r.queries.variantStatsByWorkflowId(ctx, variantStatsByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+		StartedAt:  timestamptz(&since),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	stats := make([]query.VariantStats, len(rows))
+	for i, row := range rows {
+		stats[i] = row.query()
+	}
+	return stats, nil
+}
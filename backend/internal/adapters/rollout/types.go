@@ -0,0 +1,85 @@
+package rollout
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (s WorkflowTrafficSplit) domain() (*model.TrafficSplit, error) {
+	var variants []model.TrafficSplitVariant
+	if err := json.Unmarshal(s.Variants, &variants); err != nil {
+		return nil, err
+	}
+
+	return &model.TrafficSplit{
+		ProjectID:  s.ProjectID,
+		WorkflowID: model.WorkflowID(s.WorkflowID),
+		Variants:   variants,
+		Enabled:    s.Enabled,
+	}, nil
+}
+
+func (s WorkflowTrafficSplit) query() (query.TrafficSplit, error) {
+	var variants []model.TrafficSplitVariant
+	if err := json.Unmarshal(s.Variants, &variants); err != nil {
+		return query.TrafficSplit{}, err
+	}
+
+	return query.TrafficSplit{
+		WorkflowID: s.WorkflowID,
+		Variants:   variants,
+		Enabled:    s.Enabled,
+		CreatedAt:  s.CreatedAt.Time,
+		UpdatedAt:  s.UpdatedAt.Time,
+	}, nil
+}
+
+func (e WorkflowExecution) domain() *model.Execution {
+	return &model.Execution{
+		TriggerID:  e.TriggerID,
+		ProjectID:  e.ProjectID,
+		WorkflowID: model.WorkflowID(e.WorkflowID),
+		SessionID:  e.SessionID,
+		EndUserID:  e.EndUserID,
+		VariantID:  model.WorkflowID(e.VariantWorkflowID),
+		Revision:   e.Revision,
+		Status:     model.ExecutionStatus(e.Status),
+		Error:      e.Error,
+		StartedAt:  e.StartedAt.Time,
+		FinishedAt: timePtr(e.FinishedAt),
+	}
+}
+
+func (r variantStatsByWorkflowIdRow) query() query.VariantStats {
+	return query.VariantStats{
+		WorkflowID:     r.VariantWorkflowID,
+		Revision:       r.Revision,
+		Executions:     int(r.Executions),
+		Succeeded:      int(r.Succeeded),
+		Failed:         int(r.Failed),
+		AvgLatencyMs:   r.AvgLatencyMs,
+		P95LatencyMs:   r.P95LatencyMs,
+		EvalCases:      int(r.EvalCases),
+		EvalPassed:     int(r.EvalPassed),
+		FirstStartedAt: r.FirstStartedAt.Time,
+		LastStartedAt:  r.LastStartedAt.Time,
+	}
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -58,6 +58,64 @@ func (s *Service) QueueWorkflow(
 	return nil
 }
 
+func (s *Service) CancelWorkflow(
+	ctx context.Context,
+	triggerID uuid.UUID,
+	workflow *model.Workflow,
+) error {
+	return s.publishControl(ctx, workflowControlMessage{
+		Type:       controlCancel,
+		WorkflowID: workflow.ID,
+		TriggerID:  triggerID,
+		ProjectID:  workflow.ProjectID,
+		NodeID:     "",
+		Inputs:     nil,
+	})
+}
+
+// AnswerHumanInput resumes the human input node of a running workflow.
+func (s *Service) AnswerHumanInput(
+	ctx context.Context,
+	triggerID uuid.UUID,
+	workflow *model.Workflow,
+	nodeID string,
+	inputs map[string]any,
+) error {
+	return s.publishControl(ctx, workflowControlMessage{
+		Type:       controlHumanInput,
+		WorkflowID: workflow.ID,
+		TriggerID:  triggerID,
+		ProjectID:  workflow.ProjectID,
+		NodeID:     nodeID,
+		Inputs:     inputs,
+	})
+}
+
+func (s *Service) publishControl(_ context.Context, controlMsg workflowControlMessage) error {
+	payload, err := json.Marshal(controlMsg)
+	if err != nil {
+		slog.Error("failed to create message", "error", err)
+		return err
+	}
+
+	msg := message.NewMessage(uuid.New().String(), payload)
+	event.SetCorrelationID(msg, controlMsg.TriggerID.String())
+
+	slog.Info("publishing workflow control message",
+		"type", controlMsg.Type,
+		"workflow_id", controlMsg.WorkflowID,
+		"trigger_id", controlMsg.TriggerID,
+		"topic", event.DownstreamWorkflowControlTopic)
+
+	err = s.publisher.Publish(event.DownstreamWorkflowControlTopic, msg)
+	if err != nil {
+		slog.Error("failed to publish message", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 type workflowQueueMessage struct {
 	WorkflowID model.WorkflowID `json:"workflow_id"`
 	TriggerID  uuid.UUID        `json:"trigger_id"`
@@ -67,6 +125,24 @@ type workflowQueueMessage struct {
 	Inputs     map[string]any   `json:"inputs"`
 }
 
+type controlType string
+
+const (
+	controlCancel     controlType = "cancel"
+	controlHumanInput controlType = "human_input"
+)
+
+// workflowControlMessage targets a running workflow, every runner reads
+// it and the one executing the trigger applies it.
+type workflowControlMessage struct {
+	Type       controlType      `json:"type"`
+	WorkflowID model.WorkflowID `json:"workflow_id"`
+	TriggerID  uuid.UUID        `json:"trigger_id"`
+	ProjectID  uuid.UUID        `json:"project_id"`
+	NodeID     string           `json:"node_id,omitempty"`
+	Inputs     map[string]any   `json:"inputs,omitempty"`
+}
+
 func (q workflowQueueMessage) ToMessage() (*message.Message, error) {
 	payload, err := json.Marshal(q)
 	if err != nil {
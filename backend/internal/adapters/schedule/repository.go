@@ -0,0 +1,206 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+	}
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) CreateSchedule(ctx context.Context, schedule *model.Schedule) error {
+	inputs, err := json.Marshal(schedule.Inputs)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.storeSchedule(ctx, storeScheduleParams{
+		ID:             schedule.ID,
+		ProjectID:      schedule.ProjectID,
+		WorkflowID:     schedule.WorkflowID.String(),
+		CronExpression: schedule.CronExpression,
+		Timezone:       schedule.Timezone,
+		Inputs:         inputs,
+		Enabled:        schedule.Enabled,
+		NextFireAt:     timestamptz(&schedule.NextFireAt),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveSchedule(ctx context.Context, id uuid.UUID) (*model.Schedule, error) {
+	schedule, err := r.queries.scheduleById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return schedule.domain()
+}
+
+func (r Repository) UpdateSchedule(ctx context.Context, schedule *model.Schedule) error {
+	inputs, err := json.Marshal(schedule.Inputs)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.updateSchedule(ctx, updateScheduleParams{
+		ID:             schedule.ID,
+		CronExpression: schedule.CronExpression,
+		Timezone:       schedule.Timezone,
+		Inputs:         inputs,
+		Enabled:        schedule.Enabled,
+		NextFireAt:     timestamptz(&schedule.NextFireAt),
+		LastFiredAt:    timestamptz(schedule.LastFiredAt),
+		LastTriggerID:  pgUUID(schedule.LastTriggerID),
+		LastError:      schedule.LastError,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RecordScheduleFire(
+	ctx context.Context,
+	schedule *model.Schedule,
+	previousFireAt time.Time,
+) (bool, error) {
+	rows, err := r.queries.recordScheduleFire(ctx, recordScheduleFireParams{
+		ID:            schedule.ID,
+		NextFireAt:    timestamptz(&schedule.NextFireAt),
+		LastFiredAt:   timestamptz(schedule.LastFiredAt),
+		LastTriggerID: pgUUID(schedule.LastTriggerID),
+		NextFireAt_2:  timestamptz(&previousFireAt),
+	})
+	if err != nil {
+		return false, r.errorDecoder(err)
+	}
+	return rows > 0, nil
+}
+
+func (r Repository) RecordScheduleError(ctx context.Context, id uuid.UUID, lastError string) error {
+	err := r.queries.recordScheduleError(ctx, recordScheduleErrorParams{
+		ID:        id,
+		LastError: lastError,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DisableSchedule(ctx context.Context, schedule *model.Schedule) error {
+	err := r.queries.disableSchedule(ctx, disableScheduleParams{
+		ID:         schedule.ID,
+		LastError:  schedule.LastError,
+		NextFireAt: timestamptz(&schedule.NextFireAt),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DeleteSchedule(ctx context.Context, id uuid.UUID) error {
+	err := r.queries.deleteSchedule(ctx, id)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]*model.Schedule, error) {
+	schedules, err := r.queries.dueSchedules(ctx, dueSchedulesParams{
+		NextFireAt: timestamptz(&now),
+		Limit:      int32(limit), //nolint:gosec // small batch size
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainSchedules := make([]*model.Schedule, len(schedules))
+	for i, schedule := range schedules {
+		domainSchedules[i], err = schedule.domain()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return domainSchedules, nil
+}
+
+func (r Repository) ReadSchedule(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (query.Schedule, error) {
+	schedule, err := r.queries.scheduleById(ctx, id)
+	if err != nil {
+		return query.Schedule{}, r.errorDecoder(err)
+	}
+
+	if schedule.ProjectID != projectID {
+		return query.Schedule{}, fmt.Errorf("%w: schedule %s", adapterrors.ErrNotFound, id)
+	}
+
+	return schedule.query()
+}
+
+func (r Repository) ListSchedules(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) ([]query.Schedule, error) {
+	schedules, err := r.queries.schedulesByWorkflowId(ctx, schedulesByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	querySchedules := make([]query.Schedule, len(schedules))
+	for i, schedule := range schedules {
+		querySchedules[i], err = schedule.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return querySchedules, nil
+}
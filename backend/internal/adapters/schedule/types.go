@@ -0,0 +1,83 @@
+package schedule
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (s WorkflowSchedule) domain() (*model.Schedule, error) {
+	var inputs map[string]any
+	if err := json.Unmarshal(s.Inputs, &inputs); err != nil {
+		return nil, err
+	}
+
+	return &model.Schedule{
+		ID:             s.ID,
+		ProjectID:      s.ProjectID,
+		WorkflowID:     model.WorkflowID(s.WorkflowID),
+		CronExpression: s.CronExpression,
+		Timezone:       s.Timezone,
+		Inputs:         inputs,
+		Enabled:        s.Enabled,
+		NextFireAt:     s.NextFireAt.Time,
+		LastFiredAt:    timePtr(s.LastFiredAt),
+		LastTriggerID:  uuidPtr(s.LastTriggerID),
+		LastError:      s.LastError,
+	}, nil
+}
+
+func (s WorkflowSchedule) query() (query.Schedule, error) {
+	var inputs map[string]any
+	if err := json.Unmarshal(s.Inputs, &inputs); err != nil {
+		return query.Schedule{}, err
+	}
+
+	return query.Schedule{
+		ID:             s.ID,
+		WorkflowID:     s.WorkflowID,
+		CronExpression: s.CronExpression,
+		Timezone:       s.Timezone,
+		Inputs:         inputs,
+		Enabled:        s.Enabled,
+		NextFireAt:     s.NextFireAt.Time,
+		LastFiredAt:    timePtr(s.LastFiredAt),
+		LastTriggerID:  uuidPtr(s.LastTriggerID),
+		LastError:      s.LastError,
+		CreatedAt:      s.CreatedAt.Time,
+		UpdatedAt:      s.UpdatedAt.Time,
+	}, nil
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func pgUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func uuidPtr(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
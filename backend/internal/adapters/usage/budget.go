@@ -0,0 +1,208 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (r Repository) CreateBudget(ctx context.Context, budget *model.Budget) error {
+	err := r.queries.storeBudget(ctx, storeBudgetParams{
+		ID:           budget.ID,
+		ProjectID:    budget.ProjectID,
+		Scope:        string(budget.Scope),
+		WorkflowID:   nullText(budget.WorkflowID.String()),
+		CredentialID: nullUUID(budget.CredentialID),
+		Period:       string(budget.Period),
+		LimitUsd:     budget.LimitUSD,
+		Thresholds:   int32s(budget.Thresholds),
+		Hard:         budget.Hard,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveBudget(ctx context.Context, id uuid.UUID) (*model.Budget, error) {
+	budget, err := r.queries.budgetById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return budget.domain(), nil
+}
+
+func (r Repository) UpdateBudget(ctx context.Context, budget *model.Budget) error {
+	err := r.queries.updateBudget(ctx, updateBudgetParams{
+		ID:               budget.ID,
+		Period:           string(budget.Period),
+		LimitUsd:         budget.LimitUSD,
+		Thresholds:       int32s(budget.Thresholds),
+		Hard:             budget.Hard,
+		AlertedThreshold: int32(budget.AlertedThreshold), //nolint:gosec // percentage
+		AlertedPeriod:    nullTimestamptz(budget.AlertedPeriod),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DeleteBudget(ctx context.Context, id uuid.UUID) error {
+	err := r.queries.deleteBudget(ctx, id)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ListBudgets(ctx context.Context, projectID uuid.UUID) ([]*model.Budget, error) {
+	budgets, err := r.queries.budgetsByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainBudgets := make([]*model.Budget, len(budgets))
+	for i, budget := range budgets {
+		domainBudgets[i] = budget.domain()
+	}
+	return domainBudgets, nil
+}
+
+func (r Repository) MarkBudgetAlerted(
+	ctx context.Context,
+	budget *model.Budget,
+	deliveries []*model.WebhookDelivery,
+) (bool, error) {
+	marked := false
+	err := r.withTx(ctx, func(q *Queries) error {
+		n, err := q.markBudgetAlerted(ctx, markBudgetAlertedParams{
+			ID:               budget.ID,
+			AlertedThreshold: int32(budget.AlertedThreshold), //nolint:gosec // percentage
+			AlertedPeriod:    nullTimestamptz(budget.AlertedPeriod),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+		if n == 0 {
+			return nil
+		}
+
+		for _, delivery := range deliveries {
+			err = q.storeBudgetAlertDelivery(ctx, storeBudgetAlertDeliveryParams{
+				ID:            delivery.ID,
+				WebhookID:     delivery.WebhookID,
+				EventType:     delivery.EventType.String(),
+				TriggerID:     delivery.TriggerID,
+				Payload:       delivery.Payload,
+				Status:        string(delivery.Status),
+				NextAttemptAt: timestamptz(delivery.NextAttemptAt),
+			})
+			if err != nil {
+				return r.errorDecoder(err)
+			}
+		}
+
+		marked = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return marked, nil
+}
+
+func (r Repository) Spends(ctx context.Context, budgets []*model.Budget, at time.Time) (map[uuid.UUID]float64, error) {
+	spends := make(map[uuid.UUID]float64, len(budgets))
+	if len(budgets) == 0 {
+		return spends, nil
+	}
+
+	ids := make([]uuid.UUID, len(budgets))
+	fromAts := make([]pgtype.Timestamptz, len(budgets))
+	for i, budget := range budgets {
+		ids[i] = budget.ID
+		fromAts[i] = timestamptz(budget.PeriodStart(at))
+	}
+
+	rows, err := r.queries.budgetSpends(ctx, budgetSpendsParams{
+		BudgetIds: ids,
+		FromAts:   fromAts,
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	for _, row := range rows {
+		spends[row.BudgetID] = row.SpentUsd
+	}
+	return spends, nil
+}
+
+func (r Repository) ReadBudget(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (query.Budget, error) {
+	budget, err := r.queries.budgetById(ctx, id)
+	if err != nil {
+		return query.Budget{}, r.errorDecoder(err)
+	}
+
+	if budget.ProjectID != projectID {
+		return query.Budget{}, fmt.Errorf("%w: budget %s", adapterrors.ErrNotFound, id)
+	}
+
+	domainBudget := budget.domain()
+	now := time.Now()
+	spends, err := r.Spends(ctx, []*model.Budget{domainBudget}, now)
+	if err != nil {
+		return query.Budget{}, err
+	}
+	return budget.query(domainBudget.PeriodStart(now), spends[budget.ID]), nil
+}
+
+func (r Repository) ListProjectBudgets(ctx context.Context, projectID uuid.UUID) ([]query.Budget, error) {
+	budgets, err := r.queries.budgetsByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainBudgets := make([]*model.Budget, len(budgets))
+	for i, budget := range budgets {
+		domainBudgets[i] = budget.domain()
+	}
+
+	now := time.Now()
+	spends, err := r.Spends(ctx, domainBudgets, now)
+	if err != nil {
+		return nil, err
+	}
+
+	queryBudgets := make([]query.Budget, len(budgets))
+	for i, budget := range budgets {
+		queryBudgets[i] = budget.query(domainBudgets[i].PeriodStart(now), spends[budget.ID])
+	}
+	return queryBudgets, nil
+}
+
+func nullText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func nullTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return timestamptz(*t)
+}
+
+func int32s(values []int) []int32 {
+	ints := make([]int32, len(values))
+	for i, v := range values {
+		ints[i] = int32(v) //nolint:gosec // percentages
+	}
+	return ints
+}
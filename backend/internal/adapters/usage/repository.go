@@ -0,0 +1,235 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := New(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) AddNodeUsage(ctx context.Context, usage *model.NodeUsage) error {
+	err := r.queries.storeTokenUsage(ctx, storeTokenUsageParams{
+		TriggerID:         usage.TriggerID,
+		Sequence:          int64(usage.Sequence), //nolint:gosec // event sequences are small
+		ProjectID:         usage.ProjectID,
+		WorkflowID:        usage.WorkflowID.String(),
+		VariantWorkflowID: usage.VariantID.String(),
+		NodeID:            usage.NodeID,
+		NodeType:          usage.NodeType,
+		CredentialID:      nullUUID(usage.CredentialID),
+		Model:             usage.Usage.Model,
+		InputTokens:       usage.Usage.InputTokens,
+		OutputTokens:      usage.Usage.OutputTokens,
+		CostUsd:           usage.Cost,
+		Priced:            usage.Priced,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ReadExecutionUsage(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	triggerID uuid.UUID,
+) ([]query.NodeUsage, error) {
+	rows, err := r.queries.usageByTriggerId(ctx, usageByTriggerIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+		TriggerID:  triggerID,
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	nodes := make([]query.NodeUsage, len(rows))
+	for i, row := range rows {
+		nodes[i] = row.query()
+	}
+	return nodes, nil
+}
+
+func (r Repository) ReadUsageTotals(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) (query.UsageTotals, error) {
+	row, err := r.queries.usageTotals(ctx, usageTotalsParams{
+		ProjectID: projectID,
+		FromAt:    timestamptz(from),
+		ToAt:      timestamptz(to),
+	})
+	if err != nil {
+		return query.UsageTotals{}, r.errorDecoder(err)
+	}
+	return totals(row.Executions, row.Calls, row.InputTokens, row.OutputTokens, row.CostUsd, row.Unpriced), nil
+}
+
+func (r Repository) ListModelUsage(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) ([]query.ModelUsage, error) {
+	rows, err := r.queries.usageByModel(ctx, usageByModelParams{
+		ProjectID: projectID,
+		FromAt:    timestamptz(from),
+		ToAt:      timestamptz(to),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	usage := make([]query.ModelUsage, len(rows))
+	for i, row := range rows {
+		usage[i] = query.ModelUsage{
+			Model: row.Model,
+			Usage: totals(row.Executions, row.Calls, row.InputTokens, row.OutputTokens, row.CostUsd, row.Unpriced),
+		}
+	}
+	return usage, nil
+}
+
+func (r Repository) ListDailyUsage(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) ([]query.DailyUsage, error) {
+	rows, err := r.queries.usageByDay(ctx, usageByDayParams{
+		ProjectID: projectID,
+		FromAt:    timestamptz(from),
+		ToAt:      timestamptz(to),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	usage := make([]query.DailyUsage, len(rows))
+	for i, row := range rows {
+		usage[i] = query.DailyUsage{
+			Day:   row.Day.Time,
+			Usage: totals(row.Executions, row.Calls, row.InputTokens, row.OutputTokens, row.CostUsd, row.Unpriced),
+		}
+	}
+	return usage, nil
+}
+
+func (r Repository) ListWorkflowUsage(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) ([]query.WorkflowUsage, error) {
+	rows, err := r.queries.usageByWorkflow(ctx, usageByWorkflowParams{
+		ProjectID: projectID,
+		FromAt:    timestamptz(from),
+		ToAt:      timestamptz(to),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	usage := make([]query.WorkflowUsage, len(rows))
+	for i, row := range rows {
+		usage[i] = query.WorkflowUsage{
+			WorkflowID: row.WorkflowID,
+			Usage:      totals(row.Executions, row.Calls, row.InputTokens, row.OutputTokens, row.CostUsd, row.Unpriced),
+		}
+	}
+	return usage, nil
+}
+
+func (r Repository) ListCredentialUsage(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) ([]query.CredentialUsage, error) {
+	rows, err := r.queries.usageByCredential(ctx, usageByCredentialParams{
+		ProjectID: projectID,
+		FromAt:    timestamptz(from),
+		ToAt:      timestamptz(to),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	usage := make([]query.CredentialUsage, len(rows))
+	for i, row := range rows {
+		usage[i] = query.CredentialUsage{
+			CredentialID: uuidPtr(row.CredentialID),
+			Usage:        totals(row.Executions, row.Calls, row.InputTokens, row.OutputTokens, row.CostUsd, row.Unpriced),
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,104 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+func (b Budget) domain() *model.Budget {
+	thresholds := make([]int, len(b.Thresholds))
+	for i, t := range b.Thresholds {
+		thresholds[i] = int(t)
+	}
+
+	return &model.Budget{
+		ID:               b.ID,
+		ProjectID:        b.ProjectID,
+		Scope:            model.BudgetScope(b.Scope),
+		WorkflowID:       model.WorkflowID(b.WorkflowID.String),
+		CredentialID:     uuidPtr(b.CredentialID),
+		Period:           model.BudgetPeriod(b.Period),
+		LimitUSD:         b.LimitUsd,
+		Thresholds:       thresholds,
+		Hard:             b.Hard,
+		AlertedThreshold: int(b.AlertedThreshold),
+		AlertedPeriod:    timePtr(b.AlertedPeriod),
+	}
+}
+
+func (b Budget) query(periodStart time.Time, spent float64) query.Budget {
+	thresholds := make([]int, len(b.Thresholds))
+	for i, t := range b.Thresholds {
+		thresholds[i] = int(t)
+	}
+
+	return query.Budget{
+		ID:           b.ID,
+		Scope:        b.Scope,
+		WorkflowID:   b.WorkflowID.String,
+		CredentialID: uuidPtr(b.CredentialID),
+		Period:       b.Period,
+		LimitUSD:     b.LimitUsd,
+		Thresholds:   thresholds,
+		Hard:         b.Hard,
+		PeriodStart:  periodStart,
+		SpentUSD:     spent,
+		CreatedAt:    b.CreatedAt.Time,
+		UpdatedAt:    b.UpdatedAt.Time,
+	}
+}
+
+func (r usageByTriggerIdRow) query() query.NodeUsage {
+	return query.NodeUsage{
+		NodeID:       r.NodeID,
+		NodeType:     r.NodeType,
+		Model:        r.Model,
+		CredentialID: uuidPtr(r.CredentialID),
+		Calls:        int(r.Calls),
+		InputTokens:  r.InputTokens,
+		OutputTokens: r.OutputTokens,
+		CostUSD:      r.CostUsd,
+		Unpriced:     int(r.Unpriced),
+	}
+}
+
+func totals(executions, calls, inputTokens, outputTokens int64, cost float64, unpriced int64) query.UsageTotals {
+	return query.UsageTotals{
+		Executions:   int(executions),
+		Calls:        int(calls),
+		InputTokens:  inputTokens,
+		OutputTokens: outputTokens,
+		CostUSD:      cost,
+		Unpriced:     int(unpriced),
+	}
+}
+
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func nullUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{Bytes: uuid.Nil, Valid: false}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func uuidPtr(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	v := uuid.UUID(id.Bytes)
+	return &v
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
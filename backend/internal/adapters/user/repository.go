@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -12,7 +14,6 @@ import (
 	adapterrors "github.com/supallm/core/internal/adapters/errors"
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/query"
-	"github.com/supallm/core/internal/pkg/auth"
 )
 
 type repository struct {
@@ -51,15 +52,45 @@ func (r repository) errorDecoder(err error) error {
 	return err
 }
 
+func (r repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := r.q.WithTx(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
 func (r repository) CreateUser(ctx context.Context, u *model.User) error {
 	_, err := r.q.createUser(ctx, createUserParams{
-		ID:           u.ID,
-		Email:        u.Email,
-		Name:         u.Name,
-		PasswordHash: u.PasswordHash.String(),
+		ID:                 u.ID,
+		Email:              u.Email,
+		Name:               u.Name,
+		PasswordHash:       u.PasswordHash.String(),
+		IsAdmin:            u.Admin,
+		MustChangePassword: u.MustChangePassword,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create user: %w", err)
+		return fmt.Errorf("failed to create user: %w", r.errorDecoder(err))
 	}
 
 	return nil
@@ -83,6 +114,237 @@ func (r repository) GetUserByID(ctx context.Context, id uuid.UUID) (*model.User,
 	return mapDBUserToDomain(u), nil
 }
 
+func (r repository) UpdateUser(ctx context.Context, u *model.User) error {
+	return r.errorDecoder(r.q.updateUser(ctx, updateUserParamsFromDomain(u)))
+}
+
+func (r repository) UseSecondFactor(ctx context.Context, userID uuid.UUID, factor model.SecondFactor) error {
+	var (
+		used int64
+		err  error
+	)
+	if factor.RecoveryCodeDigest != "" {
+		used, err = r.q.useRecoveryCode(ctx, useRecoveryCodeParams{
+			Digest: factor.RecoveryCodeDigest,
+			ID:     userID,
+		})
+	} else {
+		used, err = r.q.useTOTPStep(ctx, useTOTPStepParams{
+			ID:           userID,
+			TotpLastStep: factor.TOTPStep,
+		})
+	}
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	if used == 0 {
+		return fmt.Errorf("%w: code already used", adapterrors.ErrConflict)
+	}
+	return nil
+}
+
+func (r repository) CreateInvitation(ctx context.Context, i *model.Invitation) error {
+	err := r.q.createInvitation(ctx, createInvitationParams{
+		ID:          i.ID,
+		Email:       i.Email,
+		Name:        i.Name,
+		IsAdmin:     i.Admin,
+		TokenDigest: i.TokenDigest,
+		InvitedBy:   i.InvitedBy,
+		ExpiresAt:   timestamptz(&i.ExpiresAt),
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) RetrieveInvitationByToken(ctx context.Context, tokenDigest string) (*model.Invitation, error) {
+	i, err := r.q.invitationByTokenDigest(ctx, tokenDigest)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	return mapDBInvitationToDomain(i), nil
+}
+
+func (r repository) AcceptInvitation(ctx context.Context, i *model.Invitation, u *model.User) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		accepted, err := q.acceptInvitation(ctx, acceptInvitationParams{
+			ID:         i.ID,
+			AcceptedAt: timestamptz(i.AcceptedAt),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+		if accepted == 0 {
+			return fmt.Errorf("%w: invitation already accepted", adapterrors.ErrConflict)
+		}
+
+		_, err = q.createUser(ctx, createUserParams{
+			ID:                 u.ID,
+			Email:              u.Email,
+			Name:               u.Name,
+			PasswordHash:       u.PasswordHash.String(),
+			IsAdmin:            u.Admin,
+			MustChangePassword: u.MustChangePassword,
+		})
+		return r.errorDecoder(err)
+	})
+}
+
+func (r repository) DeleteInvitation(ctx context.Context, id uuid.UUID) error {
+	deleted, err := r.q.deleteInvitation(ctx, id)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: invitation %s", adapterrors.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r repository) CreatePasswordReset(ctx context.Context, p *model.PasswordReset) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		if err := q.deletePendingPasswordResets(ctx, p.UserID); err != nil {
+			return r.errorDecoder(err)
+		}
+
+		err := q.createPasswordReset(ctx, createPasswordResetParams{
+			TokenDigest: p.TokenDigest,
+			UserID:      p.UserID,
+			ExpiresAt:   timestamptz(&p.ExpiresAt),
+		})
+		return r.errorDecoder(err)
+	})
+}
+
+func (r repository) RetrievePasswordReset(ctx context.Context, tokenDigest string) (*model.PasswordReset, error) {
+	p, err := r.q.passwordResetByTokenDigest(ctx, tokenDigest)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	return &model.PasswordReset{
+		TokenDigest: p.TokenDigest,
+		UserID:      p.UserID,
+		ExpiresAt:   p.ExpiresAt.Time,
+		UsedAt:      timePtr(p.UsedAt),
+	}, nil
+}
+
+func (r repository) UsePasswordReset(ctx context.Context, p *model.PasswordReset, u *model.User) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		used, err := q.usePasswordReset(ctx, usePasswordResetParams{
+			TokenDigest: p.TokenDigest,
+			UsedAt:      timestamptz(p.UsedAt),
+		})
+		if err != nil {
+			return r.errorDecoder(err)
+		}
+		if used == 0 {
+			return fmt.Errorf("%w: password reset already used", adapterrors.ErrConflict)
+		}
+
+		return r.errorDecoder(q.updateUser(ctx, updateUserParamsFromDomain(u)))
+	})
+}
+
+func (r repository) CreateSession(ctx context.Context, s *model.Session) error {
+	err := r.q.createSession(ctx, createSessionParams{
+		ID:          s.ID,
+		UserID:      s.UserID,
+		TokenDigest: s.TokenDigest,
+		UserAgent:   s.UserAgent,
+		IpAddress:   s.IPAddress,
+		ExpiresAt:   timestamptz(&s.ExpiresAt),
+		LastUsedAt:  timestamptz(&s.LastUsedAt),
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) RetrieveSession(ctx context.Context, id uuid.UUID) (*model.Session, error) {
+	s, err := r.q.sessionById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	return mapDBSessionToDomain(s), nil
+}
+
+func (r repository) RetrieveSessionByToken(ctx context.Context, tokenDigest string) (*model.Session, error) {
+	s, err := r.q.sessionByTokenDigest(ctx, tokenDigest)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	return mapDBSessionToDomain(s), nil
+}
+
+func (r repository) ListActiveSessions(ctx context.Context, userID uuid.UUID) ([]*model.Session, error) {
+	sessions, err := r.q.activeUserSessions(ctx, userID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]*model.Session, len(sessions))
+	for i, s := range sessions {
+		result[i] = mapDBSessionToDomain(s)
+	}
+	return result, nil
+}
+
+func (r repository) RotateSession(ctx context.Context, s *model.Session) error {
+	rotated, err := r.q.rotateSession(ctx, rotateSessionParams{
+		ID:                  s.ID,
+		TokenDigest:         s.TokenDigest,
+		PreviousTokenDigest: text(s.PreviousTokenDigest),
+		IpAddress:           s.IPAddress,
+		ExpiresAt:           timestamptz(&s.ExpiresAt),
+		LastUsedAt:          timestamptz(&s.LastUsedAt),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	if rotated == 0 {
+		return fmt.Errorf("%w: session already refreshed", adapterrors.ErrConflict)
+	}
+	return nil
+}
+
+func (r repository) RevokeSession(ctx context.Context, s *model.Session) error {
+	err := r.q.revokeSession(ctx, revokeSessionParams{
+		ID:        s.ID,
+		RevokedAt: timestamptz(s.RevokedAt),
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) RevokeUserSessions(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	err := r.q.revokeUserSessions(ctx, revokeUserSessionsParams{
+		UserID:    userID,
+		RevokedAt: timestamptz(&now),
+	})
+	return r.errorDecoder(err)
+}
+
+func (r repository) ListUserSessions(ctx context.Context, userID uuid.UUID) ([]query.Session, error) {
+	sessions, err := r.q.activeUserSessions(ctx, userID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]query.Session, len(sessions))
+	for i, s := range sessions {
+		result[i] = query.Session{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IpAddress,
+			ExpiresAt:  s.ExpiresAt.Time,
+			LastUsedAt: s.LastUsedAt.Time,
+			CreatedAt:  s.CreatedAt.Time,
+		}
+	}
+	return result, nil
+}
+
 func (r repository) ReadUser(ctx context.Context, email string) (query.User, error) {
 	u, err := r.q.getUserByEmail(ctx, email)
 	if err != nil {
@@ -92,21 +354,45 @@ func (r repository) ReadUser(ctx context.Context, email string) (query.User, err
 	return mapDBUserToQuery(u), nil
 }
 
-func mapDBUserToDomain(u User) *model.User {
-	return &model.User{
-		ID:           u.ID,
-		Email:        u.Email,
-		Name:         u.Name,
-		PasswordHash: auth.Hash(u.PasswordHash),
+func (r repository) ReadUserByID(ctx context.Context, id uuid.UUID) (query.User, error) {
+	u, err := r.q.getUserByID(ctx, id)
+	if err != nil {
+		return query.User{}, r.errorDecoder(err)
 	}
+
+	return mapDBUserToQuery(u), nil
+}
+
+func (r repository) ListUsers(ctx context.Context) ([]query.User, error) {
+	users, err := r.q.listUsers(ctx)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]query.User, len(users))
+	for i, u := range users {
+		result[i] = mapDBUserToQuery(u)
+	}
+	return result, nil
 }
 
-func mapDBUserToQuery(u User) query.User {
-	return query.User{
-		ID:        u.ID,
-		Email:     u.Email,
-		Name:      u.Name,
-		CreatedAt: u.CreatedAt.Time,
-		UpdatedAt: u.UpdatedAt.Time,
+func (r repository) ListPendingInvitations(ctx context.Context) ([]query.Invitation, error) {
+	invitations, err := r.q.pendingInvitations(ctx)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	result := make([]query.Invitation, len(invitations))
+	for i, inv := range invitations {
+		result[i] = query.Invitation{
+			ID:        inv.ID,
+			Email:     inv.Email,
+			Name:      inv.Name,
+			Admin:     inv.IsAdmin,
+			InvitedBy: inv.InvitedBy,
+			ExpiresAt: inv.ExpiresAt.Time,
+			CreatedAt: inv.CreatedAt.Time,
+		}
 	}
+	return result, nil
 }
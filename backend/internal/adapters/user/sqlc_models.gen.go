@@ -9,11 +9,51 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type PasswordReset struct {
+	TokenDigest string             `json:"token_digest"`
+	UserID      uuid.UUID          `json:"user_id"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	UsedAt      pgtype.Timestamptz `json:"used_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type User struct {
-	ID           uuid.UUID          `json:"id"`
-	Email        string             `json:"email"`
-	Name         string             `json:"name"`
-	PasswordHash string             `json:"password_hash"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID                  uuid.UUID          `json:"id"`
+	Email               string             `json:"email"`
+	Name                string             `json:"name"`
+	PasswordHash        string             `json:"password_hash"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	IsAdmin             bool               `json:"is_admin"`
+	MustChangePassword  bool               `json:"must_change_password"`
+	DeactivatedAt       pgtype.Timestamptz `json:"deactivated_at"`
+	TotpSecret          pgtype.Text        `json:"totp_secret"`
+	TotpEnabledAt       pgtype.Timestamptz `json:"totp_enabled_at"`
+	TotpLastStep        int64              `json:"totp_last_step"`
+	RecoveryCodeDigests []string           `json:"recovery_code_digests"`
+}
+
+type UserInvitation struct {
+	ID          uuid.UUID          `json:"id"`
+	Email       string             `json:"email"`
+	Name        string             `json:"name"`
+	IsAdmin     bool               `json:"is_admin"`
+	TokenDigest string             `json:"token_digest"`
+	InvitedBy   uuid.UUID          `json:"invited_by"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	AcceptedAt  pgtype.Timestamptz `json:"accepted_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserSession struct {
+	ID                  uuid.UUID          `json:"id"`
+	UserID              uuid.UUID          `json:"user_id"`
+	TokenDigest         string             `json:"token_digest"`
+	PreviousTokenDigest pgtype.Text        `json:"previous_token_digest"`
+	UserAgent           string             `json:"user_agent"`
+	IpAddress           string             `json:"ip_address"`
+	ExpiresAt           pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt           pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
 }
@@ -0,0 +1,122 @@
+package user
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+func mapDBUserToDomain(u User) *model.User {
+	return &model.User{
+		ID:                 u.ID,
+		Email:              u.Email,
+		Name:               u.Name,
+		PasswordHash:       auth.Hash(u.PasswordHash),
+		Admin:              u.IsAdmin,
+		MustChangePassword: u.MustChangePassword,
+		DeactivatedAt:      timePtr(u.DeactivatedAt),
+		TOTP:               mapDBTOTPToDomain(u),
+	}
+}
+
+func mapDBTOTPToDomain(u User) *model.TOTP {
+	if !u.TotpSecret.Valid {
+		return nil
+	}
+
+	return &model.TOTP{
+		Secret:              secret.Encrypted(u.TotpSecret.String),
+		EnabledAt:           timePtr(u.TotpEnabledAt),
+		LastStep:            u.TotpLastStep,
+		RecoveryCodeDigests: u.RecoveryCodeDigests,
+	}
+}
+
+func mapDBUserToQuery(u User) query.User {
+	return query.User{
+		ID:                 u.ID,
+		Email:              u.Email,
+		Name:               u.Name,
+		Admin:              u.IsAdmin,
+		MustChangePassword: u.MustChangePassword,
+		DeactivatedAt:      timePtr(u.DeactivatedAt),
+		MFAEnabled:         u.TotpEnabledAt.Valid,
+		CreatedAt:          u.CreatedAt.Time,
+		UpdatedAt:          u.UpdatedAt.Time,
+	}
+}
+
+func mapDBInvitationToDomain(i UserInvitation) *model.Invitation {
+	return &model.Invitation{
+		ID:          i.ID,
+		Email:       i.Email,
+		Name:        i.Name,
+		Admin:       i.IsAdmin,
+		TokenDigest: i.TokenDigest,
+		InvitedBy:   i.InvitedBy,
+		ExpiresAt:   i.ExpiresAt.Time,
+		AcceptedAt:  timePtr(i.AcceptedAt),
+	}
+}
+
+func mapDBSessionToDomain(s UserSession) *model.Session {
+	return &model.Session{
+		ID:                  s.ID,
+		UserID:              s.UserID,
+		TokenDigest:         s.TokenDigest,
+		PreviousTokenDigest: s.PreviousTokenDigest.String,
+		UserAgent:           s.UserAgent,
+		IPAddress:           s.IpAddress,
+		ExpiresAt:           s.ExpiresAt.Time,
+		LastUsedAt:          s.LastUsedAt.Time,
+		RevokedAt:           timePtr(s.RevokedAt),
+	}
+}
+
+func updateUserParamsFromDomain(u *model.User) updateUserParams {
+	params := updateUserParams{
+		ID:                  u.ID,
+		Name:                u.Name,
+		Email:               u.Email,
+		PasswordHash:        u.PasswordHash.String(),
+		IsAdmin:             u.Admin,
+		MustChangePassword:  u.MustChangePassword,
+		DeactivatedAt:       timestamptz(u.DeactivatedAt),
+		TotpSecret:          pgtype.Text{},
+		TotpEnabledAt:       pgtype.Timestamptz{},
+		TotpLastStep:        0,
+		RecoveryCodeDigests: []string{},
+	}
+
+	if u.TOTP != nil {
+		params.TotpSecret = text(u.TOTP.Secret.String())
+		params.TotpEnabledAt = timestamptz(u.TOTP.EnabledAt)
+		params.TotpLastStep = u.TOTP.LastStep
+		if u.TOTP.RecoveryCodeDigests != nil {
+			params.RecoveryCodeDigests = u.TOTP.RecoveryCodeDigests
+		}
+	}
+	return params
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func text(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
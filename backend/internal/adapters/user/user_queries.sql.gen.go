@@ -12,22 +12,184 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acceptInvitation = `-- name: acceptInvitation :execrows
+UPDATE user_invitations
+SET accepted_at = $2
+WHERE id = $1 AND accepted_at IS NULL
+`
+
+type acceptInvitationParams struct {
+	ID         uuid.UUID          `json:"id"`
+	AcceptedAt pgtype.Timestamptz `json:"accepted_at"`
+}
+
+func (q *Queries) acceptInvitation(ctx context.Context, arg acceptInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, acceptInvitation,
+		arg.ID,
+		arg.AcceptedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const activeUserSessions = `-- name: activeUserSessions :many
+SELECT id, user_id, token_digest, previous_token_digest, user_agent, ip_address, expires_at, last_used_at, revoked_at, created_at FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_used_at DESC
+`
+
+func (q *Queries) activeUserSessions(ctx context.Context, userID uuid.UUID) ([]UserSession, error) {
+	rows, err := q.db.Query(ctx, activeUserSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSession
+	for rows.Next() {
+		var i UserSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TokenDigest,
+			&i.PreviousTokenDigest,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createInvitation = `-- name: createInvitation :exec
+INSERT INTO user_invitations (
+    id,
+    email,
+    name,
+    is_admin,
+    token_digest,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type createInvitationParams struct {
+	ID          uuid.UUID          `json:"id"`
+	Email       string             `json:"email"`
+	Name        string             `json:"name"`
+	IsAdmin     bool               `json:"is_admin"`
+	TokenDigest string             `json:"token_digest"`
+	InvitedBy   uuid.UUID          `json:"invited_by"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) createInvitation(ctx context.Context, arg createInvitationParams) error {
+	_, err := q.db.Exec(ctx, createInvitation,
+		arg.ID,
+		arg.Email,
+		arg.Name,
+		arg.IsAdmin,
+		arg.TokenDigest,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createPasswordReset = `-- name: createPasswordReset :exec
+INSERT INTO password_resets (
+    token_digest,
+    user_id,
+    expires_at
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type createPasswordResetParams struct {
+	TokenDigest string             `json:"token_digest"`
+	UserID      uuid.UUID          `json:"user_id"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) createPasswordReset(ctx context.Context, arg createPasswordResetParams) error {
+	_, err := q.db.Exec(ctx, createPasswordReset,
+		arg.TokenDigest,
+		arg.UserID,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createSession = `-- name: createSession :exec
+INSERT INTO user_sessions (
+    id,
+    user_id,
+    token_digest,
+    user_agent,
+    ip_address,
+    expires_at,
+    last_used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type createSessionParams struct {
+	ID          uuid.UUID          `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	TokenDigest string             `json:"token_digest"`
+	UserAgent   string             `json:"user_agent"`
+	IpAddress   string             `json:"ip_address"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+}
+
+func (q *Queries) createSession(ctx context.Context, arg createSessionParams) error {
+	_, err := q.db.Exec(ctx, createSession,
+		arg.ID,
+		arg.UserID,
+		arg.TokenDigest,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.ExpiresAt,
+		arg.LastUsedAt,
+	)
+	return err
+}
+
 const createUser = `-- name: createUser :one
 INSERT INTO users (
     id,
     email,
     name,
-    password_hash
+    password_hash,
+    is_admin,
+    must_change_password
 ) VALUES (
-    $1, $2, $3, $4
-) RETURNING id, email, name, password_hash, created_at, updated_at
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, email, name, password_hash, created_at, updated_at, is_admin, must_change_password, deactivated_at, totp_secret, totp_enabled_at, totp_last_step, recovery_code_digests
 `
 
 type createUserParams struct {
-	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
-	Name         string    `json:"name"`
-	PasswordHash string    `json:"password_hash"`
+	ID                 uuid.UUID `json:"id"`
+	Email              string    `json:"email"`
+	Name               string    `json:"name"`
+	PasswordHash       string    `json:"password_hash"`
+	IsAdmin            bool      `json:"is_admin"`
+	MustChangePassword bool      `json:"must_change_password"`
 }
 
 func (q *Queries) createUser(ctx context.Context, arg createUserParams) (User, error) {
@@ -36,6 +198,8 @@ func (q *Queries) createUser(ctx context.Context, arg createUserParams) (User, e
 		arg.Email,
 		arg.Name,
 		arg.PasswordHash,
+		arg.IsAdmin,
+		arg.MustChangePassword,
 	)
 	var i User
 	err := row.Scan(
@@ -45,12 +209,42 @@ func (q *Queries) createUser(ctx context.Context, arg createUserParams) (User, e
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsAdmin,
+		&i.MustChangePassword,
+		&i.DeactivatedAt,
+		&i.TotpSecret,
+		&i.TotpEnabledAt,
+		&i.TotpLastStep,
+		&i.RecoveryCodeDigests,
 	)
 	return i, err
 }
 
+const deleteInvitation = `-- name: deleteInvitation :execrows
+DELETE FROM user_invitations
+WHERE id = $1 AND accepted_at IS NULL
+`
+
+func (q *Queries) deleteInvitation(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteInvitation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePendingPasswordResets = `-- name: deletePendingPasswordResets :exec
+DELETE FROM password_resets
+WHERE user_id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) deletePendingPasswordResets(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePendingPasswordResets, userID)
+	return err
+}
+
 const getUserByEmail = `-- name: getUserByEmail :one
-SELECT id, email, name, password_hash, created_at, updated_at FROM users
+SELECT id, email, name, password_hash, created_at, updated_at, is_admin, must_change_password, deactivated_at, totp_secret, totp_enabled_at, totp_last_step, recovery_code_digests FROM users
 WHERE email = $1
 LIMIT 1
 `
@@ -65,12 +259,19 @@ func (q *Queries) getUserByEmail(ctx context.Context, email string) (User, error
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsAdmin,
+		&i.MustChangePassword,
+		&i.DeactivatedAt,
+		&i.TotpSecret,
+		&i.TotpEnabledAt,
+		&i.TotpLastStep,
+		&i.RecoveryCodeDigests,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: getUserByID :one
-SELECT id, email, name, password_hash, created_at, updated_at FROM users
+SELECT id, email, name, password_hash, created_at, updated_at, is_admin, must_change_password, deactivated_at, totp_secret, totp_enabled_at, totp_last_step, recovery_code_digests FROM users
 WHERE id = $1
 LIMIT 1
 `
@@ -85,6 +286,13 @@ func (q *Queries) getUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsAdmin,
+		&i.MustChangePassword,
+		&i.DeactivatedAt,
+		&i.TotpSecret,
+		&i.TotpEnabledAt,
+		&i.TotpLastStep,
+		&i.RecoveryCodeDigests,
 	)
 	return i, err
 }
@@ -102,20 +310,272 @@ func (q *Queries) hasUser(ctx context.Context) (bool, error) {
 	return exists, err
 }
 
+const invitationByTokenDigest = `-- name: invitationByTokenDigest :one
+SELECT id, email, name, is_admin, token_digest, invited_by, expires_at, accepted_at, created_at FROM user_invitations
+WHERE token_digest = $1
+LIMIT 1
+`
+
+func (q *Queries) invitationByTokenDigest(ctx context.Context, tokenDigest string) (UserInvitation, error) {
+	row := q.db.QueryRow(ctx, invitationByTokenDigest, tokenDigest)
+	var i UserInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.IsAdmin,
+		&i.TokenDigest,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listUsers = `-- name: listUsers :many
+SELECT id, email, name, password_hash, created_at, updated_at, is_admin, must_change_password, deactivated_at, totp_secret, totp_enabled_at, totp_last_step, recovery_code_digests FROM users
+ORDER BY created_at
+`
+
+func (q *Queries) listUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.PasswordHash,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.IsAdmin,
+			&i.MustChangePassword,
+			&i.DeactivatedAt,
+			&i.TotpSecret,
+			&i.TotpEnabledAt,
+			&i.TotpLastStep,
+			&i.RecoveryCodeDigests,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const passwordResetByTokenDigest = `-- name: passwordResetByTokenDigest :one
+SELECT token_digest, user_id, expires_at, used_at, created_at FROM password_resets
+WHERE token_digest = $1
+LIMIT 1
+`
+
+func (q *Queries) passwordResetByTokenDigest(ctx context.Context, tokenDigest string) (PasswordReset, error) {
+	row := q.db.QueryRow(ctx, passwordResetByTokenDigest, tokenDigest)
+	var i PasswordReset
+	err := row.Scan(
+		&i.TokenDigest,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const pendingInvitations = `-- name: pendingInvitations :many
+SELECT id, email, name, is_admin, token_digest, invited_by, expires_at, accepted_at, created_at FROM user_invitations
+WHERE accepted_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) pendingInvitations(ctx context.Context) ([]UserInvitation, error) {
+	rows, err := q.db.Query(ctx, pendingInvitations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserInvitation
+	for rows.Next() {
+		var i UserInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.IsAdmin,
+			&i.TokenDigest,
+			&i.InvitedBy,
+			&i.ExpiresAt,
+			&i.AcceptedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeSession = `-- name: revokeSession :exec
+UPDATE user_sessions
+SET revoked_at = $2
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+type revokeSessionParams struct {
+	ID        uuid.UUID          `json:"id"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+func (q *Queries) revokeSession(ctx context.Context, arg revokeSessionParams) error {
+	_, err := q.db.Exec(ctx, revokeSession,
+		arg.ID,
+		arg.RevokedAt,
+	)
+	return err
+}
+
+const revokeUserSessions = `-- name: revokeUserSessions :exec
+UPDATE user_sessions
+SET revoked_at = $2
+WHERE user_id = $1 AND revoked_at IS NULL
+`
+
+type revokeUserSessionsParams struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+func (q *Queries) revokeUserSessions(ctx context.Context, arg revokeUserSessionsParams) error {
+	_, err := q.db.Exec(ctx, revokeUserSessions,
+		arg.UserID,
+		arg.RevokedAt,
+	)
+	return err
+}
+
+const rotateSession = `-- name: rotateSession :execrows
+UPDATE user_sessions
+SET
+    token_digest = $2,
+    previous_token_digest = $3,
+    ip_address = $4,
+    expires_at = $5,
+    last_used_at = $6
+WHERE id = $1 AND token_digest = $3 AND revoked_at IS NULL
+`
+
+type rotateSessionParams struct {
+	ID                  uuid.UUID          `json:"id"`
+	TokenDigest         string             `json:"token_digest"`
+	PreviousTokenDigest pgtype.Text        `json:"previous_token_digest"`
+	IpAddress           string             `json:"ip_address"`
+	ExpiresAt           pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt          pgtype.Timestamptz `json:"last_used_at"`
+}
+
+func (q *Queries) rotateSession(ctx context.Context, arg rotateSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rotateSession,
+		arg.ID,
+		arg.TokenDigest,
+		arg.PreviousTokenDigest,
+		arg.IpAddress,
+		arg.ExpiresAt,
+		arg.LastUsedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sessionById = `-- name: sessionById :one
+SELECT id, user_id, token_digest, previous_token_digest, user_agent, ip_address, expires_at, last_used_at, revoked_at, created_at FROM user_sessions
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) sessionById(ctx context.Context, id uuid.UUID) (UserSession, error) {
+	row := q.db.QueryRow(ctx, sessionById, id)
+	var i UserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenDigest,
+		&i.PreviousTokenDigest,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const sessionByTokenDigest = `-- name: sessionByTokenDigest :one
+SELECT id, user_id, token_digest, previous_token_digest, user_agent, ip_address, expires_at, last_used_at, revoked_at, created_at FROM user_sessions
+WHERE token_digest = $1 OR previous_token_digest = $1
+LIMIT 1
+`
+
+func (q *Queries) sessionByTokenDigest(ctx context.Context, tokenDigest string) (UserSession, error) {
+	row := q.db.QueryRow(ctx, sessionByTokenDigest, tokenDigest)
+	var i UserSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenDigest,
+		&i.PreviousTokenDigest,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const updateUser = `-- name: updateUser :exec
 UPDATE users
 SET
-    name = COALESCE($2, name),
-    email = COALESCE($3, email),
-    password_hash = COALESCE($4, password_hash)
+    name = $2,
+    email = $3,
+    password_hash = $4,
+    is_admin = $5,
+    must_change_password = $6,
+    deactivated_at = $7,
+    totp_secret = $8,
+    totp_enabled_at = $9,
+    totp_last_step = $10,
+    recovery_code_digests = $11
 WHERE id = $1
 `
 
 type updateUserParams struct {
-	ID           uuid.UUID   `json:"id"`
-	Name         pgtype.Text `json:"name"`
-	Email        pgtype.Text `json:"email"`
-	PasswordHash pgtype.Text `json:"password_hash"`
+	ID                  uuid.UUID          `json:"id"`
+	Name                string             `json:"name"`
+	Email               string             `json:"email"`
+	PasswordHash        string             `json:"password_hash"`
+	IsAdmin             bool               `json:"is_admin"`
+	MustChangePassword  bool               `json:"must_change_password"`
+	DeactivatedAt       pgtype.Timestamptz `json:"deactivated_at"`
+	TotpSecret          pgtype.Text        `json:"totp_secret"`
+	TotpEnabledAt       pgtype.Timestamptz `json:"totp_enabled_at"`
+	TotpLastStep        int64              `json:"totp_last_step"`
+	RecoveryCodeDigests []string           `json:"recovery_code_digests"`
 }
 
 func (q *Queries) updateUser(ctx context.Context, arg updateUserParams) error {
@@ -124,6 +584,73 @@ func (q *Queries) updateUser(ctx context.Context, arg updateUserParams) error {
 		arg.Name,
 		arg.Email,
 		arg.PasswordHash,
+		arg.IsAdmin,
+		arg.MustChangePassword,
+		arg.DeactivatedAt,
+		arg.TotpSecret,
+		arg.TotpEnabledAt,
+		arg.TotpLastStep,
+		arg.RecoveryCodeDigests,
 	)
 	return err
 }
+
+const usePasswordReset = `-- name: usePasswordReset :execrows
+UPDATE password_resets
+SET used_at = $2
+WHERE token_digest = $1 AND used_at IS NULL
+`
+
+type usePasswordResetParams struct {
+	TokenDigest string             `json:"token_digest"`
+	UsedAt      pgtype.Timestamptz `json:"used_at"`
+}
+
+func (q *Queries) usePasswordReset(ctx context.Context, arg usePasswordResetParams) (int64, error) {
+	result, err := q.db.Exec(ctx, usePasswordReset,
+		arg.TokenDigest,
+		arg.UsedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useRecoveryCode = `-- name: useRecoveryCode :execrows
+UPDATE users
+SET recovery_code_digests = array_remove(recovery_code_digests, $1::text)
+WHERE id = $2 AND $1::text = ANY(recovery_code_digests) AND deactivated_at IS NULL
+`
+
+type useRecoveryCodeParams struct {
+	Digest string    `json:"digest"`
+	ID     uuid.UUID `json:"id"`
+}
+
+func (q *Queries) useRecoveryCode(ctx context.Context, arg useRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.Digest, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useTOTPStep = `-- name: useTOTPStep :execrows
+UPDATE users
+SET totp_last_step = $2
+WHERE id = $1 AND totp_last_step < $2 AND deactivated_at IS NULL
+`
+
+type useTOTPStepParams struct {
+	ID           uuid.UUID `json:"id"`
+	TotpLastStep int64     `json:"totp_last_step"`
+}
+
+func (q *Queries) useTOTPStep(ctx context.Context, arg useTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useTOTPStep, arg.ID, arg.TotpLastStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
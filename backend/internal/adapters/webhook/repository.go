@@ -0,0 +1,358 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	adapterrors "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+)
+
+type Repository struct {
+	queries *Queries
+	pool    *pgxpool.Pool
+}
+
+func NewRepository(_ context.Context, pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		queries: New(pool),
+		pool:    pool,
+	}
+}
+
+func (r Repository) withTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+
+	isCommitted := false
+	defer func() {
+		if !isCommitted {
+			if err = tx.Rollback(ctx); err != nil {
+				slog.Error("error rolling back transaction", "error", err)
+			}
+		}
+	}()
+
+	q := New(tx)
+	if err = fn(q); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return r.errorDecoder(err)
+	}
+	isCommitted = true
+
+	return nil
+}
+
+func (r Repository) errorDecoder(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", adapterrors.ErrNotFound, err.Error())
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23514":
+			return fmt.Errorf("%w: %v", adapterrors.ErrDuplicate, err.Error())
+		case "23503", "23502", "22P02", "42P01", "42703":
+			return fmt.Errorf("%w: %v", adapterrors.ErrInvalid, err.Error())
+		}
+	}
+
+	return err
+}
+
+func (r Repository) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	err := r.queries.storeWebhook(ctx, storeWebhookParams{
+		ID:              webhook.ID,
+		ProjectID:       webhook.ProjectID,
+		Url:             webhook.URL,
+		EventTypes:      eventTypesToStrings(webhook.EventTypes),
+		WorkflowIds:     workflowIDsToStrings(webhook.WorkflowIDs),
+		SecretEncrypted: webhook.Secret,
+		Enabled:         webhook.Enabled,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook, err := r.queries.webhookById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return webhook.domain(), nil
+}
+
+func (r Repository) UpdateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	err := r.queries.updateWebhook(ctx, updateWebhookParams{
+		ID:          webhook.ID,
+		Url:         webhook.URL,
+		EventTypes:  eventTypesToStrings(webhook.EventTypes),
+		WorkflowIds: workflowIDsToStrings(webhook.WorkflowIDs),
+		Enabled:     webhook.Enabled,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	err := r.queries.deleteWebhook(ctx, id)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ListEnabledWebhooks(ctx context.Context, projectID uuid.UUID) ([]*model.Webhook, error) {
+	webhooks, err := r.queries.enabledWebhooksByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainWebhooks := make([]*model.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		domainWebhooks[i] = webhook.domain()
+	}
+	return domainWebhooks, nil
+}
+
+func (r Repository) AddDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	return r.withTx(ctx, func(q *Queries) error {
+		for _, delivery := range deliveries {
+			err := q.storeDelivery(ctx, storeDeliveryParams{
+				ID:            delivery.ID,
+				WebhookID:     delivery.WebhookID,
+				EventType:     delivery.EventType.String(),
+				TriggerID:     delivery.TriggerID,
+				Payload:       delivery.Payload,
+				Status:        string(delivery.Status),
+				NextAttemptAt: timestamptz(&delivery.NextAttemptAt),
+			})
+			if err != nil {
+				return r.errorDecoder(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r Repository) RetrieveDelivery(ctx context.Context, id uuid.UUID) (*model.WebhookDelivery, error) {
+	delivery, err := r.queries.deliveryById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return delivery.domain(), nil
+}
+
+func (r Repository) UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	err := r.queries.updateDelivery(ctx, updateDeliveryParams{
+		ID:             delivery.ID,
+		Status:         string(delivery.Status),
+		Attempts:       int32(delivery.Attempts), //nolint:gosec // bounded by MaxWebhookAttempts
+		NextAttemptAt:  timestamptz(&delivery.NextAttemptAt),
+		ResponseStatus: int32(delivery.ResponseStatus), //nolint:gosec // http status code
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		DeliveredAt:    timestamptz(delivery.DeliveredAt),
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ClaimDueDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]*model.WebhookDelivery, error) {
+	leaseEnd := time.Now().Add(lease)
+	deliveries, err := r.queries.claimDueDeliveries(ctx, claimDueDeliveriesParams{
+		NextAttemptAt: timestamptz(&leaseEnd),
+		Limit:         int32(limit), //nolint:gosec // small batch size
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	domainDeliveries := make([]*model.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		domainDeliveries[i] = delivery.domain()
+	}
+	return domainDeliveries, nil
+}
+
+func (r Repository) ReadWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (query.Webhook, error) {
+	webhook, err := r.queries.webhookById(ctx, id)
+	if err != nil {
+		return query.Webhook{}, r.errorDecoder(err)
+	}
+
+	if webhook.ProjectID != projectID {
+		return query.Webhook{}, fmt.Errorf("%w: webhook %s", adapterrors.ErrNotFound, id)
+	}
+
+	return webhook.query()
+}
+
+func (r Repository) ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]query.Webhook, error) {
+	webhooks, err := r.queries.webhooksByProjectId(ctx, projectID)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryWebhooks := make([]query.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		queryWebhooks[i], err = webhook.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryWebhooks, nil
+}
+
+func (r Repository) ListWebhookDeliveries(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	limit int,
+) ([]query.WebhookDelivery, error) {
+	deliveries, err := r.queries.deliveriesByWebhookId(ctx, deliveriesByWebhookIdParams{
+		WebhookID: webhookID,
+		Limit:     int32(limit), //nolint:gosec // bounded by the query handler
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryDeliveries := make([]query.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		queryDeliveries[i] = delivery.query()
+	}
+	return queryDeliveries, nil
+}
+
+func (r Repository) CreateWebhookTrigger(ctx context.Context, trigger *model.WebhookTrigger) error {
+	inputMapping, err := json.Marshal(trigger.InputMapping)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.storeWebhookTrigger(ctx, storeWebhookTriggerParams{
+		ID:              trigger.ID,
+		ProjectID:       trigger.ProjectID,
+		WorkflowID:      trigger.WorkflowID.String(),
+		Name:            trigger.Name,
+		Verification:    string(trigger.Verification),
+		SignatureHeader: trigger.SignatureHeader,
+		SecretEncrypted: trigger.Secret,
+		InputMapping:    inputMapping,
+		RespondSync:     trigger.RespondSync,
+		SyncTimeoutMs:   int32(trigger.SyncTimeout.Milliseconds()), //nolint:gosec // bounded by MaxWebhookSyncTimeout
+		Enabled:         trigger.Enabled,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) RetrieveWebhookTrigger(ctx context.Context, id uuid.UUID) (*model.WebhookTrigger, error) {
+	trigger, err := r.queries.webhookTriggerById(ctx, id)
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+	return trigger.domain()
+}
+
+func (r Repository) UpdateWebhookTrigger(ctx context.Context, trigger *model.WebhookTrigger) error {
+	inputMapping, err := json.Marshal(trigger.InputMapping)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.updateWebhookTrigger(ctx, updateWebhookTriggerParams{
+		ID:              trigger.ID,
+		Name:            trigger.Name,
+		Verification:    string(trigger.Verification),
+		SignatureHeader: trigger.SignatureHeader,
+		SecretEncrypted: trigger.Secret,
+		InputMapping:    inputMapping,
+		RespondSync:     trigger.RespondSync,
+		SyncTimeoutMs:   int32(trigger.SyncTimeout.Milliseconds()), //nolint:gosec // bounded by MaxWebhookSyncTimeout
+		Enabled:         trigger.Enabled,
+	})
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) DeleteWebhookTrigger(ctx context.Context, id uuid.UUID) error {
+	err := r.queries.deleteWebhookTrigger(ctx, id)
+	if err != nil {
+		return r.errorDecoder(err)
+	}
+	return nil
+}
+
+func (r Repository) ReadWebhookTrigger(
+	ctx context.Context,
+	projectID uuid.UUID,
+	id uuid.UUID,
+) (query.WebhookTrigger, error) {
+	trigger, err := r.queries.webhookTriggerById(ctx, id)
+	if err != nil {
+		return query.WebhookTrigger{}, r.errorDecoder(err)
+	}
+
+	if trigger.ProjectID != projectID {
+		return query.WebhookTrigger{}, fmt.Errorf("%w: webhook trigger %s", adapterrors.ErrNotFound, id)
+	}
+
+	return trigger.query()
+}
+
+func (r Repository) ListWebhookTriggers(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+) ([]query.WebhookTrigger, error) {
+	triggers, err := r.queries.webhookTriggersByWorkflowId(ctx, webhookTriggersByWorkflowIdParams{
+		ProjectID:  projectID,
+		WorkflowID: workflowID.String(),
+	})
+	if err != nil {
+		return nil, r.errorDecoder(err)
+	}
+
+	queryTriggers := make([]query.WebhookTrigger, len(triggers))
+	for i, trigger := range triggers {
+		queryTriggers[i], err = trigger.query()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queryTriggers, nil
+}
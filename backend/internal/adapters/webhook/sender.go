@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+const (
+	sendTimeout     = 10 * time.Second
+	maxResponseRead = 4096
+
+	headerDelivery  = "Supallm-Webhook-Id"
+	headerEvent     = "Supallm-Webhook-Event"
+	headerTimestamp = "Supallm-Webhook-Timestamp"
+	headerSignature = "Supallm-Webhook-Signature"
+	userAgent       = "Supallm-Webhooks/1.0"
+)
+
+var ErrForbiddenAddress = errors.New("address is not public")
+
+// forbiddenPrefixes are the special-purpose ranges not covered by the
+// netip predicates, such as the carrier-grade NAT and NAT64 ones.
+//
+//nolint:gochecknoglobals // list of the reserved ranges
+var forbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Sender POSTs deliveries to webhook URLs. The signature header holds
+// v1=hex(HMAC-SHA256(secret, timestamp + "." + body)), receivers should
+// also reject timestamps too far in the past to prevent replays.
+//
+// The responses are stored in the deliveries, so unless private networks
+// are allowed the addresses dialed, redirects included, must be public.
+type Sender struct {
+	client               *http.Client
+	allowPrivateNetworks bool
+}
+
+func NewSender(allowPrivateNetworks bool) *Sender {
+	return &Sender{
+		client:               NewGuardedClient(sendTimeout, allowPrivateNetworks),
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// NewGuardedClient returns a client for the URLs given by the projects, it
+// only dials public addresses, redirects included, unless private networks
+// are allowed.
+func NewGuardedClient(timeout time.Duration, allowPrivateNetworks bool) *http.Client {
+	control := guardAddress
+	if allowPrivateNetworks {
+		control = nil
+	}
+
+	dialer := &net.Dialer{Timeout: timeout, Control: control}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// without a proxy the addresses checked are the ones dialed
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// CheckURL resolves the host of the URL and rejects the internal addresses.
+func (s *Sender) CheckURL(ctx context.Context, rawURL string) error {
+	if s.allowPrivateNetworks {
+		return nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("unable to resolve %s: %w", u.Hostname(), err)
+	}
+
+	for _, addr := range addrs {
+		if !isPublic(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrForbiddenAddress, u.Hostname(), addr)
+		}
+	}
+	return nil
+}
+
+// guardAddress runs once the address is resolved, before connecting, so
+// a host resolving to another address than when checked is rejected too.
+func guardAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+	}
+
+	if !isPublic(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+
+	for _, prefix := range forbiddenPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Sender) Send(
+	ctx context.Context,
+	url string,
+	signingSecret secret.APIKey,
+	delivery *model.WebhookDelivery,
+) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(headerDelivery, delivery.ID.String())
+	req.Header.Set(headerEvent, delivery.EventType.String())
+	req.Header.Set(headerTimestamp, timestamp)
+	req.Header.Set(headerSignature, "v1="+sign(signingSecret, timestamp, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseRead))
+	if err != nil {
+		return resp.StatusCode, "", err
+	}
+
+	return resp.StatusCode, string(body), nil
+}
+
+func sign(signingSecret secret.APIKey, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret.String()))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
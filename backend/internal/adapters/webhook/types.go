@@ -0,0 +1,177 @@
+package webhook
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+func (w Webhook) domain() *model.Webhook {
+	eventTypes := make([]model.WorkflowEventType, len(w.EventTypes))
+	for i, t := range w.EventTypes {
+		eventTypes[i] = model.WorkflowEventType(t)
+	}
+
+	workflowIDs := make([]model.WorkflowID, len(w.WorkflowIds))
+	for i, id := range w.WorkflowIds {
+		workflowIDs[i] = model.WorkflowID(id)
+	}
+
+	return &model.Webhook{
+		ID:          w.ID,
+		ProjectID:   w.ProjectID,
+		URL:         w.Url,
+		EventTypes:  eventTypes,
+		WorkflowIDs: workflowIDs,
+		Secret:      w.SecretEncrypted,
+		Enabled:     w.Enabled,
+	}
+}
+
+func (w Webhook) query() (query.Webhook, error) {
+	signingSecret, err := w.SecretEncrypted.Decrypt()
+	if err != nil {
+		slog.Error("failed to decrypt webhook secret", "error", err)
+		return query.Webhook{}, err
+	}
+
+	return query.Webhook{
+		ID:          w.ID,
+		URL:         w.Url,
+		EventTypes:  w.EventTypes,
+		WorkflowIDs: w.WorkflowIds,
+		Secret:      signingSecret,
+		Enabled:     w.Enabled,
+		CreatedAt:   w.CreatedAt.Time,
+		UpdatedAt:   w.UpdatedAt.Time,
+	}, nil
+}
+
+func (d WebhookDelivery) domain() *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventType:      model.WorkflowEventType(d.EventType),
+		TriggerID:      d.TriggerID,
+		Payload:        d.Payload,
+		Status:         model.WebhookDeliveryStatus(d.Status),
+		Attempts:       int(d.Attempts),
+		NextAttemptAt:  d.NextAttemptAt.Time,
+		ResponseStatus: int(d.ResponseStatus),
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		DeliveredAt:    timePtr(d.DeliveredAt),
+	}
+}
+
+func (d WebhookDelivery) query() query.WebhookDelivery {
+	var nextAttemptAt *time.Time
+	if d.Status == string(model.WebhookDeliveryPending) {
+		nextAttemptAt = &d.NextAttemptAt.Time
+	}
+
+	return query.WebhookDelivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventType:      d.EventType,
+		TriggerID:      d.TriggerID,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       int(d.Attempts),
+		ResponseStatus: int(d.ResponseStatus),
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		NextAttemptAt:  nextAttemptAt,
+		DeliveredAt:    timePtr(d.DeliveredAt),
+		CreatedAt:      d.CreatedAt.Time,
+	}
+}
+
+func (t WebhookTrigger) domain() (*model.WebhookTrigger, error) {
+	var inputMapping map[string]string
+	if err := json.Unmarshal(t.InputMapping, &inputMapping); err != nil {
+		return nil, err
+	}
+
+	return &model.WebhookTrigger{
+		ID:              t.ID,
+		ProjectID:       t.ProjectID,
+		WorkflowID:      model.WorkflowID(t.WorkflowID),
+		Name:            t.Name,
+		Verification:    model.WebhookVerification(t.Verification),
+		SignatureHeader: t.SignatureHeader,
+		Secret:          t.SecretEncrypted,
+		InputMapping:    inputMapping,
+		RespondSync:     t.RespondSync,
+		SyncTimeout:     time.Duration(t.SyncTimeoutMs) * time.Millisecond,
+		Enabled:         t.Enabled,
+	}, nil
+}
+
+func (t WebhookTrigger) query() (query.WebhookTrigger, error) {
+	var inputMapping map[string]string
+	if err := json.Unmarshal(t.InputMapping, &inputMapping); err != nil {
+		return query.WebhookTrigger{}, err
+	}
+
+	// triggers without verification have no secret
+	var signingSecret secret.APIKey
+	if t.SecretEncrypted != "" {
+		var err error
+		signingSecret, err = t.SecretEncrypted.Decrypt()
+		if err != nil {
+			slog.Error("failed to decrypt webhook trigger secret", "error", err)
+			return query.WebhookTrigger{}, err
+		}
+	}
+
+	return query.WebhookTrigger{
+		ID:              t.ID,
+		WorkflowID:      t.WorkflowID,
+		Name:            t.Name,
+		Verification:    t.Verification,
+		SignatureHeader: t.SignatureHeader,
+		Secret:          signingSecret,
+		InputMapping:    inputMapping,
+		RespondSync:     t.RespondSync,
+		SyncTimeout:     time.Duration(t.SyncTimeoutMs) * time.Millisecond,
+		Enabled:         t.Enabled,
+		CreatedAt:       t.CreatedAt.Time,
+		UpdatedAt:       t.UpdatedAt.Time,
+	}, nil
+}
+
+func eventTypesToStrings(eventTypes []model.WorkflowEventType) []string {
+	values := make([]string, len(eventTypes))
+	for i, t := range eventTypes {
+		values[i] = t.String()
+	}
+	return values
+}
+
+func workflowIDsToStrings(workflowIDs []model.WorkflowID) []string {
+	values := make([]string, len(workflowIDs))
+	for i, id := range workflowIDs {
+		values[i] = id.String()
+	}
+	return values
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, InfinityModifier: pgtype.Finite, Valid: true}
+}
+
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -8,14 +8,28 @@ import (
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/supallm/core/internal/adapters/access"
+	"github.com/supallm/core/internal/adapters/audit"
+	"github.com/supallm/core/internal/adapters/batch"
+	"github.com/supallm/core/internal/adapters/enduser"
+	"github.com/supallm/core/internal/adapters/eval"
 	"github.com/supallm/core/internal/adapters/events"
 	"github.com/supallm/core/internal/adapters/execution"
+	"github.com/supallm/core/internal/adapters/login"
 	"github.com/supallm/core/internal/adapters/project"
+	"github.com/supallm/core/internal/adapters/provider"
+	"github.com/supallm/core/internal/adapters/ratelimit"
+	"github.com/supallm/core/internal/adapters/rollout"
 	"github.com/supallm/core/internal/adapters/runner"
+	"github.com/supallm/core/internal/adapters/schedule"
+	"github.com/supallm/core/internal/adapters/usage"
 	"github.com/supallm/core/internal/adapters/user"
+	"github.com/supallm/core/internal/adapters/webhook"
 	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/event"
 	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/pkg/auth"
 	"github.com/supallm/core/internal/pkg/config"
 	"github.com/supallm/core/internal/pkg/postgres"
 	"github.com/supallm/core/internal/pkg/redis"
@@ -31,6 +45,7 @@ type App struct {
 
 	EventsSubscriber message.Subscriber
 	pool             *pgxpool.Pool
+	schedulerLeader  *postgres.Leader
 }
 
 type Commands struct {
@@ -38,33 +53,152 @@ type Commands struct {
 	UpdateProjectName  command.UpdateProjectNameHandler
 	UpdateAuthProvider command.UpdateAuthProviderHandler
 
+	CreateOrganization          command.CreateOrganizationHandler
+	SaveOrganizationMember      command.SaveOrganizationMemberHandler
+	RemoveOrganizationMember    command.RemoveOrganizationMemberHandler
+	SaveProjectMember           command.SaveProjectMemberHandler
+	RemoveProjectMember         command.RemoveProjectMemberHandler
+	AuthorizeProjectAccess      command.AuthorizeProjectAccessHandler
+	AuthorizeOrganizationAccess command.AuthorizeOrganizationAccessHandler
+
 	AddWorkflow    command.AddWorkflowHandler
 	UpdateWorkflow command.UpdateWorkflowHandler
 	RemoveWorkflow command.RemoveWorkflowHandler
 
-	AddCredential    command.AddCredentialHandler
-	UpdateCredential command.UpdateCredentialHandler
-	RemoveCredential command.RemoveCredentialHandler
+	AddCredential     command.AddCredentialHandler
+	UpdateCredential  command.UpdateCredentialHandler
+	RemoveCredential  command.RemoveCredentialHandler
+	TestCredential    command.TestCredentialHandler
+	ReplaceCredential command.ReplaceCredentialHandler
+
+	AddWebhook       command.AddWebhookHandler
+	UpdateWebhook    command.UpdateWebhookHandler
+	RemoveWebhook    command.RemoveWebhookHandler
+	RedeliverWebhook command.RedeliverWebhookHandler
+
+	AddWebhookTrigger    command.AddWebhookTriggerHandler
+	UpdateWebhookTrigger command.UpdateWebhookTriggerHandler
+	RemoveWebhookTrigger command.RemoveWebhookTriggerHandler
+	FireWebhookTrigger   command.FireWebhookTriggerHandler
+
+	AddSchedule    command.AddScheduleHandler
+	UpdateSchedule command.UpdateScheduleHandler
+	RemoveSchedule command.RemoveScheduleHandler
+
+	AddBatch command.AddBatchHandler
+
+	AddDataset      command.AddDatasetHandler
+	UpdateDataset   command.UpdateDatasetHandler
+	RemoveDataset   command.RemoveDatasetHandler
+	AddDatasetCases command.AddDatasetCasesHandler
+	AddEvalRun      command.AddEvalRunHandler
+
+	SaveTrafficSplit   command.SaveTrafficSplitHandler
+	RemoveTrafficSplit command.RemoveTrafficSplitHandler
+
+	AddBudget    command.AddBudgetHandler
+	UpdateBudget command.UpdateBudgetHandler
+	RemoveBudget command.RemoveBudgetHandler
+
+	SaveRateLimit   command.SaveRateLimitHandler
+	RemoveRateLimit command.RemoveRateLimitHandler
 
 	TriggerWorkflow            command.TriggerWorkflowHandler
+	CancelWorkflow             command.CancelWorkflowHandler
+	AnswerHumanInput           command.AnswerHumanInputHandler
 	AuthorizeEventSubscription command.AuthorizeEventSubscriptionHandler
+	AuthenticateEndUser        command.AuthenticateEndUserHandler
+	AuthorizeTriggerListening  command.AuthorizeTriggerListeningHandler
 	CreateJWT                  command.CreateJWTHandler
 
-	loadFixture command.LoadFixtureHandler
+	CreateUser         command.CreateUserHandler
+	InviteUser         command.InviteUserHandler
+	RevokeInvitation   command.RevokeInvitationHandler
+	AcceptInvitation   command.AcceptInvitationHandler
+	ChangePassword     command.ChangePasswordHandler
+	IssuePasswordReset command.IssuePasswordResetHandler
+	ResetPassword      command.ResetPasswordHandler
+	DeactivateUser     command.DeactivateUserHandler
+	ActivateUser       command.ActivateUserHandler
+
+	CompleteMFALogin        command.CompleteMFALoginHandler
+	EnrollTOTP              command.EnrollTOTPHandler
+	ConfirmTOTP             command.ConfirmTOTPHandler
+	DisableTOTP             command.DisableTOTPHandler
+	RegenerateRecoveryCodes command.RegenerateRecoveryCodesHandler
+	ResetMFA                command.ResetMFAHandler
+
+	RefreshSession     command.RefreshSessionHandler
+	RevokeSession      command.RevokeSessionHandler
+	RevokeUserSessions command.RevokeUserSessionsHandler
+
+	loadFixture      command.LoadFixtureHandler
+	deliverWebhooks  command.DeliverWebhooksHandler
+	fireDueSchedules command.FireDueSchedulesHandler
+	dispatchBatches  command.DispatchBatchItemsHandler
+	scoreEvalRuns    command.ScoreEvalRunsHandler
 }
 
 type Queries struct {
 	GetProject   query.GetProjectHandler
 	ListProjects query.ListProjectsHandler
 
+	ListOrganizations       query.ListOrganizationsHandler
+	ListOrganizationMembers query.ListOrganizationMembersHandler
+	ListProjectMembers      query.ListProjectMembersHandler
+
 	ListWorkflows query.ListWorkflowsHandler
 	GetWorkflow   query.GetWorkflowHandler
 
-	ListCredentials query.ListCredentialsHandler
-	GetCredential   query.GetCredentialHandler
+	ListCredentials          query.ListCredentialsHandler
+	ListCredentialReferences query.ListCredentialReferencesHandler
+	GetCredential            query.GetCredentialHandler
+
+	ListWebhooks          query.ListWebhooksHandler
+	GetWebhook            query.GetWebhookHandler
+	ListWebhookDeliveries query.ListWebhookDeliveriesHandler
+
+	ListWebhookTriggers query.ListWebhookTriggersHandler
+	GetWebhookTrigger   query.GetWebhookTriggerHandler
+
+	ListSchedules query.ListSchedulesHandler
+	GetSchedule   query.GetScheduleHandler
+
+	ListBatches     query.ListBatchesHandler
+	GetBatch        query.GetBatchHandler
+	GetBatchResults query.GetBatchResultsHandler
+
+	ListDatasets     query.ListDatasetsHandler
+	GetDataset       query.GetDatasetHandler
+	ListDatasetCases query.ListDatasetCasesHandler
+	ListEvalRuns     query.ListEvalRunsHandler
+	GetEvalRun       query.GetEvalRunHandler
+	ListEvalCases    query.ListEvalCasesHandler
+	CompareEvalRuns  query.CompareEvalRunsHandler
+
+	GetTrafficSplit  query.GetTrafficSplitHandler
+	ListVariantStats query.ListVariantStatsHandler
+
+	GetExecutionUsage   query.GetExecutionUsageHandler
+	GetProjectUsage     query.GetProjectUsageHandler
+	ListWorkflowUsage   query.ListWorkflowUsageHandler
+	ListCredentialUsage query.ListCredentialUsageHandler
+
+	ListBudgets query.ListBudgetsHandler
+	GetBudget   query.GetBudgetHandler
+
+	ListRateLimits       query.ListRateLimitsHandler
+	GetWorkflowRateLimit query.GetWorkflowRateLimitHandler
+
+	ListAuditEvents   query.ListAuditEventsHandler
+	ExportAuditEvents query.ExportAuditEventsHandler
 
 	ListenWorkflowEvents query.ListenWorkflowEventsHandler
 	GetUser              query.GetUserHandler
+	GetUserByID          query.GetUserByIDHandler
+	ListUsers            query.ListUsersHandler
+	ListInvitations      query.ListInvitationsHandler
+	ListSessions         query.ListSessionsHandler
 
 	GetWorkflowExecutions query.GetWorkflowExecutionsHandler
 	GetTriggerExecution   query.GetTriggerExecutionHandler
@@ -88,56 +222,227 @@ func New(
 	if err != nil {
 		return nil, err
 	}
+	redisSessions, err := redis.NewClient(conf.Redis, redis.DBSessions)
+	if err != nil {
+		return nil, err
+	}
+	redisRateLimits, err := redis.NewClient(conf.Redis, redis.DBRateLimits)
+	if err != nil {
+		return nil, err
+	}
 
 	eventRepo := events.NewRedisEventStore(redisEvents, eventTTL)
+	webhookRepo := webhook.NewRepository(ctx, pool)
+	webhookSender := webhook.NewSender(conf.Webhooks.AllowPrivateNetworks)
+	endUserVerifier := enduser.NewVerifier(conf.Webhooks.AllowPrivateNetworks)
+	batchRepo := batch.NewRepository(ctx, pool)
+	rolloutRepo := rollout.NewRepository(ctx, pool)
+	usageRepo := usage.NewRepository(ctx, pool)
+	projectRepo := project.NewRepository(ctx, pool)
 	router := event.CreateRouter(event.Config{
 		WorkflowsRedis: redisWorkflows,
 		Logger:         logger,
 		EventStore:     eventRepo,
+		WebhookDispatcher: webhookDispatcher{
+			handler: command.NewDispatchWebhookEventHandler(webhookRepo),
+		},
+		BatchTracker: batchTracker{
+			handler: command.NewRecordBatchItemResultHandler(batchRepo),
+		},
+		ExecutionTracker: executionTracker{
+			handler: command.NewRecordExecutionResultHandler(rolloutRepo),
+			usage: command.NewRecordTokenUsageHandler(
+				projectRepo,
+				rolloutRepo,
+				usageRepo,
+				priceTable(conf.Usage),
+			),
+			budgets: command.NewCheckBudgetsHandler(usageRepo, webhookRepo),
+		},
+		InstanceID: conf.Server.InstanceID,
 	})
 
-	projectRepo := project.NewRepository(ctx, pool)
 	userRepo := user.NewRepository(ctx, pool)
+	denylist := auth.NewDenylist(redisSessions)
+	loginAttemptRepo := login.NewRedisAttemptRepository(redisRateLimits)
+	auditRepo := audit.NewRepository(ctx, pool)
+	accessRepo := access.NewRepository(ctx, pool)
 	runnerService := runner.NewService(ctx, router.RunnerPublisher)
 	executionRepo := execution.NewRedisExecutionRepository(redisExecutions)
+	rateLimitRepo := ratelimit.NewRepository(ctx, pool)
+	triggerWorkflow := command.NewTriggerWorkflowHandler(
+		projectRepo,
+		rolloutRepo,
+		usageRepo,
+		rateLimitRepo,
+		ratelimit.NewRedisLimiter(redisRateLimits),
+		runnerService,
+		eventRepo,
+	)
+	scheduleRepo := schedule.NewRepository(ctx, pool)
+	evalRepo := eval.NewRepository(ctx, pool)
 
 	app := &App{
 		pool:             pool,
 		EventsSubscriber: router.InternalSubscriber,
+		schedulerLeader:  postgres.NewLeader(pool, schedulerLockKey),
 		Commands: &Commands{
-			CreateProject:      command.NewCreateProjectHandler(projectRepo),
-			UpdateProjectName:  command.NewUpdateProjectNameHandler(projectRepo),
-			UpdateAuthProvider: command.NewUpdateAuthProviderHandler(projectRepo),
-
-			AddWorkflow:    command.NewAddWorkflowHandler(projectRepo),
-			UpdateWorkflow: command.NewUpdateWorkflowHandler(projectRepo),
-			RemoveWorkflow: command.NewRemoveWorkflowHandler(projectRepo),
-
-			AddCredential:    command.NewAddCredentialHandler(projectRepo),
-			UpdateCredential: command.NewUpdateCredentialHandler(projectRepo),
-			RemoveCredential: command.NewRemoveCredentialHandler(projectRepo),
-
-			TriggerWorkflow:            command.NewTriggerWorkflowHandler(projectRepo, runnerService),
+			CreateProject:      command.NewCreateProjectHandler(projectRepo, accessRepo, auditRepo),
+			UpdateProjectName:  command.NewUpdateProjectNameHandler(projectRepo, auditRepo),
+			UpdateAuthProvider: command.NewUpdateAuthProviderHandler(projectRepo, auditRepo),
+
+			CreateOrganization:          command.NewCreateOrganizationHandler(accessRepo, auditRepo),
+			SaveOrganizationMember:      command.NewSaveOrganizationMemberHandler(accessRepo, auditRepo),
+			RemoveOrganizationMember:    command.NewRemoveOrganizationMemberHandler(accessRepo, auditRepo),
+			SaveProjectMember:           command.NewSaveProjectMemberHandler(accessRepo, auditRepo),
+			RemoveProjectMember:         command.NewRemoveProjectMemberHandler(accessRepo, auditRepo),
+			AuthorizeProjectAccess:      command.NewAuthorizeProjectAccessHandler(projectRepo, accessRepo),
+			AuthorizeOrganizationAccess: command.NewAuthorizeOrganizationAccessHandler(accessRepo),
+
+			AddWorkflow:    command.NewAddWorkflowHandler(projectRepo, auditRepo),
+			UpdateWorkflow: command.NewUpdateWorkflowHandler(projectRepo, auditRepo),
+			RemoveWorkflow: command.NewRemoveWorkflowHandler(projectRepo, auditRepo),
+
+			AddCredential:    command.NewAddCredentialHandler(projectRepo, auditRepo),
+			UpdateCredential: command.NewUpdateCredentialHandler(projectRepo, auditRepo),
+			RemoveCredential: command.NewRemoveCredentialHandler(projectRepo, auditRepo),
+			TestCredential: command.NewTestCredentialHandler(
+				projectRepo,
+				provider.NewVerifier(conf.Providers.BaseURLs),
+				auditRepo,
+			),
+			ReplaceCredential: command.NewReplaceCredentialHandler(projectRepo, auditRepo),
+
+			AddWebhook:       command.NewAddWebhookHandler(projectRepo, webhookRepo, webhookSender, auditRepo),
+			UpdateWebhook:    command.NewUpdateWebhookHandler(projectRepo, webhookRepo, webhookSender, auditRepo),
+			RemoveWebhook:    command.NewRemoveWebhookHandler(webhookRepo, auditRepo),
+			RedeliverWebhook: command.NewRedeliverWebhookHandler(webhookRepo, auditRepo),
+
+			AddWebhookTrigger:    command.NewAddWebhookTriggerHandler(projectRepo, webhookRepo, auditRepo),
+			UpdateWebhookTrigger: command.NewUpdateWebhookTriggerHandler(webhookRepo, auditRepo),
+			RemoveWebhookTrigger: command.NewRemoveWebhookTriggerHandler(webhookRepo, auditRepo),
+			FireWebhookTrigger:   command.NewFireWebhookTriggerHandler(webhookRepo, triggerWorkflow),
+
+			AddSchedule:    command.NewAddScheduleHandler(projectRepo, scheduleRepo, auditRepo),
+			UpdateSchedule: command.NewUpdateScheduleHandler(scheduleRepo, auditRepo),
+			RemoveSchedule: command.NewRemoveScheduleHandler(scheduleRepo, auditRepo),
+
+			AddBatch: command.NewAddBatchHandler(projectRepo, batchRepo, auditRepo),
+
+			AddDataset:      command.NewAddDatasetHandler(projectRepo, evalRepo, auditRepo),
+			UpdateDataset:   command.NewUpdateDatasetHandler(evalRepo, auditRepo),
+			RemoveDataset:   command.NewRemoveDatasetHandler(evalRepo, auditRepo),
+			AddDatasetCases: command.NewAddDatasetCasesHandler(evalRepo, auditRepo),
+			AddEvalRun:      command.NewAddEvalRunHandler(projectRepo, evalRepo, auditRepo),
+
+			SaveTrafficSplit:   command.NewSaveTrafficSplitHandler(projectRepo, rolloutRepo, auditRepo),
+			RemoveTrafficSplit: command.NewRemoveTrafficSplitHandler(rolloutRepo, auditRepo),
+
+			AddBudget:    command.NewAddBudgetHandler(projectRepo, usageRepo, auditRepo),
+			UpdateBudget: command.NewUpdateBudgetHandler(usageRepo, auditRepo),
+			RemoveBudget: command.NewRemoveBudgetHandler(usageRepo, auditRepo),
+
+			SaveRateLimit:   command.NewSaveRateLimitHandler(projectRepo, rateLimitRepo, auditRepo),
+			RemoveRateLimit: command.NewRemoveRateLimitHandler(rateLimitRepo, auditRepo),
+
+			TriggerWorkflow:            triggerWorkflow,
+			CancelWorkflow:             command.NewCancelWorkflowHandler(projectRepo, rolloutRepo, runnerService, auditRepo),
+			AnswerHumanInput:           command.NewAnswerHumanInputHandler(projectRepo, rolloutRepo, runnerService),
 			AuthorizeEventSubscription: command.NewAuthorizeEventSubscriptionHandler(projectRepo),
-			CreateJWT:                  command.NewCreateJWTHandler(userRepo, conf.Auth.SecretKey),
-
-			loadFixture: command.NewLoadFixtureHandler(projectRepo, userRepo, conf.Auth),
+			AuthenticateEndUser:        command.NewAuthenticateEndUserHandler(projectRepo, endUserVerifier),
+			AuthorizeTriggerListening:  command.NewAuthorizeTriggerListeningHandler(projectRepo, rolloutRepo),
+			CreateJWT:                  command.NewCreateJWTHandler(userRepo, loginAttemptRepo, auditRepo, conf.Auth.SecretKey),
+
+			CreateUser:         command.NewCreateUserHandler(userRepo, auditRepo),
+			InviteUser:         command.NewInviteUserHandler(userRepo, auditRepo),
+			RevokeInvitation:   command.NewRevokeInvitationHandler(userRepo, auditRepo),
+			AcceptInvitation:   command.NewAcceptInvitationHandler(userRepo, auditRepo),
+			ChangePassword:     command.NewChangePasswordHandler(userRepo, auditRepo),
+			IssuePasswordReset: command.NewIssuePasswordResetHandler(userRepo, auditRepo),
+			ResetPassword:      command.NewResetPasswordHandler(userRepo, denylist, auditRepo),
+			DeactivateUser:     command.NewDeactivateUserHandler(userRepo, denylist, auditRepo),
+			ActivateUser:       command.NewActivateUserHandler(userRepo, auditRepo),
+
+			CompleteMFALogin:        command.NewCompleteMFALoginHandler(userRepo, loginAttemptRepo, auditRepo, conf.Auth.SecretKey),
+			EnrollTOTP:              command.NewEnrollTOTPHandler(userRepo),
+			ConfirmTOTP:             command.NewConfirmTOTPHandler(userRepo, auditRepo),
+			DisableTOTP:             command.NewDisableTOTPHandler(userRepo, auditRepo),
+			RegenerateRecoveryCodes: command.NewRegenerateRecoveryCodesHandler(userRepo, auditRepo),
+			ResetMFA:                command.NewResetMFAHandler(userRepo, auditRepo),
+
+			RefreshSession:     command.NewRefreshSessionHandler(userRepo, denylist, conf.Auth.SecretKey),
+			RevokeSession:      command.NewRevokeSessionHandler(userRepo, denylist, auditRepo),
+			RevokeUserSessions: command.NewRevokeUserSessionsHandler(userRepo, denylist, auditRepo),
+
+			loadFixture:      command.NewLoadFixtureHandler(projectRepo, userRepo, conf.Auth),
+			deliverWebhooks:  command.NewDeliverWebhooksHandler(webhookRepo, webhookSender),
+			fireDueSchedules: command.NewFireDueSchedulesHandler(scheduleRepo, triggerWorkflow),
+			dispatchBatches:  command.NewDispatchBatchItemsHandler(batchRepo, triggerWorkflow),
+			scoreEvalRuns:    command.NewScoreEvalRunsHandler(evalRepo),
 		},
 		Queries: &Queries{
 			GetProject:   query.NewGetProjectHandler(projectRepo),
 			ListProjects: query.NewListProjectsHandler(projectRepo),
 
-			ListCredentials: query.NewListCredentialsHandler(projectRepo),
-			GetCredential:   query.NewGetCredentialHandler(projectRepo),
+			ListOrganizations:       query.NewListOrganizationsHandler(accessRepo),
+			ListOrganizationMembers: query.NewListOrganizationMembersHandler(accessRepo),
+			ListProjectMembers:      query.NewListProjectMembersHandler(accessRepo),
+
+			ListCredentials:          query.NewListCredentialsHandler(projectRepo),
+			ListCredentialReferences: query.NewListCredentialReferencesHandler(projectRepo),
+			GetCredential:            query.NewGetCredentialHandler(projectRepo),
 
 			ListWorkflows: query.NewListWorkflowsHandler(projectRepo),
 			GetWorkflow:   query.NewGetWorkflowHandler(projectRepo),
 
+			ListWebhooks:          query.NewListWebhooksHandler(webhookRepo),
+			GetWebhook:            query.NewGetWebhookHandler(webhookRepo),
+			ListWebhookDeliveries: query.NewListWebhookDeliveriesHandler(webhookRepo),
+
+			ListWebhookTriggers: query.NewListWebhookTriggersHandler(webhookRepo),
+			GetWebhookTrigger:   query.NewGetWebhookTriggerHandler(webhookRepo),
+
+			ListSchedules: query.NewListSchedulesHandler(scheduleRepo),
+			GetSchedule:   query.NewGetScheduleHandler(scheduleRepo),
+
+			ListBatches:     query.NewListBatchesHandler(batchRepo),
+			GetBatch:        query.NewGetBatchHandler(batchRepo),
+			GetBatchResults: query.NewGetBatchResultsHandler(batchRepo),
+
+			ListDatasets:     query.NewListDatasetsHandler(evalRepo),
+			GetDataset:       query.NewGetDatasetHandler(evalRepo),
+			ListDatasetCases: query.NewListDatasetCasesHandler(evalRepo),
+			ListEvalRuns:     query.NewListEvalRunsHandler(evalRepo),
+			GetEvalRun:       query.NewGetEvalRunHandler(evalRepo),
+			ListEvalCases:    query.NewListEvalCasesHandler(evalRepo),
+			CompareEvalRuns:  query.NewCompareEvalRunsHandler(evalRepo),
+
+			GetTrafficSplit:  query.NewGetTrafficSplitHandler(rolloutRepo),
+			ListVariantStats: query.NewListVariantStatsHandler(rolloutRepo),
+
+			GetExecutionUsage:   query.NewGetExecutionUsageHandler(usageRepo),
+			GetProjectUsage:     query.NewGetProjectUsageHandler(usageRepo),
+			ListWorkflowUsage:   query.NewListWorkflowUsageHandler(usageRepo),
+			ListCredentialUsage: query.NewListCredentialUsageHandler(usageRepo),
+
+			ListBudgets: query.NewListBudgetsHandler(usageRepo),
+			GetBudget:   query.NewGetBudgetHandler(usageRepo),
+
+			ListRateLimits:       query.NewListRateLimitsHandler(rateLimitRepo),
+			GetWorkflowRateLimit: query.NewGetWorkflowRateLimitHandler(rateLimitRepo),
+
+			ListAuditEvents:   query.NewListAuditEventsHandler(auditRepo),
+			ExportAuditEvents: query.NewExportAuditEventsHandler(auditRepo),
+
 			ListenWorkflowEvents: query.NewListenWorkflowEventsHandler(eventRepo),
 			GetUser:              query.NewGetUserHandler(userRepo),
+			GetUserByID:          query.NewGetUserByIDHandler(userRepo),
+			ListUsers:            query.NewListUsersHandler(userRepo),
+			ListInvitations:      query.NewListInvitationsHandler(userRepo),
+			ListSessions:         query.NewListSessionsHandler(userRepo),
 
-			GetWorkflowExecutions: query.NewGetWorkflowExecutionsHandler(executionRepo),
-			GetTriggerExecution:   query.NewGetTriggerExecutionHandler(executionRepo),
+			GetWorkflowExecutions: query.NewGetWorkflowExecutionsHandler(executionRepo, rolloutRepo),
+			GetTriggerExecution:   query.NewGetTriggerExecutionHandler(executionRepo, rolloutRepo),
 		},
 	}
 
@@ -147,6 +452,10 @@ func New(
 	}
 
 	go router.Run()
+	go app.runWebhookDeliveries(ctx)
+	go app.runScheduler(ctx)
+	go app.runBatchDispatch(ctx)
+	go app.runEvalScoring(ctx)
 	return app, nil
 }
 
@@ -154,6 +463,9 @@ func New(
 func (a *App) Shutdown(ctx context.Context) error {
 	slog.Info("shutting down application resources")
 
+	// Let another replica take over the schedules
+	a.schedulerLeader.Resign(ctx)
+
 	// Close database connection pool
 	if a.pool != nil {
 		slog.Info("closing database connection pool")
@@ -165,3 +477,15 @@ func (a *App) Shutdown(ctx context.Context) error {
 
 	return nil
 }
+
+// priceTable merges the configured model prices over the built-in ones.
+func priceTable(conf config.Usage) model.PriceTable {
+	prices := make(model.PriceTable, len(conf.ModelPrices))
+	for name, price := range conf.ModelPrices {
+		prices[name] = model.ModelPrice{
+			Input:  price.Input,
+			Output: price.Output,
+		}
+	}
+	return model.DefaultPriceTable().Merge(prices)
+}
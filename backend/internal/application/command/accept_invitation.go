@@ -0,0 +1,85 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AcceptInvitationCommand struct {
+	UserID   uuid.UUID
+	Token    auth.OneTimeToken
+	Name     string
+	Password string
+}
+
+type AcceptInvitationHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAcceptInvitationHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) AcceptInvitationHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AcceptInvitationHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle creates the account of the invited user and returns it.
+func (h AcceptInvitationHandler) Handle(ctx context.Context, cmd AcceptInvitationCommand) (*model.User, error) {
+	invitation, err := h.userRepo.RetrieveInvitationByToken(ctx, cmd.Token.Digest())
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.InvalidError{Field: "token", Reason: "invalid invitation token", Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	user, err := invitation.Accept(cmd.UserID, cmd.Name, cmd.Password, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.userRepo.AcceptInvitation(ctx, invitation, user)
+	if err != nil {
+		switch {
+		case errors.Is(err, repo.ErrConflict):
+			return nil, errs.InvalidError{Field: "token", Reason: "invitation already accepted", Err: err}
+		case errors.Is(err, repo.ErrDuplicate):
+			return nil, errs.DuplicateError{Resource: "user", ID: user.Email, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionInvitationAccepted,
+		Resource:   "invitation",
+		ResourceID: invitation.ID.String(),
+		After:      model.AuditSnapshot(user),
+		Details:    map[string]any{"userId": user.ID},
+	})
+
+	return user, nil
+}
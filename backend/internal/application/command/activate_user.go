@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ActivateUserCommand struct {
+	UserID uuid.UUID
+}
+
+type ActivateUserHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewActivateUserHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) ActivateUserHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return ActivateUserHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle reactivates a deactivated user.
+func (h ActivateUserHandler) Handle(ctx context.Context, cmd ActivateUserCommand) error {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsActive() {
+		return nil
+	}
+	before := model.AuditSnapshot(user)
+	user.Activate()
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionUserActivated,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(user),
+	})
+
+	return nil
+}
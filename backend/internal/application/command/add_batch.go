@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddBatchCommand struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	WorkflowID  model.WorkflowID
+	Inputs      []map[string]any
+	Concurrency int
+}
+
+type AddBatchHandler struct {
+	projectRepo repository.ProjectRepository
+	batchRepo   repository.BatchRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAddBatchHandler(
+	projectRepo repository.ProjectRepository,
+	batchRepo repository.BatchRepository,
+	auditLogger repository.AuditLogger,
+) AddBatchHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if batchRepo == nil {
+		slog.Error("batchRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddBatchHandler{
+		projectRepo: projectRepo,
+		batchRepo:   batchRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle stores the batch with all its items pending, they are queued
+// by DispatchBatchItemsHandler as the concurrency of the batch allows.
+func (h AddBatchHandler) Handle(ctx context.Context, cmd AddBatchCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	workflow, err := project.GetWorkflow(cmd.WorkflowID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID, Err: err}
+	}
+
+	batch, err := model.NewBatch(cmd.ID, cmd.ProjectID, workflow, cmd.Inputs, cmd.Concurrency)
+	if err != nil {
+		return err
+	}
+
+	err = h.batchRepo.CreateBatch(ctx, batch)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "batch", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &batch.ProjectID,
+		Action:     model.AuditActionBatchCreated,
+		Resource:   "batch",
+		ResourceID: batch.ID.String(),
+		Details: map[string]any{
+			"workflowId": batch.WorkflowID,
+			"revision":   batch.Revision,
+			"items":      len(cmd.Inputs),
+		},
+	})
+
+	return nil
+}
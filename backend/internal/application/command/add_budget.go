@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddBudgetCommand struct {
+	ID           uuid.UUID
+	ProjectID    uuid.UUID
+	Scope        model.BudgetScope
+	WorkflowID   model.WorkflowID
+	CredentialID *uuid.UUID
+	Period       model.BudgetPeriod
+	LimitUSD     float64
+	Thresholds   []int
+	Hard         bool
+}
+
+type AddBudgetHandler struct {
+	projectRepo repository.ProjectRepository
+	budgetRepo  repository.BudgetRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAddBudgetHandler(
+	projectRepo repository.ProjectRepository,
+	budgetRepo repository.BudgetRepository,
+	auditLogger repository.AuditLogger,
+) AddBudgetHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if budgetRepo == nil {
+		slog.Error("budgetRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddBudgetHandler{
+		projectRepo: projectRepo,
+		budgetRepo:  budgetRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h AddBudgetHandler) Handle(ctx context.Context, cmd AddBudgetCommand) error {
+	budget, err := model.NewBudget(
+		cmd.ID,
+		cmd.ProjectID,
+		cmd.Scope,
+		cmd.WorkflowID,
+		cmd.CredentialID,
+		cmd.Period,
+		cmd.LimitUSD,
+		cmd.Thresholds,
+		cmd.Hard,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = validateBudgetScope(ctx, h.projectRepo, cmd.ProjectID, budget.WorkflowID, budget.CredentialID)
+	if err != nil {
+		return err
+	}
+
+	err = h.budgetRepo.CreateBudget(ctx, budget)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "budget", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &budget.ProjectID,
+		Action:     model.AuditActionBudgetCreated,
+		Resource:   "budget",
+		ResourceID: budget.ID.String(),
+		After:      model.AuditSnapshot(budget),
+	})
+
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
 	"github.com/supallm/core/internal/pkg/errs"
-	"github.com/supallm/core/internal/pkg/secret"
 )
 
 type AddCredentialCommand struct {
@@ -19,23 +18,32 @@ type AddCredentialCommand struct {
 	ProjectID    uuid.UUID
 	Name         string
 	ProviderType model.ProviderType
-	APIKey       secret.APIKey
+	// Payload holds the fields of the schema of the provider.
+	Payload map[string]any
 }
 
 type AddCredentialHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewAddCredentialHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) AddCredentialHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return AddCredentialHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -48,7 +56,7 @@ func (h AddCredentialHandler) Handle(ctx context.Context, cmd AddCredentialComma
 		return errs.InternalError{Err: err}
 	}
 
-	credential, err := project.CreateCredential(cmd.ID, cmd.Name, cmd.ProviderType, cmd.APIKey)
+	credential, err := project.CreateCredential(cmd.ID, cmd.Name, cmd.ProviderType, cmd.Payload)
 	if err != nil {
 		return errs.InvalidError{Reason: "unable to add credential", Err: err}
 	}
@@ -61,5 +69,13 @@ func (h AddCredentialHandler) Handle(ctx context.Context, cmd AddCredentialComma
 		return errs.InternalError{Err: err}
 	}
 
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionCredentialCreated,
+		Resource:   "credential",
+		ResourceID: credential.ID.String(),
+		After:      model.AuditSnapshot(credential),
+	})
+
 	return nil
 }
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddDatasetCommand struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	Name        string
+	Description string
+	Cases       []model.DatasetCase
+}
+
+type AddDatasetHandler struct {
+	projectRepo repository.ProjectRepository
+	evalRepo    repository.EvalRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAddDatasetHandler(
+	projectRepo repository.ProjectRepository,
+	evalRepo repository.EvalRepository,
+	auditLogger repository.AuditLogger,
+) AddDatasetHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if evalRepo == nil {
+		slog.Error("evalRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddDatasetHandler{
+		projectRepo: projectRepo,
+		evalRepo:    evalRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h AddDatasetHandler) Handle(ctx context.Context, cmd AddDatasetCommand) error {
+	_, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	dataset, err := model.NewDataset(cmd.ID, cmd.ProjectID, cmd.Name, cmd.Description)
+	if err != nil {
+		return err
+	}
+
+	if len(cmd.Cases) > 0 {
+		if err = model.ValidateDatasetCases(0, cmd.Cases); err != nil {
+			return err
+		}
+	}
+
+	err = h.evalRepo.CreateDataset(ctx, dataset, cmd.Cases)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "dataset", ID: cmd.Name, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &dataset.ProjectID,
+		Action:     model.AuditActionDatasetCreated,
+		Resource:   "dataset",
+		ResourceID: dataset.ID.String(),
+		After:      model.AuditSnapshot(dataset),
+	})
+
+	return nil
+}
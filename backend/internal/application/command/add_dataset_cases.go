@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddDatasetCasesCommand struct {
+	DatasetID uuid.UUID
+	ProjectID uuid.UUID
+	Cases     []model.DatasetCase
+}
+
+type AddDatasetCasesHandler struct {
+	evalRepo    repository.EvalRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAddDatasetCasesHandler(
+	evalRepo repository.EvalRepository,
+	auditLogger repository.AuditLogger,
+) AddDatasetCasesHandler {
+	if evalRepo == nil {
+		slog.Error("evalRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddDatasetCasesHandler{
+		evalRepo:    evalRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle appends the cases after the ones of the dataset.
+func (h AddDatasetCasesHandler) Handle(ctx context.Context, cmd AddDatasetCasesCommand) error {
+	dataset, err := retrieveProjectDataset(ctx, h.evalRepo, cmd.ProjectID, cmd.DatasetID)
+	if err != nil {
+		return err
+	}
+
+	count, err := h.evalRepo.CountDatasetCases(ctx, dataset.ID)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	if err = model.ValidateDatasetCases(count, cmd.Cases); err != nil {
+		return err
+	}
+
+	err = h.evalRepo.AddDatasetCases(ctx, dataset.ID, cmd.Cases)
+	if err != nil {
+		return errs.UpdateError{Entity: "dataset", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &dataset.ProjectID,
+		Action:     model.AuditActionDatasetCasesAdded,
+		Resource:   "dataset",
+		ResourceID: dataset.ID.String(),
+		Details:    map[string]any{"cases": len(cmd.Cases)},
+	})
+
+	return nil
+}
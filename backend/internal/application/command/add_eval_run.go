@@ -0,0 +1,122 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddEvalRunCommand struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	WorkflowID  model.WorkflowID
+	DatasetID   uuid.UUID
+	Scorers     []model.ScorerConfig
+	Concurrency int
+}
+
+type AddEvalRunHandler struct {
+	projectRepo repository.ProjectRepository
+	evalRepo    repository.EvalRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAddEvalRunHandler(
+	projectRepo repository.ProjectRepository,
+	evalRepo repository.EvalRepository,
+	auditLogger repository.AuditLogger,
+) AddEvalRunHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if evalRepo == nil {
+		slog.Error("evalRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddEvalRunHandler{
+		projectRepo: projectRepo,
+		evalRepo:    evalRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle executes the current revision of the workflow across the dataset
+// through a batch, the results are scored by ScoreEvalRunsHandler once it is completed.
+func (h AddEvalRunHandler) Handle(ctx context.Context, cmd AddEvalRunCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	workflow, err := project.GetWorkflow(cmd.WorkflowID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID, Err: err}
+	}
+
+	dataset, err := retrieveProjectDataset(ctx, h.evalRepo, cmd.ProjectID, cmd.DatasetID)
+	if err != nil {
+		return err
+	}
+
+	cases, err := h.evalRepo.ListDatasetCases(ctx, dataset.ID)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	batchID := uuid.New()
+	run, err := model.NewEvalRun(cmd.ID, cmd.ProjectID, workflow, dataset.ID, batchID, cmd.Scorers, cases)
+	if err != nil {
+		return err
+	}
+
+	inputs := make([]map[string]any, len(cases))
+	for i, c := range cases {
+		inputs[i] = c.Inputs
+	}
+
+	batch, err := model.NewBatch(batchID, cmd.ProjectID, workflow, inputs, cmd.Concurrency)
+	if err != nil {
+		return err
+	}
+
+	err = h.evalRepo.CreateEvalRun(ctx, run, batch)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "eval run", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionEvalRunCreated,
+		Resource:   "eval_run",
+		ResourceID: cmd.ID.String(),
+		Details: map[string]any{
+			"workflowId": cmd.WorkflowID,
+			"revision":   batch.Revision,
+			"datasetId":  dataset.ID,
+			"batchId":    batch.ID,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddScheduleCommand struct {
+	ID             uuid.UUID
+	ProjectID      uuid.UUID
+	WorkflowID     model.WorkflowID
+	CronExpression string
+	Timezone       string
+	Inputs         map[string]any
+	Enabled        bool
+}
+
+type AddScheduleHandler struct {
+	projectRepo  repository.ProjectRepository
+	scheduleRepo repository.ScheduleRepository
+	auditLogger  repository.AuditLogger
+}
+
+func NewAddScheduleHandler(
+	projectRepo repository.ProjectRepository,
+	scheduleRepo repository.ScheduleRepository,
+	auditLogger repository.AuditLogger,
+) AddScheduleHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if scheduleRepo == nil {
+		slog.Error("scheduleRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddScheduleHandler{
+		projectRepo:  projectRepo,
+		scheduleRepo: scheduleRepo,
+		auditLogger:  auditLogger,
+	}
+}
+
+func (h AddScheduleHandler) Handle(ctx context.Context, cmd AddScheduleCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if _, err = project.GetWorkflow(cmd.WorkflowID); err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID, Err: err}
+	}
+
+	schedule, err := model.NewSchedule(
+		cmd.ID,
+		cmd.ProjectID,
+		cmd.WorkflowID,
+		cmd.CronExpression,
+		cmd.Timezone,
+		cmd.Inputs,
+		cmd.Enabled,
+		time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = h.scheduleRepo.CreateSchedule(ctx, schedule)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "schedule", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &schedule.ProjectID,
+		Action:     model.AuditActionScheduleCreated,
+		Resource:   "schedule",
+		ResourceID: schedule.ID.String(),
+		After:      model.AuditSnapshot(schedule),
+	})
+
+	return nil
+}
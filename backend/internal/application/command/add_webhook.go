@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddWebhookCommand struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	URL         string
+	EventTypes  []model.WorkflowEventType
+	WorkflowIDs []model.WorkflowID
+}
+
+type AddWebhookHandler struct {
+	projectRepo repository.ProjectRepository
+	webhookRepo repository.WebhookRepository
+	urlChecker  webhookURLChecker
+	auditLogger repository.AuditLogger
+}
+
+func NewAddWebhookHandler(
+	projectRepo repository.ProjectRepository,
+	webhookRepo repository.WebhookRepository,
+	urlChecker webhookURLChecker,
+	auditLogger repository.AuditLogger,
+) AddWebhookHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if urlChecker == nil {
+		slog.Error("urlChecker is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddWebhookHandler{
+		projectRepo: projectRepo,
+		webhookRepo: webhookRepo,
+		urlChecker:  urlChecker,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h AddWebhookHandler) Handle(ctx context.Context, cmd AddWebhookCommand) error {
+	err := validateWebhookWorkflows(ctx, h.projectRepo, cmd.ProjectID, cmd.WorkflowIDs)
+	if err != nil {
+		return err
+	}
+
+	webhook, err := model.NewWebhook(cmd.ID, cmd.ProjectID, cmd.URL, cmd.EventTypes, cmd.WorkflowIDs)
+	if err != nil {
+		return err
+	}
+
+	if err = checkWebhookURL(ctx, h.urlChecker, webhook.URL); err != nil {
+		return err
+	}
+
+	err = h.webhookRepo.CreateWebhook(ctx, webhook)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "webhook", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &webhook.ProjectID,
+		Action:     model.AuditActionWebhookCreated,
+		Resource:   "webhook",
+		ResourceID: webhook.ID.String(),
+		After:      model.AuditSnapshot(webhook),
+	})
+
+	return nil
+}
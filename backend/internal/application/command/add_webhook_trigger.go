@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AddWebhookTriggerCommand struct {
+	ID              uuid.UUID
+	ProjectID       uuid.UUID
+	WorkflowID      model.WorkflowID
+	Name            string
+	Verification    model.WebhookVerification
+	SignatureHeader string
+	Secret          string
+	InputMapping    map[string]string
+	RespondSync     bool
+	SyncTimeout     time.Duration
+}
+
+type AddWebhookTriggerHandler struct {
+	projectRepo repository.ProjectRepository
+	webhookRepo repository.WebhookRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewAddWebhookTriggerHandler(
+	projectRepo repository.ProjectRepository,
+	webhookRepo repository.WebhookRepository,
+	auditLogger repository.AuditLogger,
+) AddWebhookTriggerHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return AddWebhookTriggerHandler{
+		projectRepo: projectRepo,
+		webhookRepo: webhookRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h AddWebhookTriggerHandler) Handle(ctx context.Context, cmd AddWebhookTriggerCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if _, err = project.GetWorkflow(cmd.WorkflowID); err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID, Err: err}
+	}
+
+	trigger, err := model.NewWebhookTrigger(
+		cmd.ID,
+		cmd.ProjectID,
+		cmd.WorkflowID,
+		cmd.Name,
+		cmd.Verification,
+		cmd.SignatureHeader,
+		cmd.Secret,
+		cmd.InputMapping,
+		cmd.RespondSync,
+		cmd.SyncTimeout,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = h.webhookRepo.CreateWebhookTrigger(ctx, trigger)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "webhook trigger", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &trigger.ProjectID,
+		Action:     model.AuditActionWebhookTriggerCreated,
+		Resource:   "webhook_trigger",
+		ResourceID: trigger.ID.String(),
+		After:      model.AuditSnapshot(trigger),
+	})
+
+	return nil
+}
@@ -23,18 +23,26 @@ type AddWorkflowCommand struct {
 
 type AddWorkflowHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewAddWorkflowHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) AddWorkflowHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return AddWorkflowHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -60,5 +68,14 @@ func (h AddWorkflowHandler) Handle(ctx context.Context, cmd AddWorkflowCommand)
 		}
 		return errs.InternalError{Err: err}
 	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &p.ID,
+		Action:     model.AuditActionWorkflowCreated,
+		Resource:   "workflow",
+		ResourceID: workflow.ID.String(),
+		After:      model.AuditSnapshot(workflow),
+	})
+
 	return nil
 }
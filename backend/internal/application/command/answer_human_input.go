@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AnswerHumanInputCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	TriggerID  uuid.UUID
+	// EndUserID restricts the answer to the executions of the end-user,
+	// nil for the dashboard and the holders of the project secret key.
+	EndUserID *string
+	// NodeID is the human input node waiting for the answer.
+	NodeID string
+	Inputs map[string]any
+}
+
+type AnswerHumanInputHandler struct {
+	projectRepo   repository.ProjectRepository
+	rolloutRepo   repository.RolloutRepository
+	runnerService runnerService
+}
+
+func NewAnswerHumanInputHandler(
+	projectRepo repository.ProjectRepository,
+	rolloutRepo repository.RolloutRepository,
+	runnerService runnerService,
+) AnswerHumanInputHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	if runnerService == nil {
+		slog.Error("runnerService is nil")
+		os.Exit(1)
+	}
+
+	return AnswerHumanInputHandler{
+		projectRepo:   projectRepo,
+		rolloutRepo:   rolloutRepo,
+		runnerService: runnerService,
+	}
+}
+
+// Handle relays the answer to the runner executing the trigger, which resumes
+// the node that emitted the HUMAN_INPUT_REQUESTED event.
+func (h AnswerHumanInputHandler) Handle(ctx context.Context, cmd AnswerHumanInputCommand) error {
+	if cmd.NodeID == "" {
+		return errs.ReqMissingError{Field: "nodeId"}
+	}
+
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+	}
+
+	workflow, err := project.GetWorkflow(cmd.WorkflowID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID}
+	}
+
+	err = authorizeExecutionControl(ctx, h.rolloutRepo, cmd.ProjectID, cmd.WorkflowID, cmd.TriggerID, cmd.EndUserID)
+	if err != nil {
+		return err
+	}
+
+	err = h.runnerService.AnswerHumanInput(ctx, cmd.TriggerID, workflow, cmd.NodeID, cmd.Inputs)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	return nil
+}
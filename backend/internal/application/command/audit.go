@@ -0,0 +1,39 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+)
+
+// recordAudit appends the event to the audit log. The event is attributed
+// to the actor of the request unless it names its actor, and only the
+// changed fields of its snapshots are recorded, with their secrets redacted.
+// The change is already applied, so an event failing to be recorded is
+// logged rather than failing the command.
+func recordAudit(ctx context.Context, auditLogger repository.AuditLogger, event model.AuditEvent) {
+	if event.ActorType == "" {
+		actor := auth.ActorFromContext(ctx)
+		event.ActorType = string(actor.Type)
+		event.IPAddress = actor.IPAddress
+		if id, err := uuid.Parse(actor.ID); err == nil {
+			event.ActorID = &id
+		}
+	}
+
+	event.ID = uuid.New()
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	event.Diff()
+
+	if err := auditLogger.Record(ctx, event); err != nil {
+		slog.Error("error recording audit event",
+			"action", event.Action, "resource", event.Resource, "resourceId", event.ResourceID, "error", err)
+	}
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AuthenticateEndUserCommand struct {
+	ProjectID uuid.UUID
+	Token     string
+}
+
+type AuthenticateEndUserHandler struct {
+	projectRepo repository.ProjectRepository
+	verifier    endUserVerifier
+}
+
+func NewAuthenticateEndUserHandler(
+	projectRepo repository.ProjectRepository,
+	verifier endUserVerifier,
+) AuthenticateEndUserHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if verifier == nil {
+		slog.Error("verifier is nil")
+		os.Exit(1)
+	}
+
+	return AuthenticateEndUserHandler{
+		projectRepo: projectRepo,
+		verifier:    verifier,
+	}
+}
+
+// Handle verifies the end-user token against the auth provider of the project.
+func (h AuthenticateEndUserHandler) Handle(ctx context.Context, cmd AuthenticateEndUserCommand) (*model.EndUser, error) {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if project.AuthProvider == nil {
+		return nil, errs.UnauthorizedError{Err: errors.New("project has no auth provider")}
+	}
+
+	endUser, err := h.verifier.Verify(ctx, project.AuthProvider, cmd.Token)
+	if err != nil {
+		return nil, errs.UnauthorizedError{Err: err}
+	}
+
+	return endUser, nil
+}
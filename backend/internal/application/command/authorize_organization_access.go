@@ -0,0 +1,37 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+)
+
+type AuthorizeOrganizationAccessCommand struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Permission     model.Permission
+}
+
+type AuthorizeOrganizationAccessHandler struct {
+	accessRepo repository.AccessRepository
+}
+
+func NewAuthorizeOrganizationAccessHandler(accessRepo repository.AccessRepository) AuthorizeOrganizationAccessHandler {
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	return AuthorizeOrganizationAccessHandler{
+		accessRepo: accessRepo,
+	}
+}
+
+// Handle checks the role of the user in the organization grants the permission.
+func (h AuthorizeOrganizationAccessHandler) Handle(ctx context.Context, cmd AuthorizeOrganizationAccessCommand) error {
+	return authorizeOrganization(ctx, h.accessRepo, cmd.OrganizationID, cmd.UserID, cmd.Permission)
+}
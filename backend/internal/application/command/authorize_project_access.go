@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+// AuthorizeProjectAccessCommand is made for a dashboard user or,
+// when SecretKey is set, for a holder of the project secret key.
+type AuthorizeProjectAccessCommand struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+	SecretKey secret.APIKey
+	// WorkflowID, when set, must be a workflow of the project.
+	WorkflowID model.WorkflowID
+	Permission model.Permission
+}
+
+type AuthorizeProjectAccessHandler struct {
+	projectRepo repository.ProjectRepository
+	accessRepo  repository.AccessRepository
+}
+
+func NewAuthorizeProjectAccessHandler(
+	projectRepo repository.ProjectRepository,
+	accessRepo repository.AccessRepository,
+) AuthorizeProjectAccessHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	return AuthorizeProjectAccessHandler{
+		projectRepo: projectRepo,
+		accessRepo:  accessRepo,
+	}
+}
+
+// Handle returns the role of the caller on the project when it grants the permission.
+// Projects the user holds no role on are reported as not found, so their
+// existence is not disclosed. The secret key acts as a runner of the project.
+func (h AuthorizeProjectAccessHandler) Handle(ctx context.Context, cmd AuthorizeProjectAccessCommand) (model.Role, error) {
+	role, err := h.role(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+
+	if cmd.WorkflowID != "" {
+		exists, existsErr := h.accessRepo.ProjectHasWorkflow(ctx, cmd.ProjectID, cmd.WorkflowID)
+		if existsErr != nil {
+			return "", errs.InternalError{Err: existsErr}
+		}
+		if !exists {
+			return "", errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID}
+		}
+	}
+
+	if !role.Can(cmd.Permission) {
+		return "", errs.ForbiddenError{
+			Entity: "project",
+			Err:    fmt.Errorf("the %s role cannot %s", role, cmd.Permission),
+		}
+	}
+
+	return role, nil
+}
+
+func (h AuthorizeProjectAccessHandler) role(ctx context.Context, cmd AuthorizeProjectAccessCommand) (model.Role, error) {
+	if cmd.SecretKey != "" {
+		project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+		if err != nil {
+			if errors.Is(err, repo.ErrNotFound) {
+				return "", errs.NotFoundError{Resource: "project", ID: cmd.ProjectID, Err: err}
+			}
+			return "", errs.InternalError{Err: err}
+		}
+
+		if !project.ValidateAPIKey(cmd.SecretKey) {
+			return "", errs.UnauthorizedError{Err: errors.New("invalid secret key")}
+		}
+		return model.RoleRunner, nil
+	}
+
+	roles, err := h.accessRepo.ProjectRoles(ctx, cmd.ProjectID, cmd.UserID)
+	if err != nil {
+		return "", errs.InternalError{Err: err}
+	}
+
+	role, ok := model.HighestRole(roles)
+	if !ok {
+		return "", errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+	}
+	return role, nil
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type AuthorizeTriggerListeningCommand struct {
+	ProjectID uuid.UUID
+	TriggerID uuid.UUID
+	EndUserID string
+}
+
+type AuthorizeTriggerListeningHandler struct {
+	projectRepo repository.ProjectRepository
+	rolloutRepo repository.RolloutRepository
+}
+
+func NewAuthorizeTriggerListeningHandler(
+	projectRepo repository.ProjectRepository,
+	rolloutRepo repository.RolloutRepository,
+) AuthorizeTriggerListeningHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	return AuthorizeTriggerListeningHandler{
+		projectRepo: projectRepo,
+		rolloutRepo: rolloutRepo,
+	}
+}
+
+// Handle rejects listeners other than the end-user who triggered the execution.
+// Anonymous listeners are rejected by the projects with an auth provider.
+// Triggers without a recorded execution have no end-user to restrict them to.
+func (h AuthorizeTriggerListeningHandler) Handle(ctx context.Context, cmd AuthorizeTriggerListeningCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+	}
+
+	if cmd.EndUserID == "" && project.AuthProvider != nil {
+		return errs.UnauthorizedError{Err: errors.New("an end-user token is required to listen to this trigger")}
+	}
+
+	execution, err := h.rolloutRepo.RetrieveExecution(ctx, cmd.TriggerID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if execution.ProjectID != cmd.ProjectID {
+		return errs.NotFoundError{Resource: "trigger", ID: cmd.TriggerID}
+	}
+
+	if !execution.ListenableBy(cmd.EndUserID) {
+		return errs.ForbiddenError{Entity: "trigger"}
+	}
+
+	return nil
+}
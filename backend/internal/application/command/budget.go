@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// retrieveProjectBudget returns the budget only when it belongs to the project.
+func retrieveProjectBudget(
+	ctx context.Context,
+	budgetRepo repository.BudgetRepository,
+	projectID uuid.UUID,
+	budgetID uuid.UUID,
+) (*model.Budget, error) {
+	budget, err := budgetRepo.RetrieveBudget(ctx, budgetID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "budget", ID: budgetID, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if budget.ProjectID != projectID {
+		return nil, errs.NotFoundError{Resource: "budget", ID: budgetID}
+	}
+
+	return budget, nil
+}
+
+// enforceBudgets rejects a trigger of the workflow run by the variant once a
+// hard budget it applies to is exhausted for the current period.
+func enforceBudgets(
+	ctx context.Context,
+	budgetRepo repository.BudgetRepository,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	variant *model.Workflow,
+) error {
+	budgets, err := budgetRepo.ListBudgets(ctx, projectID)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	credentialIDs := variant.CredentialIDs()
+	enforced := make([]*model.Budget, 0, len(budgets))
+	for _, budget := range budgets {
+		if budget.Hard && budget.Applies(workflowID, variant.ID, credentialIDs) {
+			enforced = append(enforced, budget)
+		}
+	}
+	if len(enforced) == 0 {
+		return nil
+	}
+
+	spends, err := budgetRepo.Spends(ctx, enforced, time.Now())
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	for _, budget := range enforced {
+		spent := spends[budget.ID]
+		if budget.Exhausted(spent) {
+			return errs.BudgetExceededError{
+				BudgetID: budget.ID,
+				Scope:    string(budget.Scope),
+				Period:   string(budget.Period),
+				LimitUSD: budget.LimitUSD,
+				SpentUSD: spent,
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBudgetScope checks that the workflow or credential of the budget
+// belongs to the project.
+func validateBudgetScope(
+	ctx context.Context,
+	projectRepo repository.ProjectRepository,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	credentialID *uuid.UUID,
+) error {
+	project, err := projectRepo.Retrieve(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: projectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if workflowID != "" {
+		if _, err = project.GetWorkflow(workflowID); err != nil {
+			return errs.InvalidError{Field: "workflowId", Reason: "unknown workflow " + workflowID.String(), Err: err}
+		}
+	}
+
+	if credentialID != nil {
+		if _, ok := project.Credentials[*credentialID]; !ok {
+			return errs.InvalidError{Field: "credentialId", Reason: "unknown credential " + credentialID.String()}
+		}
+	}
+
+	return nil
+}
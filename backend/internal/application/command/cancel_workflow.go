@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type CancelWorkflowCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	TriggerID  uuid.UUID
+	// EndUserID restricts the cancellation to the executions of the end-user,
+	// nil for the dashboard and the holders of the project secret key.
+	EndUserID *string
+}
+
+type CancelWorkflowHandler struct {
+	projectRepo   repository.ProjectRepository
+	rolloutRepo   repository.RolloutRepository
+	runnerService runnerService
+	auditLogger   repository.AuditLogger
+}
+
+func NewCancelWorkflowHandler(
+	projectRepo repository.ProjectRepository,
+	rolloutRepo repository.RolloutRepository,
+	runnerService runnerService,
+	auditLogger repository.AuditLogger,
+) CancelWorkflowHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	if runnerService == nil {
+		slog.Error("runnerService is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return CancelWorkflowHandler{
+		projectRepo:   projectRepo,
+		rolloutRepo:   rolloutRepo,
+		runnerService: runnerService,
+		auditLogger:   auditLogger,
+	}
+}
+
+func (h CancelWorkflowHandler) Handle(ctx context.Context, cmd CancelWorkflowCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+	}
+
+	workflow, err := project.GetWorkflow(cmd.WorkflowID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID}
+	}
+
+	err = authorizeExecutionControl(ctx, h.rolloutRepo, cmd.ProjectID, cmd.WorkflowID, cmd.TriggerID, cmd.EndUserID)
+	if err != nil {
+		return err
+	}
+
+	err = h.runnerService.CancelWorkflow(ctx, cmd.TriggerID, workflow)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionWorkflowCanceled,
+		Resource:   "workflow",
+		ResourceID: workflow.ID.String(),
+		Details:    map[string]any{"triggerId": cmd.TriggerID},
+	})
+
+	return nil
+}
+
+// authorizeExecutionControl rejects the cancellations and answers targeting
+// an execution of another workflow or of another end-user. They are reported
+// as not found so that the triggers of others cannot be probed.
+func authorizeExecutionControl(
+	ctx context.Context,
+	rolloutRepo repository.RolloutRepository,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	triggerID uuid.UUID,
+	endUserID *string,
+) error {
+	if triggerID == uuid.Nil {
+		return errs.ReqMissingError{Field: "triggerId"}
+	}
+
+	execution, err := rolloutRepo.RetrieveExecution(ctx, triggerID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "trigger", ID: triggerID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if execution.ProjectID != projectID || execution.WorkflowID != workflowID {
+		return errs.NotFoundError{Resource: "trigger", ID: triggerID}
+	}
+
+	if endUserID != nil && !execution.ListenableBy(*endUserID) {
+		return errs.NotFoundError{Resource: "trigger", ID: triggerID}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ChangePasswordCommand struct {
+	UserID          uuid.UUID
+	CurrentPassword string
+	NewPassword     string
+}
+
+type ChangePasswordHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewChangePasswordHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) ChangePasswordHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return ChangePasswordHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle changes the password of the user, which lifts a required password change.
+func (h ChangePasswordHandler) Handle(ctx context.Context, cmd ChangePasswordCommand) error {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err = user.ChangePassword(cmd.CurrentPassword, cmd.NewPassword); err != nil {
+		return err
+	}
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionPasswordChanged,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+	})
+
+	return nil
+}
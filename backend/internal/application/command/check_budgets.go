@@ -0,0 +1,137 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type CheckBudgetsCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	TriggerID  uuid.UUID
+	EventType  model.WorkflowEventType
+}
+
+type CheckBudgetsHandler struct {
+	budgetRepo  repository.BudgetRepository
+	webhookRepo repository.WebhookRepository
+}
+
+func NewCheckBudgetsHandler(
+	budgetRepo repository.BudgetRepository,
+	webhookRepo repository.WebhookRepository,
+) CheckBudgetsHandler {
+	if budgetRepo == nil {
+		slog.Error("budgetRepo is nil")
+		os.Exit(1)
+	}
+
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	return CheckBudgetsHandler{
+		budgetRepo:  budgetRepo,
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Handle alerts the thresholds of the budgets of the project reached by the
+// spend of the current period once an execution ends, the other events are
+// ignored. Each threshold is alerted once per period to the webhooks
+// subscribed to BudgetThresholdReached, the deliveries are stored with the
+// alert so that a failure leaves the threshold to be alerted again.
+func (h CheckBudgetsHandler) Handle(ctx context.Context, cmd CheckBudgetsCommand) error {
+	if !cmd.EventType.IsTerminal() {
+		return nil
+	}
+
+	budgets, err := h.budgetRepo.ListBudgets(ctx, cmd.ProjectID)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	spends, err := h.budgetRepo.Spends(ctx, budgets, now)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	var (
+		webhooks []*model.Webhook
+		listed   bool
+	)
+	for _, budget := range budgets {
+		periodStart := budget.PeriodStart(now)
+		spent := spends[budget.ID]
+		threshold, ok := budget.Alert(spent, periodStart)
+		if !ok {
+			continue
+		}
+
+		if !listed {
+			webhooks, err = h.webhookRepo.ListEnabledWebhooks(ctx, cmd.ProjectID)
+			if err != nil {
+				return errs.InternalError{Err: err}
+			}
+			listed = true
+		}
+
+		deliveries, err := alertDeliveries(webhooks, budget, cmd, threshold, spent, periodStart)
+		if err != nil {
+			return err
+		}
+
+		// another replica may have alerted the same threshold
+		marked, err := h.budgetRepo.MarkBudgetAlerted(ctx, budget, deliveries)
+		if err != nil {
+			return errs.InternalError{Err: err}
+		}
+		if !marked {
+			continue
+		}
+
+		slog.Warn("budget threshold reached",
+			"project_id", budget.ProjectID,
+			"budget_id", budget.ID,
+			"threshold", threshold,
+			"spent_usd", spent,
+			"limit_usd", budget.LimitUSD,
+		)
+	}
+
+	return nil
+}
+
+func alertDeliveries(
+	webhooks []*model.Webhook,
+	budget *model.Budget,
+	cmd CheckBudgetsCommand,
+	threshold int,
+	spent float64,
+	periodStart time.Time,
+) ([]*model.WebhookDelivery, error) {
+	payload, err := budget.NewAlert(cmd.WorkflowID, cmd.TriggerID, threshold, spent, periodStart)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(model.BudgetThresholdReached, cmd.WorkflowID) {
+			deliveries = append(deliveries, webhook.NewDelivery(model.BudgetThresholdReached, cmd.TriggerID, payload))
+		}
+	}
+	return deliveries, nil
+}
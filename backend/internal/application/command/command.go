@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/secret"
 )
 
 const (
@@ -25,6 +26,46 @@ type (
 			workflow *model.Workflow,
 			inputs map[string]any,
 		) error
+		CancelWorkflow(
+			ctx context.Context,
+			triggerID uuid.UUID,
+			workflow *model.Workflow,
+		) error
+		AnswerHumanInput(
+			ctx context.Context,
+			triggerID uuid.UUID,
+			workflow *model.Workflow,
+			nodeID string,
+			inputs map[string]any,
+		) error
+	}
+
+	endUserVerifier interface {
+		Verify(ctx context.Context, provider model.AuthProvider, token string) (*model.EndUser, error)
+	}
+
+	// triggerEvents tags the events emitted by the runner for a trigger.
+	triggerEvents interface {
+		BindEndUser(ctx context.Context, workflowID model.WorkflowID, triggerID uuid.UUID, endUserID string) error
+	}
+
+	webhookSender interface {
+		Send(
+			ctx context.Context,
+			url string,
+			signingSecret secret.APIKey,
+			delivery *model.WebhookDelivery,
+		) (status int, body string, err error)
+	}
+
+	// webhookURLChecker rejects the URLs targeting internal addresses.
+	webhookURLChecker interface {
+		CheckURL(ctx context.Context, url string) error
+	}
+
+	credentialVerifier interface {
+		Supports(providerType model.ProviderType) bool
+		Verify(ctx context.Context, providerType model.ProviderType, apiKey secret.APIKey) error
 	}
 
 	retryConfig struct {
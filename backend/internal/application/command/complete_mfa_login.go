@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type CompleteMFALoginCommand struct {
+	ChallengeToken auth.Token
+	Code           string
+	UserAgent      string
+	IPAddress      string
+}
+
+type CompleteMFALoginHandler struct {
+	userRepo     repository.UserRepository
+	attemptsRepo repository.LoginAttemptRepository
+	auditLogger  repository.AuditLogger
+	authKey      string
+}
+
+func NewCompleteMFALoginHandler(
+	userRepo repository.UserRepository,
+	attemptsRepo repository.LoginAttemptRepository,
+	auditLogger repository.AuditLogger,
+	authKey string,
+) CompleteMFALoginHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if attemptsRepo == nil {
+		slog.Error("attemptsRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return CompleteMFALoginHandler{
+		userRepo:     userRepo,
+		attemptsRepo: attemptsRepo,
+		auditLogger:  auditLogger,
+		authKey:      authKey,
+	}
+}
+
+// Handle opens the session of a login challenge given a code of the
+// authenticator app or a recovery code. Wrong codes count as failed logins.
+func (h CompleteMFALoginHandler) Handle(ctx context.Context, cmd CompleteMFALoginCommand) (IssuedSession, error) {
+	userID, err := auth.VerifyMFAChallenge(cmd.ChallengeToken.String(), h.authKey)
+	if err != nil {
+		return IssuedSession{}, errs.UnauthorizedError{Err: err}
+	}
+
+	user, err := retrieveUser(ctx, h.userRepo, userID)
+	if err != nil {
+		return IssuedSession{}, err
+	}
+
+	if !user.IsActive() {
+		return IssuedSession{}, errs.UnauthorizedError{Err: errors.New("user is deactivated")}
+	}
+
+	now := time.Now()
+	guard := newLoginGuard(h.attemptsRepo, h.auditLogger, user.Email, cmd.IPAddress, now)
+	if err = guard.reserve(ctx); err != nil {
+		return IssuedSession{}, err
+	}
+
+	factor, err := user.VerifySecondFactor(cmd.Code, now)
+	if err != nil {
+		var unauthorized errs.UnauthorizedError
+		if !errors.As(err, &unauthorized) {
+			guard.release(ctx)
+			return IssuedSession{}, err
+		}
+		guard.fail(ctx, "second_factor")
+		return IssuedSession{}, err
+	}
+
+	// the code is used up, by one of the logins made with it concurrently
+	if err = h.userRepo.UseSecondFactor(ctx, user.ID, factor); err != nil {
+		if errors.Is(err, repo.ErrConflict) {
+			guard.fail(ctx, "second_factor")
+			return IssuedSession{}, errs.UnauthorizedError{Err: errors.New("invalid two-factor code")}
+		}
+		guard.release(ctx)
+		return IssuedSession{}, errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	if err = guard.succeed(ctx, user); err != nil {
+		return IssuedSession{}, err
+	}
+
+	return openSession(ctx, h.userRepo, user, cmd.UserAgent, cmd.IPAddress, h.authKey, now)
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ConfirmTOTPCommand struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+type ConfirmTOTPHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewConfirmTOTPHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) ConfirmTOTPHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return ConfirmTOTPHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle enables two-factor authentication given a first code of the app,
+// the recovery codes are returned once.
+func (h ConfirmTOTPHandler) Handle(ctx context.Context, cmd ConfirmTOTPCommand) ([]auth.OneTimeToken, error) {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := user.ConfirmTOTP(cmd.Code, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return nil, errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionMFAEnabled,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+	})
+
+	return codes, nil
+}
@@ -3,53 +3,117 @@ package command
 import (
 	"context"
 	"errors"
+	"time"
 
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
 	"github.com/supallm/core/internal/pkg/auth"
 	"github.com/supallm/core/internal/pkg/errs"
 )
 
 type CreateJWTCommand struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	UserAgent string
+	IPAddress string
 }
 
 type CreateJWTHandler struct {
-	userRepo repository.UserRepository
-	authKey  string
+	userRepo     repository.UserRepository
+	attemptsRepo repository.LoginAttemptRepository
+	auditLogger  repository.AuditLogger
+	authKey      string
 }
 
-func NewCreateJWTHandler(userRepo repository.UserRepository, authKey string) CreateJWTHandler {
+func NewCreateJWTHandler(
+	userRepo repository.UserRepository,
+	attemptsRepo repository.LoginAttemptRepository,
+	auditLogger repository.AuditLogger,
+	authKey string,
+) CreateJWTHandler {
 	return CreateJWTHandler{
-		userRepo: userRepo,
-		authKey:  authKey,
+		userRepo:     userRepo,
+		attemptsRepo: attemptsRepo,
+		auditLogger:  auditLogger,
+		authKey:      authKey,
 	}
 }
 
-func (h *CreateJWTHandler) Handle(ctx context.Context, cmd CreateJWTCommand) (auth.Token, error) {
+// Handle logs the user in, opening a session of their own. The users with
+// two-factor authentication get a challenge, completed by CompleteMFALogin.
+// Failed logins are counted per account and per address, they are slowed
+// down then locked out.
+func (h *CreateJWTHandler) Handle(ctx context.Context, cmd CreateJWTCommand) (LoginResult, error) {
+	now := time.Now()
+	guard := newLoginGuard(h.attemptsRepo, h.auditLogger, cmd.Email, cmd.IPAddress, now)
+	if err := guard.reserve(ctx); err != nil {
+		return LoginResult{}, err
+	}
+
+	u, err := h.authenticate(ctx, cmd)
+	if err != nil {
+		var unauthorized errs.UnauthorizedError
+		if !errors.As(err, &unauthorized) {
+			guard.release(ctx)
+			return LoginResult{}, err
+		}
+		guard.fail(ctx, "password")
+		return LoginResult{}, err
+	}
+
+	if !u.IsActive() {
+		guard.release(ctx)
+		return LoginResult{}, errs.UnauthorizedError{
+			Err: errors.New("user is deactivated"),
+		}
+	}
+
+	// the failures are kept until the second factor is given too
+	if u.MFAEnabled() {
+		guard.release(ctx)
+		token, expiresAt, err := auth.GenerateMFAChallenge(u.ID, h.authKey)
+		if err != nil {
+			return LoginResult{}, errs.InternalError{Err: err}
+		}
+
+		return LoginResult{
+			Session:   nil,
+			Challenge: &MFAChallenge{Token: token, ExpiresAt: expiresAt},
+		}, nil
+	}
+
+	if err = guard.succeed(ctx, u); err != nil {
+		return LoginResult{}, err
+	}
+
+	session, err := openSession(ctx, h.userRepo, u, cmd.UserAgent, cmd.IPAddress, h.authKey, now)
+	if err != nil {
+		return LoginResult{}, err
+	}
+
+	return LoginResult{
+		Session:   &session,
+		Challenge: nil,
+	}, nil
+}
+
+func (h *CreateJWTHandler) authenticate(ctx context.Context, cmd CreateJWTCommand) (*model.User, error) {
 	u, err := h.userRepo.GetUserByEmail(ctx, cmd.Email)
 	if err != nil {
-		var notFoundErr errs.NotFoundError
-		if errors.As(err, &notFoundErr) {
-			return "", errs.UnauthorizedError{
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.UnauthorizedError{
 				Err: errors.New("invalid email or password"),
 			}
 		}
-		return "", err
+		return nil, err
 	}
 
 	if !auth.CheckPassword(cmd.Password, u.PasswordHash) {
-		return "", errs.UnauthorizedError{
+		return nil, errs.UnauthorizedError{
 			Err: errors.New("invalid email or password"),
 		}
 	}
 
-	token, err := auth.GenerateToken(u.ID, u.Email, u.Name, h.authKey)
-	if err != nil {
-		return "", errs.InternalError{
-			Err: errors.New("authentication failed"),
-		}
-	}
-
-	return token, nil
+	return u, nil
 }
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type CreateOrganizationCommand struct {
+	ID      uuid.UUID
+	Name    string
+	OwnerID uuid.UUID
+}
+
+type CreateOrganizationHandler struct {
+	accessRepo  repository.AccessRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewCreateOrganizationHandler(
+	accessRepo repository.AccessRepository,
+	auditLogger repository.AuditLogger,
+) CreateOrganizationHandler {
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return CreateOrganizationHandler{
+		accessRepo:  accessRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle creates an organization owned by the user creating it.
+func (h CreateOrganizationHandler) Handle(ctx context.Context, cmd CreateOrganizationCommand) error {
+	organization, err := model.NewOrganization(cmd.ID, cmd.Name, cmd.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	if err = h.accessRepo.CreateOrganization(ctx, organization); err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "organization", ID: cmd.ID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionOrganizationCreated,
+		Resource:   "organization",
+		ResourceID: organization.ID.String(),
+		After:      map[string]any{"Name": organization.Name},
+	})
+
+	return nil
+}
@@ -15,29 +15,55 @@ import (
 
 type CreateProjectCommand struct {
 	ID     uuid.UUID
-	UserID string
+	UserID uuid.UUID
 	Name   string
+	// OrganizationID shares the project with the organization members,
+	// the user must be an editor of the organization or above.
+	OrganizationID *uuid.UUID
 }
 
 type CreateProjectHandler struct {
 	projectRepo repository.ProjectRepository
+	accessRepo  repository.AccessRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewCreateProjectHandler(
 	projectRepo repository.ProjectRepository,
+	accessRepo repository.AccessRepository,
+	auditLogger repository.AuditLogger,
 ) CreateProjectHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return CreateProjectHandler{
 		projectRepo: projectRepo,
+		accessRepo:  accessRepo,
+		auditLogger: auditLogger,
 	}
 }
 
 func (h CreateProjectHandler) Handle(ctx context.Context, cmd CreateProjectCommand) error {
-	project, err := model.NewProject(cmd.ID, cmd.UserID, cmd.Name)
+	if cmd.OrganizationID != nil {
+		err := authorizeOrganization(ctx, h.accessRepo, *cmd.OrganizationID, cmd.UserID, model.PermissionEdit)
+		if err != nil {
+			return err
+		}
+	}
+
+	project, err := model.NewProject(cmd.ID, cmd.UserID.String(), cmd.Name, cmd.OrganizationID)
 	if err != nil {
 		return errs.InvalidError{Reason: err.Error()}
 	}
@@ -50,5 +76,22 @@ func (h CreateProjectHandler) Handle(ctx context.Context, cmd CreateProjectComma
 		return errs.InternalError{Err: err}
 	}
 
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionProjectCreated,
+		Resource:   "project",
+		ResourceID: project.ID.String(),
+		After:      map[string]any{"Name": project.Name, "OrganizationID": project.OrganizationID},
+	})
+
+	for _, key := range project.APIKeys {
+		recordAudit(ctx, h.auditLogger, model.AuditEvent{
+			ProjectID:  &project.ID,
+			Action:     model.AuditActionAPIKeyCreated,
+			Resource:   "api_key",
+			ResourceID: key.ID.String(),
+		})
+	}
+
 	return nil
 }
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type CreateUserCommand struct {
+	ID       uuid.UUID
+	Email    string
+	Name     string
+	Password string
+	Admin    bool
+}
+
+type CreateUserHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewCreateUserHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) CreateUserHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return CreateUserHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle creates a user with a temporary password, which they must change
+// on their first login.
+func (h CreateUserHandler) Handle(ctx context.Context, cmd CreateUserCommand) error {
+	user, err := model.NewUser(cmd.ID, cmd.Email, cmd.Name, cmd.Password, cmd.Admin)
+	if err != nil {
+		return err
+	}
+	user.RequirePasswordChange()
+
+	if err = ensureEmailAvailable(ctx, h.userRepo, user.Email); err != nil {
+		return err
+	}
+
+	err = h.userRepo.CreateUser(ctx, user)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "user", ID: user.Email, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionUserCreated,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+		After:      model.AuditSnapshot(user),
+	})
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type DeactivateUserCommand struct {
+	UserID  uuid.UUID
+	ActorID uuid.UUID
+}
+
+type DeactivateUserHandler struct {
+	userRepo    repository.UserRepository
+	denylist    repository.SessionDenylist
+	auditLogger repository.AuditLogger
+}
+
+func NewDeactivateUserHandler(
+	userRepo repository.UserRepository,
+	denylist repository.SessionDenylist,
+	auditLogger repository.AuditLogger,
+) DeactivateUserHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if denylist == nil {
+		slog.Error("denylist is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return DeactivateUserHandler{
+		userRepo:    userRepo,
+		denylist:    denylist,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle deactivates a user, who can no longer log in nor use their tokens.
+// Admins cannot deactivate themselves, so there is always one left.
+func (h DeactivateUserHandler) Handle(ctx context.Context, cmd DeactivateUserCommand) error {
+	if cmd.UserID == cmd.ActorID {
+		return errs.InvalidError{Field: "userId", Reason: "cannot deactivate yourself"}
+	}
+
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	before := model.AuditSnapshot(user)
+	if err = user.Deactivate(time.Now()); err != nil {
+		return err
+	}
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	if err = revokeUserSessions(ctx, h.userRepo, h.denylist, user.ID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionUserDeactivated,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(user),
+	})
+
+	return nil
+}
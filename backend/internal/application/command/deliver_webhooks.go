@@ -0,0 +1,127 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// deliveryLease covers the sending of a batch, a delivery claimed by
+// a replica that stopped is claimed again once the lease expires.
+const deliveryLease = time.Minute
+
+type DeliverWebhooksCommand struct {
+	BatchSize int
+}
+
+type DeliverWebhooksHandler struct {
+	webhookRepo repository.WebhookRepository
+	sender      webhookSender
+}
+
+func NewDeliverWebhooksHandler(
+	webhookRepo repository.WebhookRepository,
+	sender webhookSender,
+) DeliverWebhooksHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if sender == nil {
+		slog.Error("sender is nil")
+		os.Exit(1)
+	}
+
+	return DeliverWebhooksHandler{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+	}
+}
+
+// Handle sends a batch of due deliveries and records their outcome.
+// It returns the number of deliveries attempted.
+func (h DeliverWebhooksHandler) Handle(ctx context.Context, cmd DeliverWebhooksCommand) (int, error) {
+	deliveries, err := h.webhookRepo.ClaimDueDeliveries(ctx, cmd.BatchSize, deliveryLease)
+	if err != nil {
+		return 0, errs.InternalError{Err: err}
+	}
+
+	webhooks := map[uuid.UUID]*model.Webhook{}
+	for _, delivery := range deliveries {
+		if _, ok := webhooks[delivery.WebhookID]; ok {
+			continue
+		}
+
+		var webhook *model.Webhook
+		webhook, err = h.webhookRepo.RetrieveWebhook(ctx, delivery.WebhookID)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) {
+			return 0, errs.InternalError{Err: err}
+		}
+		webhooks[delivery.WebhookID] = webhook
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		webhook := webhooks[delivery.WebhookID]
+		if webhook == nil {
+			// deleted since the delivery was claimed
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.deliver(ctx, webhook, delivery)
+		}()
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+func (h DeliverWebhooksHandler) deliver(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) {
+	switch status, body, err := h.send(ctx, webhook, delivery); {
+	case !webhook.Enabled:
+		// not retried, the deliveries of a disabled webhook are dropped
+		delivery.Abandon(err.Error())
+	case err != nil:
+		delivery.Fail(status, body, err.Error())
+	case status >= http.StatusOK && status < http.StatusMultipleChoices:
+		delivery.Succeed(status, body)
+	default:
+		delivery.Fail(status, body, fmt.Sprintf("unexpected status %d", status))
+	}
+
+	if err := h.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		slog.Error("error recording webhook delivery", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+func (h DeliverWebhooksHandler) send(
+	ctx context.Context,
+	webhook *model.Webhook,
+	delivery *model.WebhookDelivery,
+) (int, string, error) {
+	if !webhook.Enabled {
+		return 0, "", errors.New("webhook is disabled")
+	}
+
+	signingSecret, err := webhook.Secret.Decrypt()
+	if err != nil {
+		return 0, "", fmt.Errorf("unable to decrypt signing secret: %w", err)
+	}
+
+	return h.sender.Send(ctx, webhook.URL, signingSecret, delivery)
+}
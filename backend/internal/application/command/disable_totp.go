@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type DisableTOTPCommand struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+type DisableTOTPHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewDisableTOTPHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) DisableTOTPHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return DisableTOTPHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle turns two-factor authentication off given a code of the app or a recovery code.
+func (h DisableTOTPHandler) Handle(ctx context.Context, cmd DisableTOTPCommand) error {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err = user.DisableTOTP(cmd.Code, time.Now()); err != nil {
+		return err
+	}
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionMFADisabled,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+	})
+
+	return nil
+}
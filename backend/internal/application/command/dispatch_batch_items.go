@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	// batchItemLease covers the queueing of a claimed item, an item claimed
+	// by a replica that stopped before queueing it is claimed again.
+	batchItemLease = time.Minute
+	// batchItemTimeout fails the items whose workflow never finished, such
+	// as when its runner stopped, so they stop taking a concurrency slot.
+	batchItemTimeout = time.Hour
+)
+
+type DispatchBatchItemsCommand struct{}
+
+type DispatchBatchItemsHandler struct {
+	batchRepo       repository.BatchRepository
+	triggerWorkflow TriggerWorkflowHandler
+}
+
+func NewDispatchBatchItemsHandler(
+	batchRepo repository.BatchRepository,
+	triggerWorkflow TriggerWorkflowHandler,
+) DispatchBatchItemsHandler {
+	if batchRepo == nil {
+		slog.Error("batchRepo is nil")
+		os.Exit(1)
+	}
+
+	return DispatchBatchItemsHandler{
+		batchRepo:       batchRepo,
+		triggerWorkflow: triggerWorkflow,
+	}
+}
+
+// Handle queues the pending items of the running batches, as many as their
+// concurrency allows. Items are claimed in the database, so every replica
+// can run it. It returns the number of items queued.
+func (h DispatchBatchItemsHandler) Handle(ctx context.Context, _ DispatchBatchItemsCommand) (int, error) {
+	batchIDs, err := h.batchRepo.ListRunningBatchIDs(ctx)
+	if err != nil {
+		return 0, errs.InternalError{Err: err}
+	}
+
+	queued := 0
+	for _, batchID := range batchIDs {
+		batch, items, err := h.batchRepo.ClaimBatchItems(ctx, batchID, batchItemLease, batchItemTimeout)
+		if err != nil {
+			slog.Error("error claiming batch items", "batch_id", batchID, "error", err)
+			continue
+		}
+
+		for _, item := range items {
+			if h.queue(ctx, batch, item) {
+				queued++
+			}
+		}
+	}
+
+	return queued, nil
+}
+
+func (h DispatchBatchItemsHandler) queue(ctx context.Context, batch *model.Batch, item *model.BatchItem) bool {
+	err := h.triggerWorkflow.Handle(ctx, TriggerWorkflowCommand{
+		ProjectID:  batch.ProjectID,
+		WorkflowID: batch.WorkflowID,
+		Revision:   batch.Revision,
+		TriggerID:  item.TriggerID,
+		SessionID:  uuid.New(),
+		EndUserID:  "",
+		Caller:     model.TriggerCaller{},
+		Inputs:     item.Inputs,
+	})
+	if err == nil {
+		if err = h.batchRepo.MarkBatchItemQueued(ctx, item.TriggerID); err != nil {
+			// claimed again once the lease expires
+			slog.Error("error recording batch item queued", "batch_id", batch.ID, "index", item.Index, "error", err)
+		}
+		return true
+	}
+
+	var limited errs.RateLimitedError
+	if errors.As(err, &limited) {
+		// claimed again once the lease expires
+		slog.Warn("batch item rate limited", "batch_id", batch.ID, "index", item.Index, "retry_after", limited.RetryAfter)
+		return false
+	}
+
+	slog.Error("error queueing batch item", "batch_id", batch.ID, "index", item.Index, "error", err)
+	item.Fail(err)
+	if err = h.batchRepo.FinishBatchItem(ctx, item); err != nil {
+		slog.Error("error recording batch item failure", "batch_id", batch.ID, "index", item.Index, "error", err)
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type DispatchWebhookEventCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	TriggerID  uuid.UUID
+	EventType  model.WorkflowEventType
+	Payload    []byte
+}
+
+type DispatchWebhookEventHandler struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewDispatchWebhookEventHandler(
+	webhookRepo repository.WebhookRepository,
+) DispatchWebhookEventHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	return DispatchWebhookEventHandler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Handle queues a delivery of the event for every subscribed webhook of the project,
+// deliveries are sent by DeliverWebhooksHandler.
+func (h DispatchWebhookEventHandler) Handle(ctx context.Context, cmd DispatchWebhookEventCommand) error {
+	if cmd.ProjectID == uuid.Nil {
+		return nil
+	}
+
+	webhooks, err := h.webhookRepo.ListEnabledWebhooks(ctx, cmd.ProjectID)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(cmd.EventType, cmd.WorkflowID) {
+			deliveries = append(deliveries, webhook.NewDelivery(cmd.EventType, cmd.TriggerID, cmd.Payload))
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	err = h.webhookRepo.AddDeliveries(ctx, deliveries)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type EnrollTOTPCommand struct {
+	UserID uuid.UUID
+}
+
+type EnrollTOTPHandler struct {
+	userRepo repository.UserRepository
+}
+
+func NewEnrollTOTPHandler(userRepo repository.UserRepository) EnrollTOTPHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	return EnrollTOTPHandler{
+		userRepo: userRepo,
+	}
+}
+
+// Handle generates the TOTP secret of the user, to be confirmed with ConfirmTOTP.
+func (h EnrollTOTPHandler) Handle(ctx context.Context, cmd EnrollTOTPCommand) (model.TOTPEnrollment, error) {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return model.TOTPEnrollment{}, err
+	}
+
+	enrollment, err := user.EnrollTOTP()
+	if err != nil {
+		return model.TOTPEnrollment{}, err
+	}
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return model.TOTPEnrollment{}, errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	return enrollment, nil
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// retrieveProjectDataset returns the dataset only when it belongs to the project.
+func retrieveProjectDataset(
+	ctx context.Context,
+	evalRepo repository.EvalRepository,
+	projectID uuid.UUID,
+	datasetID uuid.UUID,
+) (*model.Dataset, error) {
+	dataset, err := evalRepo.RetrieveDataset(ctx, datasetID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "dataset", ID: datasetID, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if dataset.ProjectID != projectID {
+		return nil, errs.NotFoundError{Resource: "dataset", ID: datasetID}
+	}
+
+	return dataset, nil
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type FireDueSchedulesCommand struct {
+	Now       time.Time
+	BatchSize int
+}
+
+type FireDueSchedulesHandler struct {
+	scheduleRepo    repository.ScheduleRepository
+	triggerWorkflow TriggerWorkflowHandler
+}
+
+func NewFireDueSchedulesHandler(
+	scheduleRepo repository.ScheduleRepository,
+	triggerWorkflow TriggerWorkflowHandler,
+) FireDueSchedulesHandler {
+	if scheduleRepo == nil {
+		slog.Error("scheduleRepo is nil")
+		os.Exit(1)
+	}
+
+	return FireDueSchedulesHandler{
+		scheduleRepo:    scheduleRepo,
+		triggerWorkflow: triggerWorkflow,
+	}
+}
+
+// Handle triggers the workflows of a batch of due schedules, it must only run
+// on the leader replica. It returns the number of schedules fired.
+func (h FireDueSchedulesHandler) Handle(ctx context.Context, cmd FireDueSchedulesCommand) (int, error) {
+	schedules, err := h.scheduleRepo.ListDueSchedules(ctx, cmd.Now, cmd.BatchSize)
+	if err != nil {
+		return 0, errs.InternalError{Err: err}
+	}
+
+	for _, schedule := range schedules {
+		h.fire(ctx, schedule, cmd.Now)
+	}
+
+	return len(schedules), nil
+}
+
+// fire records the fire before triggering the workflow, so a schedule
+// is never fired twice for the same time. Only the fire is written, a
+// schedule edited or disabled since it was listed is left as is.
+func (h FireDueSchedulesHandler) fire(ctx context.Context, schedule *model.Schedule, now time.Time) {
+	previousFireAt := schedule.NextFireAt
+	triggerID, err := schedule.Fire(now)
+	if err != nil {
+		// the expression was valid when stored, disable the schedule rather than retrying it forever
+		schedule.Failed(err)
+		if err = h.scheduleRepo.DisableSchedule(ctx, schedule); err != nil {
+			slog.Error("error disabling schedule", "schedule_id", schedule.ID, "error", err)
+		}
+		return
+	}
+
+	fired, err := h.scheduleRepo.RecordScheduleFire(ctx, schedule, previousFireAt)
+	if err != nil {
+		slog.Error("error recording schedule fire", "schedule_id", schedule.ID, "error", err)
+		return
+	}
+	if !fired {
+		return
+	}
+
+	err = h.triggerWorkflow.Handle(ctx, TriggerWorkflowCommand{
+		ProjectID:  schedule.ProjectID,
+		WorkflowID: schedule.WorkflowID,
+		TriggerID:  triggerID,
+		SessionID:  uuid.New(),
+		EndUserID:  "",
+		Caller:     model.TriggerCaller{},
+		Inputs:     schedule.Inputs,
+	})
+	if err != nil {
+		slog.Error("error triggering scheduled workflow", "schedule_id", schedule.ID, "error", err)
+		if err = h.scheduleRepo.RecordScheduleError(ctx, schedule.ID, err.Error()); err != nil {
+			slog.Error("error recording schedule error", "schedule_id", schedule.ID, "error", err)
+		}
+	}
+}
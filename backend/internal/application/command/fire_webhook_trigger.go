@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type FireWebhookTriggerCommand struct {
+	ID        uuid.UUID
+	TriggerID uuid.UUID
+	SessionID uuid.UUID
+	Request   model.InboundRequest
+	// Caller is the address the request came from.
+	Caller model.TriggerCaller
+}
+
+// FiredWebhookTrigger tells the caller where the workflow runs and
+// whether the result is expected in the response.
+type FiredWebhookTrigger struct {
+	ProjectID   uuid.UUID
+	WorkflowID  model.WorkflowID
+	RespondSync bool
+	SyncTimeout time.Duration
+}
+
+type FireWebhookTriggerHandler struct {
+	webhookRepo     repository.WebhookRepository
+	triggerWorkflow TriggerWorkflowHandler
+}
+
+func NewFireWebhookTriggerHandler(
+	webhookRepo repository.WebhookRepository,
+	triggerWorkflow TriggerWorkflowHandler,
+) FireWebhookTriggerHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	return FireWebhookTriggerHandler{
+		webhookRepo:     webhookRepo,
+		triggerWorkflow: triggerWorkflow,
+	}
+}
+
+// Handle verifies the inbound request and runs the workflow with the mapped inputs.
+func (h FireWebhookTriggerHandler) Handle(
+	ctx context.Context,
+	cmd FireWebhookTriggerCommand,
+) (FiredWebhookTrigger, error) {
+	trigger, err := h.webhookRepo.RetrieveWebhookTrigger(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return FiredWebhookTrigger{}, errs.NotFoundError{Resource: "webhook trigger", ID: cmd.ID, Err: err}
+		}
+		return FiredWebhookTrigger{}, errs.InternalError{Err: err}
+	}
+
+	if err = trigger.Verify(cmd.Request, time.Now()); err != nil {
+		return FiredWebhookTrigger{}, err
+	}
+
+	inputs, err := trigger.Inputs(cmd.Request)
+	if err != nil {
+		return FiredWebhookTrigger{}, err
+	}
+
+	err = h.triggerWorkflow.Handle(ctx, TriggerWorkflowCommand{
+		ProjectID:  trigger.ProjectID,
+		WorkflowID: trigger.WorkflowID,
+		TriggerID:  cmd.TriggerID,
+		SessionID:  cmd.SessionID,
+		EndUserID:  "",
+		Caller:     cmd.Caller,
+		Inputs:     inputs,
+	})
+	if err != nil {
+		return FiredWebhookTrigger{}, err
+	}
+
+	return FiredWebhookTrigger{
+		ProjectID:   trigger.ProjectID,
+		WorkflowID:  trigger.WorkflowID,
+		RespondSync: trigger.RespondSync,
+		SyncTimeout: trigger.Timeout(),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type InviteUserCommand struct {
+	ID        uuid.UUID
+	Email     string
+	Name      string
+	Admin     bool
+	InvitedBy uuid.UUID
+}
+
+type InviteUserHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewInviteUserHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) InviteUserHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return InviteUserHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle creates an invitation and returns its token, to be shared
+// as a link with the invited user.
+func (h InviteUserHandler) Handle(ctx context.Context, cmd InviteUserCommand) (IssuedToken, error) {
+	invitation, token, err := model.NewInvitation(cmd.ID, cmd.Email, cmd.Name, cmd.Admin, cmd.InvitedBy, time.Now())
+	if err != nil {
+		return IssuedToken{}, err
+	}
+
+	if err = ensureEmailAvailable(ctx, h.userRepo, invitation.Email); err != nil {
+		return IssuedToken{}, err
+	}
+
+	if err = h.userRepo.CreateInvitation(ctx, invitation); err != nil {
+		return IssuedToken{}, errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionUserInvited,
+		Resource:   "invitation",
+		ResourceID: invitation.ID.String(),
+		After:      model.AuditSnapshot(invitation),
+	})
+
+	return IssuedToken{
+		Token:     token,
+		ExpiresAt: invitation.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type IssuePasswordResetCommand struct {
+	UserID uuid.UUID
+}
+
+type IssuePasswordResetHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewIssuePasswordResetHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) IssuePasswordResetHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return IssuePasswordResetHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle issues a password reset token for the user, replacing the ones
+// not used yet. The token is handed out by the admin as a link.
+func (h IssuePasswordResetHandler) Handle(ctx context.Context, cmd IssuePasswordResetCommand) (IssuedToken, error) {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return IssuedToken{}, err
+	}
+
+	if !user.IsActive() {
+		return IssuedToken{}, errs.ForbiddenError{Err: errors.New("user is deactivated")}
+	}
+
+	reset, token, err := model.NewPasswordReset(user.ID, time.Now())
+	if err != nil {
+		return IssuedToken{}, errs.InternalError{Err: err}
+	}
+
+	if err = h.userRepo.CreatePasswordReset(ctx, reset); err != nil {
+		return IssuedToken{}, errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionPasswordResetIssued,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+		Details:    map[string]any{"expiresAt": reset.ExpiresAt},
+	})
+
+	return IssuedToken{
+		Token:     token,
+		ExpiresAt: reset.ExpiresAt,
+	}, nil
+}
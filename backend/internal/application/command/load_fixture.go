@@ -44,11 +44,14 @@ func NewLoadFixtureHandler(
 	}
 }
 
+// Handle creates the initial admin user and its default project. The user
+// logs in with the configured credentials and must change the password,
+// which is required again as long as it is left as configured.
 func (h LoadFixtureHandler) Handle(ctx context.Context, cmd LoadFixtureCommand) error {
-	_, err := h.userRepo.GetUserByEmail(ctx, h.defaultUser.InitialUser.Email)
+	existing, err := h.userRepo.GetUserByEmail(ctx, h.defaultUser.InitialUser.Email)
 	if err == nil {
 		slog.Info("user already exists, skipping fixture load")
-		return nil
+		return h.requireInitialPasswordChange(ctx, existing)
 	}
 	if !errors.Is(err, repo.ErrNotFound) {
 		return errs.InternalError{Err: err}
@@ -60,10 +63,14 @@ func (h LoadFixtureHandler) Handle(ctx context.Context, cmd LoadFixtureCommand)
 	}
 
 	user := &model.User{
-		ID:           uuid.New(),
-		Email:        h.defaultUser.InitialUser.Email,
-		Name:         h.defaultUser.InitialUser.Name,
-		PasswordHash: hash,
+		ID:                 uuid.New(),
+		Email:              h.defaultUser.InitialUser.Email,
+		Name:               h.defaultUser.InitialUser.Name,
+		PasswordHash:       hash,
+		Admin:              true,
+		MustChangePassword: true,
+		DeactivatedAt:      nil,
+		TOTP:               nil,
 	}
 
 	err = h.userRepo.CreateUser(ctx, user)
@@ -71,7 +78,7 @@ func (h LoadFixtureHandler) Handle(ctx context.Context, cmd LoadFixtureCommand)
 		return errs.InternalError{Err: err}
 	}
 
-	project, err := model.NewProject(uuid.New(), user.ID.String(), "default")
+	project, err := model.NewProject(uuid.New(), user.ID.String(), "default", nil)
 	if err != nil {
 		return errs.InternalError{Err: err}
 	}
@@ -83,3 +90,17 @@ func (h LoadFixtureHandler) Handle(ctx context.Context, cmd LoadFixtureCommand)
 
 	return nil
 }
+
+func (h LoadFixtureHandler) requireInitialPasswordChange(ctx context.Context, user *model.User) error {
+	if user.MustChangePassword || !auth.CheckPassword(h.defaultUser.InitialUser.Password, user.PasswordHash) {
+		return nil
+	}
+
+	user.RequirePasswordChange()
+	if err := h.userRepo.UpdateUser(ctx, user); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	slog.Warn("initial user still has the configured password, a password change is required", "email", user.Email)
+	return nil
+}
@@ -0,0 +1,185 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// LoginResult holds the session of the user or, when they enabled two-factor
+// authentication, the challenge to complete with a code.
+type LoginResult struct {
+	Session   *IssuedSession
+	Challenge *MFAChallenge
+}
+
+// MFAChallenge is the token of a login waiting for its second factor.
+type MFAChallenge struct {
+	Token     auth.Token
+	ExpiresAt time.Time
+}
+
+// loginThrottle is a throttle applied to the logins of an account or an address.
+type loginThrottle struct {
+	model.LoginThrottle
+	key     string
+	subject string
+	// previous are the attempts preceding the one reserved, if any.
+	previous *model.LoginAttempts
+}
+
+// loginGuard counts the failed logins per account and per address, they
+// are slowed down then locked out. Failed second factors count as well.
+// Each attempt is reserved as a failure before the credentials are checked,
+// then released when they are valid.
+type loginGuard struct {
+	attemptsRepo repository.LoginAttemptRepository
+	auditLogger  repository.AuditLogger
+	email        string
+	ipAddress    string
+	now          time.Time
+	throttles    []*loginThrottle
+}
+
+func newLoginGuard(
+	attemptsRepo repository.LoginAttemptRepository,
+	auditLogger repository.AuditLogger,
+	email string,
+	ipAddress string,
+	now time.Time,
+) *loginGuard {
+	email = strings.ToLower(strings.TrimSpace(email))
+	return &loginGuard{
+		attemptsRepo: attemptsRepo,
+		auditLogger:  auditLogger,
+		email:        email,
+		ipAddress:    ipAddress,
+		now:          now,
+		throttles: []*loginThrottle{
+			{LoginThrottle: model.AccountLoginThrottle, key: "account:" + email, subject: email, previous: nil},
+			{LoginThrottle: model.IPLoginThrottle, key: "ip:" + ipAddress, subject: ipAddress, previous: nil},
+		},
+	}
+}
+
+// reserve counts the attempt as a failure, it is rejected and released while
+// a delay or a lockout applies to the attempts preceding it.
+func (g *loginGuard) reserve(ctx context.Context) error {
+	for _, throttle := range g.throttles {
+		previous, err := g.attemptsRepo.ReserveLoginAttempt(ctx, throttle.key, g.now, throttle.Retention())
+		if err != nil {
+			g.release(ctx)
+			return errs.InternalError{Err: err}
+		}
+		throttle.previous = &previous
+
+		if err = throttle.Check(previous, g.now); err != nil {
+			g.release(ctx)
+			return err
+		}
+	}
+	return nil
+}
+
+// release uncounts the reserved attempt, its credentials were valid or were
+// not checked. A release failing leaves a failure counted, which is logged.
+func (g *loginGuard) release(ctx context.Context) {
+	for _, throttle := range g.throttles {
+		if throttle.previous == nil {
+			continue
+		}
+
+		err := g.attemptsRepo.ReleaseLoginAttempt(ctx, throttle.key, g.now, *throttle.previous)
+		if err != nil {
+			slog.Error("error releasing login attempt", "scope", throttle.Scope, "error", err)
+		}
+		throttle.previous = nil
+	}
+}
+
+// fail audits the failed login of the factor, which the reservation already
+// counted, along with the lockouts it causes.
+func (g *loginGuard) fail(ctx context.Context, factor string) {
+	recordAudit(ctx, g.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionLoginFailed,
+		Resource:   "user",
+		ResourceID: g.email,
+		Details:    map[string]any{"factor": factor},
+		OccurredAt: g.now,
+	})
+
+	for _, throttle := range g.throttles {
+		if throttle.previous == nil {
+			continue
+		}
+
+		attempts := model.LoginAttempts{Failures: throttle.previous.Failures + 1, LastFailureAt: g.now}
+		if !throttle.LocksOut(attempts) {
+			continue
+		}
+
+		recordAudit(ctx, g.auditLogger, model.AuditEvent{
+			Action:     model.AuditActionLoginLocked,
+			Resource:   throttle.Scope,
+			ResourceID: throttle.subject,
+			Details: map[string]any{
+				"failures":    attempts.Failures,
+				"lockedUntil": g.now.Add(throttle.LockoutDuration),
+			},
+			OccurredAt: g.now,
+		})
+	}
+}
+
+// succeed forgets the failures of the account, those of the address are
+// kept but for the attempt, and audits the login of the user.
+func (g *loginGuard) succeed(ctx context.Context, user *model.User) error {
+	account := g.throttles[0]
+	if err := g.attemptsRepo.ResetLoginAttempts(ctx, account.key); err != nil {
+		return errs.InternalError{Err: err}
+	}
+	account.previous = nil
+	g.release(ctx)
+
+	recordAudit(ctx, g.auditLogger, model.AuditEvent{
+		ActorType:  string(auth.ActorUser),
+		ActorID:    &user.ID,
+		IPAddress:  g.ipAddress,
+		Action:     model.AuditActionLoginSucceeded,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+		Details:    map[string]any{"mfa": user.MFAEnabled()},
+		OccurredAt: g.now,
+	})
+
+	return nil
+}
+
+// openSession logs the user in with a new session.
+func openSession(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	user *model.User,
+	userAgent string,
+	ipAddress string,
+	authKey string,
+	now time.Time,
+) (IssuedSession, error) {
+	session, refreshToken, err := model.NewSession(uuid.New(), user.ID, userAgent, ipAddress, now)
+	if err != nil {
+		return IssuedSession{}, errs.InternalError{Err: err}
+	}
+
+	if err = userRepo.CreateSession(ctx, session); err != nil {
+		return IssuedSession{}, errs.CreateError{Entity: "session", Err: err}
+	}
+
+	return issueSession(user, session, refreshToken, authKey, now)
+}
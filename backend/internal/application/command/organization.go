@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+func retrieveOrganization(
+	ctx context.Context,
+	accessRepo repository.AccessRepository,
+	id uuid.UUID,
+) (*model.Organization, error) {
+	organization, err := accessRepo.RetrieveOrganization(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "organization", ID: id, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+	return organization, nil
+}
+
+// authorizeOrganization checks the role of the user in the organization grants
+// the permission, organizations the user is not a member of are reported as not found.
+func authorizeOrganization(
+	ctx context.Context,
+	accessRepo repository.AccessRepository,
+	organizationID uuid.UUID,
+	userID uuid.UUID,
+	permission model.Permission,
+) error {
+	organization, err := retrieveOrganization(ctx, accessRepo, organizationID)
+	if err != nil {
+		return err
+	}
+
+	role, ok := organization.RoleOf(userID)
+	if !ok {
+		return errs.NotFoundError{Resource: "organization", ID: organizationID}
+	}
+
+	if !role.Can(permission) {
+		return errs.ForbiddenError{
+			Entity: "organization",
+			Err:    fmt.Errorf("the %s role cannot %s", role, permission),
+		}
+	}
+
+	return nil
+}
+
+// memberSaveError reports the members referencing an unknown user as not found.
+func memberSaveError(err error, userID uuid.UUID) error {
+	if errors.Is(err, repo.ErrInvalid) {
+		return errs.NotFoundError{Resource: "user", ID: userID, Err: err}
+	}
+	return errs.UpdateError{Entity: "member", Err: err}
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RecordBatchItemResultCommand struct {
+	TriggerID uuid.UUID
+	EventType model.WorkflowEventType
+	Data      map[string]any
+}
+
+type RecordBatchItemResultHandler struct {
+	batchRepo repository.BatchRepository
+}
+
+func NewRecordBatchItemResultHandler(batchRepo repository.BatchRepository) RecordBatchItemResultHandler {
+	if batchRepo == nil {
+		slog.Error("batchRepo is nil")
+		os.Exit(1)
+	}
+
+	return RecordBatchItemResultHandler{
+		batchRepo: batchRepo,
+	}
+}
+
+// Handle records the outcome of the execution when it belongs to a batch,
+// the other executions are ignored.
+func (h RecordBatchItemResultHandler) Handle(ctx context.Context, cmd RecordBatchItemResultCommand) error {
+	if !cmd.EventType.IsTerminal() {
+		return nil
+	}
+
+	item, err := h.batchRepo.RetrieveBatchItem(ctx, cmd.TriggerID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if item.Status != model.BatchItemRunning {
+		return nil
+	}
+
+	item.Finish(cmd.EventType, cmd.Data)
+	if err = h.batchRepo.FinishBatchItem(ctx, item); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	return nil
+}
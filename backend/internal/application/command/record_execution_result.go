@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RecordExecutionResultCommand struct {
+	TriggerID uuid.UUID
+	EventType model.WorkflowEventType
+	Data      map[string]any
+}
+
+type RecordExecutionResultHandler struct {
+	rolloutRepo repository.RolloutRepository
+}
+
+func NewRecordExecutionResultHandler(rolloutRepo repository.RolloutRepository) RecordExecutionResultHandler {
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	return RecordExecutionResultHandler{
+		rolloutRepo: rolloutRepo,
+	}
+}
+
+// Handle records the outcome of the execution on its terminal event,
+// the other events are ignored.
+func (h RecordExecutionResultHandler) Handle(ctx context.Context, cmd RecordExecutionResultCommand) error {
+	if !cmd.EventType.IsTerminal() {
+		return nil
+	}
+
+	execution, err := h.rolloutRepo.RetrieveExecution(ctx, cmd.TriggerID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if execution.Status != model.ExecutionRunning {
+		return nil
+	}
+
+	execution.Finish(cmd.EventType, cmd.Data)
+	if err = h.rolloutRepo.FinishExecution(ctx, execution); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	return nil
+}
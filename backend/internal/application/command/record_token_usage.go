@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RecordTokenUsageCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	TriggerID  uuid.UUID
+	Sequence   uint64
+	EventType  model.WorkflowEventType
+	Data       map[string]any
+}
+
+type RecordTokenUsageHandler struct {
+	projectRepo repository.ProjectRepository
+	rolloutRepo repository.RolloutRepository
+	usageRepo   repository.UsageRepository
+	prices      model.PriceTable
+}
+
+func NewRecordTokenUsageHandler(
+	projectRepo repository.ProjectRepository,
+	rolloutRepo repository.RolloutRepository,
+	usageRepo repository.UsageRepository,
+	prices model.PriceTable,
+) RecordTokenUsageHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	if usageRepo == nil {
+		slog.Error("usageRepo is nil")
+		os.Exit(1)
+	}
+
+	return RecordTokenUsageHandler{
+		projectRepo: projectRepo,
+		rolloutRepo: rolloutRepo,
+		usageRepo:   usageRepo,
+		prices:      prices,
+	}
+}
+
+// Handle prices and records the token usage reported by a node completed event,
+// events without usage are ignored. The usage is attributed to the variant
+// that ran the execution and to the credential configured on the node.
+func (h RecordTokenUsageHandler) Handle(ctx context.Context, cmd RecordTokenUsageCommand) error {
+	tokens, ok := model.ExtractTokenUsage(cmd.EventType, cmd.Data)
+	if !ok {
+		return nil
+	}
+
+	variantID := cmd.WorkflowID
+	execution, err := h.rolloutRepo.RetrieveExecution(ctx, cmd.TriggerID)
+	switch {
+	case err == nil:
+		variantID = execution.VariantID
+	case !errors.Is(err, repo.ErrNotFound):
+		return errs.InternalError{Err: err}
+	}
+
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	nodeID, _ := cmd.Data["nodeId"].(string)
+	nodeType, _ := cmd.Data["nodeType"].(string)
+
+	var credentialID *uuid.UUID
+	if workflow, err := project.GetWorkflow(variantID); err == nil {
+		var configured string
+		configured, credentialID = workflow.NodeModel(nodeID)
+		if tokens.Model == "" {
+			tokens.Model = configured
+		}
+	}
+
+	cost, priced := h.prices.Cost(tokens)
+	err = h.usageRepo.AddNodeUsage(ctx, &model.NodeUsage{
+		TriggerID:    cmd.TriggerID,
+		Sequence:     cmd.Sequence,
+		ProjectID:    cmd.ProjectID,
+		WorkflowID:   cmd.WorkflowID,
+		VariantID:    variantID,
+		NodeID:       nodeID,
+		NodeType:     nodeType,
+		CredentialID: credentialID,
+		Usage:        tokens,
+		Cost:         cost,
+		Priced:       priced,
+	})
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RedeliverWebhookCommand struct {
+	ProjectID  uuid.UUID
+	WebhookID  uuid.UUID
+	DeliveryID uuid.UUID
+}
+
+type RedeliverWebhookHandler struct {
+	webhookRepo repository.WebhookRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRedeliverWebhookHandler(
+	webhookRepo repository.WebhookRepository,
+	auditLogger repository.AuditLogger,
+) RedeliverWebhookHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RedeliverWebhookHandler{
+		webhookRepo: webhookRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle queues a new delivery of the payload, the original delivery log is kept.
+func (h RedeliverWebhookHandler) Handle(ctx context.Context, cmd RedeliverWebhookCommand) (uuid.UUID, error) {
+	webhook, err := retrieveProjectWebhook(ctx, h.webhookRepo, cmd.ProjectID, cmd.WebhookID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	delivery, err := h.webhookRepo.RetrieveDelivery(ctx, cmd.DeliveryID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return uuid.Nil, errs.NotFoundError{Resource: "delivery", ID: cmd.DeliveryID, Err: err}
+		}
+		return uuid.Nil, errs.InternalError{Err: err}
+	}
+
+	if delivery.WebhookID != webhook.ID {
+		return uuid.Nil, errs.NotFoundError{Resource: "delivery", ID: cmd.DeliveryID}
+	}
+
+	redelivery := delivery.Redeliver()
+	err = h.webhookRepo.AddDeliveries(ctx, []*model.WebhookDelivery{redelivery})
+	if err != nil {
+		return uuid.Nil, errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionWebhookRedelivered,
+		Resource:   "webhook",
+		ResourceID: webhook.ID.String(),
+		Details: map[string]any{
+			"deliveryId":   delivery.ID,
+			"redeliveryId": redelivery.ID,
+		},
+	})
+
+	return redelivery.ID, nil
+}
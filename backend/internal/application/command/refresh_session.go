@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RefreshSessionCommand struct {
+	RefreshToken auth.OneTimeToken
+	IPAddress    string
+}
+
+type RefreshSessionHandler struct {
+	userRepo repository.UserRepository
+	denylist repository.SessionDenylist
+	authKey  string
+}
+
+func NewRefreshSessionHandler(
+	userRepo repository.UserRepository,
+	denylist repository.SessionDenylist,
+	authKey string,
+) RefreshSessionHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if denylist == nil {
+		slog.Error("denylist is nil")
+		os.Exit(1)
+	}
+
+	return RefreshSessionHandler{
+		userRepo: userRepo,
+		denylist: denylist,
+		authKey:  authKey,
+	}
+}
+
+// Handle exchanges a refresh token for new tokens of its session. A refresh
+// token presented twice revokes the session, as one of the holders stole it.
+func (h RefreshSessionHandler) Handle(ctx context.Context, cmd RefreshSessionCommand) (IssuedSession, error) {
+	invalid := errs.UnauthorizedError{Err: errors.New("invalid refresh token")}
+
+	session, err := h.userRepo.RetrieveSessionByToken(ctx, cmd.RefreshToken.Digest())
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return IssuedSession{}, invalid
+		}
+		return IssuedSession{}, errs.InternalError{Err: err}
+	}
+
+	user, err := retrieveUser(ctx, h.userRepo, session.UserID)
+	if err != nil {
+		return IssuedSession{}, err
+	}
+
+	if !user.IsActive() {
+		return IssuedSession{}, errs.UnauthorizedError{Err: errors.New("user is deactivated")}
+	}
+
+	now := time.Now()
+	wasActive := session.IsActive(now)
+	refreshToken, err := session.Refresh(cmd.RefreshToken.Digest(), cmd.IPAddress, now)
+	if err != nil {
+		if wasActive && session.RevokedAt != nil {
+			if revokeErr := h.revoke(ctx, session); revokeErr != nil {
+				return IssuedSession{}, revokeErr
+			}
+		}
+		return IssuedSession{}, err
+	}
+
+	err = h.userRepo.RotateSession(ctx, session)
+	if err != nil {
+		// the token was exchanged concurrently
+		if errors.Is(err, repo.ErrConflict) {
+			return IssuedSession{}, invalid
+		}
+		return IssuedSession{}, errs.UpdateError{Entity: "session", Err: err}
+	}
+
+	return issueSession(user, session, refreshToken, h.authKey, now)
+}
+
+func (h RefreshSessionHandler) revoke(ctx context.Context, session *model.Session) error {
+	if err := h.denylist.Deny(ctx, session.ID); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	if err := h.userRepo.RevokeSession(ctx, session); err != nil {
+		return errs.UpdateError{Entity: "session", Err: err}
+	}
+	return nil
+}
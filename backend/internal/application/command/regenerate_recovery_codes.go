@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RegenerateRecoveryCodesCommand struct {
+	UserID uuid.UUID
+	Code   string
+}
+
+type RegenerateRecoveryCodesHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRegenerateRecoveryCodesHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) RegenerateRecoveryCodesHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RegenerateRecoveryCodesHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle replaces the recovery codes of the user given a code, the new ones are returned once.
+func (h RegenerateRecoveryCodesHandler) Handle(
+	ctx context.Context,
+	cmd RegenerateRecoveryCodesCommand,
+) ([]auth.OneTimeToken, error) {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := user.RegenerateRecoveryCodes(cmd.Code, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return nil, errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionRecoveryCodesGenerated,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+	})
+
+	return codes, nil
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveBudgetCommand struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+}
+
+type RemoveBudgetHandler struct {
+	budgetRepo  repository.BudgetRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveBudgetHandler(
+	budgetRepo repository.BudgetRepository,
+	auditLogger repository.AuditLogger,
+) RemoveBudgetHandler {
+	if budgetRepo == nil {
+		slog.Error("budgetRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveBudgetHandler{
+		budgetRepo:  budgetRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h RemoveBudgetHandler) Handle(ctx context.Context, cmd RemoveBudgetCommand) error {
+	budget, err := retrieveProjectBudget(ctx, h.budgetRepo, cmd.ProjectID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	err = h.budgetRepo.DeleteBudget(ctx, budget.ID)
+	if err != nil {
+		return errs.DeleteError{Entity: "budget", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &budget.ProjectID,
+		Action:     model.AuditActionBudgetRemoved,
+		Resource:   "budget",
+		ResourceID: budget.ID.String(),
+		Before:     model.AuditSnapshot(budget),
+	})
+
+	return nil
+}
@@ -2,34 +2,88 @@ package command
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 
 	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
 )
 
 type RemoveCredentialCommand struct {
+	ProjectID       uuid.UUID
 	LLMCredentialID uuid.UUID
+	// Force removes the credential even when workflows use it, their
+	// triggers then fail until the credential is replaced.
+	Force bool
 }
 
 type RemoveCredentialHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewRemoveCredentialHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) RemoveCredentialHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return RemoveCredentialHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
 func (h RemoveCredentialHandler) Handle(ctx context.Context, cmd RemoveCredentialCommand) error {
-	return h.projectRepo.DeleteCredential(ctx, cmd.LLMCredentialID)
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	// credentials are only removed from the project they belong to
+	credential, ok := project.Credentials[cmd.LLMCredentialID]
+	if !ok {
+		return errs.NotFoundError{Resource: "credential", ID: cmd.LLMCredentialID}
+	}
+
+	references := project.CredentialReferences(cmd.LLMCredentialID)
+	if len(references) > 0 && !cmd.Force {
+		return errs.ConstraintError{
+			Condition: fmt.Sprintf("%d workflow nodes using the credential", len(references)),
+		}
+	}
+
+	if err = h.projectRepo.DeleteCredential(ctx, cmd.LLMCredentialID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionCredentialRemoved,
+		Resource:   "credential",
+		ResourceID: cmd.LLMCredentialID.String(),
+		Before:     model.AuditSnapshot(credential),
+		Details: map[string]any{
+			"force":      cmd.Force,
+			"references": len(references),
+		},
+	})
+
+	return nil
 }
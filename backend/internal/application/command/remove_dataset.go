@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveDatasetCommand struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+}
+
+type RemoveDatasetHandler struct {
+	evalRepo    repository.EvalRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveDatasetHandler(
+	evalRepo repository.EvalRepository,
+	auditLogger repository.AuditLogger,
+) RemoveDatasetHandler {
+	if evalRepo == nil {
+		slog.Error("evalRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveDatasetHandler{
+		evalRepo:    evalRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle deletes the dataset, the evaluation runs made with it are kept.
+func (h RemoveDatasetHandler) Handle(ctx context.Context, cmd RemoveDatasetCommand) error {
+	dataset, err := retrieveProjectDataset(ctx, h.evalRepo, cmd.ProjectID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	err = h.evalRepo.DeleteDataset(ctx, dataset.ID)
+	if err != nil {
+		return errs.DeleteError{Entity: "dataset", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &dataset.ProjectID,
+		Action:     model.AuditActionDatasetRemoved,
+		Resource:   "dataset",
+		ResourceID: dataset.ID.String(),
+		Before:     model.AuditSnapshot(dataset),
+	})
+
+	return nil
+}
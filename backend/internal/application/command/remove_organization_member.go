@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveOrganizationMemberCommand struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type RemoveOrganizationMemberHandler struct {
+	accessRepo  repository.AccessRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveOrganizationMemberHandler(
+	accessRepo repository.AccessRepository,
+	auditLogger repository.AuditLogger,
+) RemoveOrganizationMemberHandler {
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveOrganizationMemberHandler{
+		accessRepo:  accessRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h RemoveOrganizationMemberHandler) Handle(ctx context.Context, cmd RemoveOrganizationMemberCommand) error {
+	organization, err := retrieveOrganization(ctx, h.accessRepo, cmd.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	role, _ := organization.RoleOf(cmd.UserID)
+	if err = organization.RemoveMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if err = h.accessRepo.DeleteOrganizationMember(ctx, cmd.OrganizationID, cmd.UserID); err != nil {
+		return errs.DeleteError{Entity: "member", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionOrganizationMemberRemoved,
+		Resource:   "organization_member",
+		ResourceID: cmd.UserID.String(),
+		Before:     map[string]any{"Role": role},
+		Details:    map[string]any{"organizationId": cmd.OrganizationID},
+	})
+
+	return nil
+}
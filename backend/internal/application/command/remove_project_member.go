@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveProjectMemberCommand struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type RemoveProjectMemberHandler struct {
+	accessRepo  repository.AccessRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveProjectMemberHandler(
+	accessRepo repository.AccessRepository,
+	auditLogger repository.AuditLogger,
+) RemoveProjectMemberHandler {
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveProjectMemberHandler{
+		accessRepo:  accessRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h RemoveProjectMemberHandler) Handle(ctx context.Context, cmd RemoveProjectMemberCommand) error {
+	err := h.accessRepo.DeleteProjectMember(ctx, cmd.ProjectID, cmd.UserID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "member", ID: cmd.UserID, Err: err}
+		}
+		return errs.DeleteError{Entity: "member", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionProjectMemberRemoved,
+		Resource:   "project_member",
+		ResourceID: cmd.UserID.String(),
+	})
+
+	return nil
+}
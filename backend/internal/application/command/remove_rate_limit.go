@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveRateLimitCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type RemoveRateLimitHandler struct {
+	rateLimitRepo repository.RateLimitRepository
+	auditLogger   repository.AuditLogger
+}
+
+func NewRemoveRateLimitHandler(
+	rateLimitRepo repository.RateLimitRepository,
+	auditLogger repository.AuditLogger,
+) RemoveRateLimitHandler {
+	if rateLimitRepo == nil {
+		slog.Error("rateLimitRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveRateLimitHandler{
+		rateLimitRepo: rateLimitRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Handle removes the rate limit of the project, or the override of one of
+// its workflows when WorkflowID is set.
+func (h RemoveRateLimitHandler) Handle(ctx context.Context, cmd RemoveRateLimitCommand) error {
+	err := h.rateLimitRepo.DeleteRateLimit(ctx, cmd.ProjectID, cmd.WorkflowID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "rate limit", ID: cmd.ProjectID, Err: err}
+		}
+		return errs.DeleteError{Entity: "rate limit", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionRateLimitRemoved,
+		Resource:   "rate_limit",
+		ResourceID: cmd.WorkflowID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveScheduleCommand struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type RemoveScheduleHandler struct {
+	scheduleRepo repository.ScheduleRepository
+	auditLogger  repository.AuditLogger
+}
+
+func NewRemoveScheduleHandler(
+	scheduleRepo repository.ScheduleRepository,
+	auditLogger repository.AuditLogger,
+) RemoveScheduleHandler {
+	if scheduleRepo == nil {
+		slog.Error("scheduleRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveScheduleHandler{
+		scheduleRepo: scheduleRepo,
+		auditLogger:  auditLogger,
+	}
+}
+
+func (h RemoveScheduleHandler) Handle(ctx context.Context, cmd RemoveScheduleCommand) error {
+	schedule, err := retrieveWorkflowSchedule(ctx, h.scheduleRepo, cmd.ProjectID, cmd.WorkflowID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	err = h.scheduleRepo.DeleteSchedule(ctx, schedule.ID)
+	if err != nil {
+		return errs.DeleteError{Entity: "schedule", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &schedule.ProjectID,
+		Action:     model.AuditActionScheduleRemoved,
+		Resource:   "schedule",
+		ResourceID: schedule.ID.String(),
+		Before:     model.AuditSnapshot(schedule),
+	})
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveTrafficSplitCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type RemoveTrafficSplitHandler struct {
+	rolloutRepo repository.RolloutRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveTrafficSplitHandler(
+	rolloutRepo repository.RolloutRepository,
+	auditLogger repository.AuditLogger,
+) RemoveTrafficSplitHandler {
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveTrafficSplitHandler{
+		rolloutRepo: rolloutRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h RemoveTrafficSplitHandler) Handle(ctx context.Context, cmd RemoveTrafficSplitCommand) error {
+	split, err := h.rolloutRepo.RetrieveTrafficSplit(ctx, cmd.ProjectID, cmd.WorkflowID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "traffic split", ID: cmd.WorkflowID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if err = h.rolloutRepo.DeleteTrafficSplit(ctx, split.WorkflowID); err != nil {
+		return errs.DeleteError{Entity: "traffic split", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &split.ProjectID,
+		Action:     model.AuditActionTrafficSplitRemoved,
+		Resource:   "traffic_split",
+		ResourceID: split.WorkflowID.String(),
+		Before:     model.AuditSnapshot(split),
+	})
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveWebhookCommand struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+}
+
+type RemoveWebhookHandler struct {
+	webhookRepo repository.WebhookRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveWebhookHandler(
+	webhookRepo repository.WebhookRepository,
+	auditLogger repository.AuditLogger,
+) RemoveWebhookHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveWebhookHandler{
+		webhookRepo: webhookRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h RemoveWebhookHandler) Handle(ctx context.Context, cmd RemoveWebhookCommand) error {
+	webhook, err := retrieveProjectWebhook(ctx, h.webhookRepo, cmd.ProjectID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	err = h.webhookRepo.DeleteWebhook(ctx, webhook.ID)
+	if err != nil {
+		return errs.DeleteError{Entity: "webhook", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &webhook.ProjectID,
+		Action:     model.AuditActionWebhookRemoved,
+		Resource:   "webhook",
+		ResourceID: webhook.ID.String(),
+		Before:     model.AuditSnapshot(webhook),
+	})
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RemoveWebhookTriggerCommand struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type RemoveWebhookTriggerHandler struct {
+	webhookRepo repository.WebhookRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRemoveWebhookTriggerHandler(
+	webhookRepo repository.WebhookRepository,
+	auditLogger repository.AuditLogger,
+) RemoveWebhookTriggerHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RemoveWebhookTriggerHandler{
+		webhookRepo: webhookRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h RemoveWebhookTriggerHandler) Handle(ctx context.Context, cmd RemoveWebhookTriggerCommand) error {
+	trigger, err := retrieveWorkflowWebhookTrigger(ctx, h.webhookRepo, cmd.ProjectID, cmd.WorkflowID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	err = h.webhookRepo.DeleteWebhookTrigger(ctx, trigger.ID)
+	if err != nil {
+		return errs.DeleteError{Entity: "webhook trigger", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &trigger.ProjectID,
+		Action:     model.AuditActionWebhookTriggerRemoved,
+		Resource:   "webhook_trigger",
+		ResourceID: trigger.ID.String(),
+		Before:     model.AuditSnapshot(trigger),
+	})
+
+	return nil
+}
@@ -2,12 +2,15 @@ package command
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 
 	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
 )
 
 type RemoveWorkflowCommand struct {
@@ -17,21 +20,55 @@ type RemoveWorkflowCommand struct {
 
 type RemoveWorkflowHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewRemoveWorkflowHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) RemoveWorkflowHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return RemoveWorkflowHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
 func (h RemoveWorkflowHandler) Handle(ctx context.Context, cmd RemoveWorkflowCommand) error {
-	return h.projectRepo.DeleteWorkflow(ctx, cmd.WorkflowID)
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	// workflows are only removed from the project they belong to
+	workflow, err := project.GetWorkflow(cmd.WorkflowID)
+	if err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID}
+	}
+
+	if err = h.projectRepo.DeleteWorkflow(ctx, cmd.WorkflowID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionWorkflowRemoved,
+		Resource:   "workflow",
+		ResourceID: cmd.WorkflowID.String(),
+		Before:     model.AuditSnapshot(workflow),
+	})
+
+	return nil
 }
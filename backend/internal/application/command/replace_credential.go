@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ReplaceCredentialCommand struct {
+	ProjectID     uuid.UUID
+	CredentialID  uuid.UUID
+	ReplacementID uuid.UUID
+	// WorkflowIDs restricts the replacement to these workflows, all the
+	// workflows of the project when empty.
+	WorkflowIDs []model.WorkflowID
+}
+
+type ReplaceCredentialHandler struct {
+	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewReplaceCredentialHandler(
+	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
+) ReplaceCredentialHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return ReplaceCredentialHandler{
+		projectRepo: projectRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle points the workflow nodes using a credential to another credential
+// of the same provider, and returns the nodes replaced.
+func (h ReplaceCredentialHandler) Handle(
+	ctx context.Context,
+	cmd ReplaceCredentialCommand,
+) ([]model.CredentialReference, error) {
+	var replaced []model.CredentialReference
+	err := retryOnConflict(ctx, defaultRetryConfig, repo.ErrConflict, func() error {
+		project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+		if err != nil {
+			if errors.Is(err, repo.ErrNotFound) {
+				return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+			}
+			return errs.InternalError{Err: err}
+		}
+
+		replaced, err = project.ReplaceCredential(cmd.CredentialID, cmd.ReplacementID, cmd.WorkflowIDs)
+		if err != nil {
+			return err
+		}
+
+		if len(replaced) == 0 {
+			return nil
+		}
+		return h.projectRepo.Update(ctx, project)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workflowIDs := []string{}
+	for _, reference := range replaced {
+		if id := reference.WorkflowID.String(); len(workflowIDs) == 0 || workflowIDs[len(workflowIDs)-1] != id {
+			workflowIDs = append(workflowIDs, id)
+		}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionCredentialReplaced,
+		Resource:   "credential",
+		ResourceID: cmd.CredentialID.String(),
+		Details: map[string]any{
+			"replacementId": cmd.ReplacementID.String(),
+			"workflows":     workflowIDs,
+			"nodes":         len(replaced),
+		},
+	})
+
+	return replaced, nil
+}
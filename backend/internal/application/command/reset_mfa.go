@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ResetMFACommand struct {
+	UserID uuid.UUID
+}
+
+type ResetMFAHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewResetMFAHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) ResetMFAHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return ResetMFAHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle turns the two-factor authentication of a user off, such as after
+// they lost their app and their recovery codes.
+func (h ResetMFAHandler) Handle(ctx context.Context, cmd ResetMFACommand) error {
+	user, err := retrieveUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	user.ResetMFA()
+	if err = h.userRepo.UpdateUser(ctx, user); err != nil {
+		return errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionMFAReset,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+	})
+
+	return nil
+}
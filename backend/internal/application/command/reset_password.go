@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ResetPasswordCommand struct {
+	Token    auth.OneTimeToken
+	Password string
+}
+
+type ResetPasswordHandler struct {
+	userRepo    repository.UserRepository
+	denylist    repository.SessionDenylist
+	auditLogger repository.AuditLogger
+}
+
+func NewResetPasswordHandler(
+	userRepo repository.UserRepository,
+	denylist repository.SessionDenylist,
+	auditLogger repository.AuditLogger,
+) ResetPasswordHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if denylist == nil {
+		slog.Error("denylist is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return ResetPasswordHandler{
+		userRepo:    userRepo,
+		denylist:    denylist,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle sets the password of the user of a reset token, which is then used up.
+// The sessions opened with the former password are logged out.
+func (h ResetPasswordHandler) Handle(ctx context.Context, cmd ResetPasswordCommand) error {
+	reset, err := h.userRepo.RetrievePasswordReset(ctx, cmd.Token.Digest())
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.InvalidError{Field: "token", Reason: "invalid password reset token", Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	user, err := retrieveUser(ctx, h.userRepo, reset.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err = reset.Use(user, cmd.Password, time.Now()); err != nil {
+		return err
+	}
+
+	err = h.userRepo.UsePasswordReset(ctx, reset, user)
+	if err != nil {
+		if errors.Is(err, repo.ErrConflict) {
+			return errs.InvalidError{Field: "token", Reason: "password reset token is expired", Err: err}
+		}
+		return errs.UpdateError{Entity: "user", Err: err}
+	}
+
+	if err = revokeUserSessions(ctx, h.userRepo, h.denylist, user.ID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionPasswordReset,
+		Resource:   "user",
+		ResourceID: user.ID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RevokeInvitationCommand struct {
+	ID uuid.UUID
+}
+
+type RevokeInvitationHandler struct {
+	userRepo    repository.UserRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewRevokeInvitationHandler(
+	userRepo repository.UserRepository,
+	auditLogger repository.AuditLogger,
+) RevokeInvitationHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RevokeInvitationHandler{
+		userRepo:    userRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle deletes a pending invitation, its link can no longer be used.
+func (h RevokeInvitationHandler) Handle(ctx context.Context, cmd RevokeInvitationCommand) error {
+	err := h.userRepo.DeleteInvitation(ctx, cmd.ID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "invitation", ID: cmd.ID, Err: err}
+		}
+		return errs.DeleteError{Entity: "invitation", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionInvitationRevoked,
+		Resource:   "invitation",
+		ResourceID: cmd.ID.String(),
+	})
+
+	return nil
+}
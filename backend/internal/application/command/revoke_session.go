@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type RevokeSessionCommand struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+}
+
+type RevokeSessionHandler struct {
+	userRepo    repository.UserRepository
+	denylist    repository.SessionDenylist
+	auditLogger repository.AuditLogger
+}
+
+func NewRevokeSessionHandler(
+	userRepo repository.UserRepository,
+	denylist repository.SessionDenylist,
+	auditLogger repository.AuditLogger,
+) RevokeSessionHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if denylist == nil {
+		slog.Error("denylist is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RevokeSessionHandler{
+		userRepo:    userRepo,
+		denylist:    denylist,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle logs a session of the user out, its tokens are rejected from now on.
+func (h RevokeSessionHandler) Handle(ctx context.Context, cmd RevokeSessionCommand) error {
+	session, err := h.userRepo.RetrieveSession(ctx, cmd.SessionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "session", ID: cmd.SessionID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if session.UserID != cmd.UserID {
+		return errs.NotFoundError{Resource: "session", ID: cmd.SessionID}
+	}
+
+	if !session.IsActive(time.Now()) {
+		return nil
+	}
+
+	if err = h.denylist.Deny(ctx, session.ID); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	session.Revoke(time.Now())
+	if err = h.userRepo.RevokeSession(ctx, session); err != nil {
+		return errs.UpdateError{Entity: "session", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionSessionRevoked,
+		Resource:   "session",
+		ResourceID: session.ID.String(),
+		Details:    map[string]any{"userId": session.UserID},
+	})
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+)
+
+type RevokeUserSessionsCommand struct {
+	UserID uuid.UUID
+}
+
+type RevokeUserSessionsHandler struct {
+	userRepo    repository.UserRepository
+	denylist    repository.SessionDenylist
+	auditLogger repository.AuditLogger
+}
+
+func NewRevokeUserSessionsHandler(
+	userRepo repository.UserRepository,
+	denylist repository.SessionDenylist,
+	auditLogger repository.AuditLogger,
+) RevokeUserSessionsHandler {
+	if userRepo == nil {
+		slog.Error("userRepo is nil")
+		os.Exit(1)
+	}
+
+	if denylist == nil {
+		slog.Error("denylist is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return RevokeUserSessionsHandler{
+		userRepo:    userRepo,
+		denylist:    denylist,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle logs every session of the user out.
+func (h RevokeUserSessionsHandler) Handle(ctx context.Context, cmd RevokeUserSessionsCommand) error {
+	if _, err := retrieveUser(ctx, h.userRepo, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := revokeUserSessions(ctx, h.userRepo, h.denylist, cmd.UserID); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionUserSessionsRevoked,
+		Resource:   "user",
+		ResourceID: cmd.UserID.String(),
+	})
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+)
+
+type SaveOrganizationMemberCommand struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           model.Role
+}
+
+type SaveOrganizationMemberHandler struct {
+	accessRepo  repository.AccessRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewSaveOrganizationMemberHandler(
+	accessRepo repository.AccessRepository,
+	auditLogger repository.AuditLogger,
+) SaveOrganizationMemberHandler {
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return SaveOrganizationMemberHandler{
+		accessRepo:  accessRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle adds a member to the organization or changes their role.
+func (h SaveOrganizationMemberHandler) Handle(ctx context.Context, cmd SaveOrganizationMemberCommand) error {
+	organization, err := retrieveOrganization(ctx, h.accessRepo, cmd.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	var before map[string]any
+	if role, ok := organization.RoleOf(cmd.UserID); ok {
+		before = map[string]any{"Role": role}
+	}
+
+	if err = organization.SetMember(cmd.UserID, cmd.Role); err != nil {
+		return err
+	}
+
+	err = h.accessRepo.SaveOrganizationMember(ctx, cmd.OrganizationID, cmd.UserID, cmd.Role)
+	if err != nil {
+		return memberSaveError(err, cmd.UserID)
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		Action:     model.AuditActionOrganizationMemberSaved,
+		Resource:   "organization_member",
+		ResourceID: cmd.UserID.String(),
+		Before:     before,
+		After:      map[string]any{"Role": cmd.Role},
+		Details:    map[string]any{"organizationId": cmd.OrganizationID},
+	})
+
+	return nil
+}
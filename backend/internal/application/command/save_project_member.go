@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+)
+
+type SaveProjectMemberCommand struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+	Role      model.Role
+}
+
+type SaveProjectMemberHandler struct {
+	accessRepo  repository.AccessRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewSaveProjectMemberHandler(
+	accessRepo repository.AccessRepository,
+	auditLogger repository.AuditLogger,
+) SaveProjectMemberHandler {
+	if accessRepo == nil {
+		slog.Error("accessRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return SaveProjectMemberHandler{
+		accessRepo:  accessRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle shares the project with a user or changes their role on it.
+// The role adds to the one the user may hold through the organization of the project.
+func (h SaveProjectMemberHandler) Handle(ctx context.Context, cmd SaveProjectMemberCommand) error {
+	if err := cmd.Role.Validate(); err != nil {
+		return err
+	}
+
+	err := h.accessRepo.SaveProjectMember(ctx, cmd.ProjectID, cmd.UserID, cmd.Role)
+	if err != nil {
+		return memberSaveError(err, cmd.UserID)
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionProjectMemberSaved,
+		Resource:   "project_member",
+		ResourceID: cmd.UserID.String(),
+		After:      map[string]any{"Role": cmd.Role},
+	})
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type SaveRateLimitCommand struct {
+	ProjectID                uuid.UUID
+	WorkflowID               model.WorkflowID
+	RequestsPerMinute        int
+	APIKeyRequestsPerMinute  int
+	EndUserRequestsPerMinute int
+	Burst                    int
+}
+
+type SaveRateLimitHandler struct {
+	projectRepo   repository.ProjectRepository
+	rateLimitRepo repository.RateLimitRepository
+	auditLogger   repository.AuditLogger
+}
+
+func NewSaveRateLimitHandler(
+	projectRepo repository.ProjectRepository,
+	rateLimitRepo repository.RateLimitRepository,
+	auditLogger repository.AuditLogger,
+) SaveRateLimitHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if rateLimitRepo == nil {
+		slog.Error("rateLimitRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return SaveRateLimitHandler{
+		projectRepo:   projectRepo,
+		rateLimitRepo: rateLimitRepo,
+		auditLogger:   auditLogger,
+	}
+}
+
+// Handle creates or replaces the rate limit of the project,
+// of one of its workflows when WorkflowID is set.
+func (h SaveRateLimitHandler) Handle(ctx context.Context, cmd SaveRateLimitCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if cmd.WorkflowID != "" {
+		if _, err = project.GetWorkflow(cmd.WorkflowID); err != nil {
+			return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID, Err: err}
+		}
+	}
+
+	limit, err := model.NewRateLimit(
+		cmd.ProjectID,
+		cmd.WorkflowID,
+		cmd.RequestsPerMinute,
+		cmd.APIKeyRequestsPerMinute,
+		cmd.EndUserRequestsPerMinute,
+		cmd.Burst,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = h.rateLimitRepo.SaveRateLimit(ctx, limit); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &limit.ProjectID,
+		Action:     model.AuditActionRateLimitSaved,
+		Resource:   "rate_limit",
+		ResourceID: limit.WorkflowID.String(),
+		After:      model.AuditSnapshot(limit),
+	})
+
+	return nil
+}
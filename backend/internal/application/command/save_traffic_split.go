@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type SaveTrafficSplitCommand struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	Variants   []model.TrafficSplitVariant
+	Enabled    bool
+}
+
+type SaveTrafficSplitHandler struct {
+	projectRepo repository.ProjectRepository
+	rolloutRepo repository.RolloutRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewSaveTrafficSplitHandler(
+	projectRepo repository.ProjectRepository,
+	rolloutRepo repository.RolloutRepository,
+	auditLogger repository.AuditLogger,
+) SaveTrafficSplitHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return SaveTrafficSplitHandler{
+		projectRepo: projectRepo,
+		rolloutRepo: rolloutRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle creates or replaces the traffic split of the workflow,
+// every variant must be a workflow of the project.
+func (h SaveTrafficSplitHandler) Handle(ctx context.Context, cmd SaveTrafficSplitCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if _, err = project.GetWorkflow(cmd.WorkflowID); err != nil {
+		return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID, Err: err}
+	}
+
+	for _, variant := range cmd.Variants {
+		if _, err = project.GetWorkflow(variant.WorkflowID); err != nil {
+			return errs.InvalidError{
+				Field:  "variants",
+				Reason: "workflow " + variant.WorkflowID.String() + " not found in project",
+				Err:    err,
+			}
+		}
+	}
+
+	split, err := model.NewTrafficSplit(cmd.ProjectID, cmd.WorkflowID, cmd.Variants, cmd.Enabled)
+	if err != nil {
+		return err
+	}
+
+	existing, err := h.rolloutRepo.RetrieveTrafficSplit(ctx, cmd.ProjectID, cmd.WorkflowID)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return errs.InternalError{Err: err}
+	}
+
+	if err = h.rolloutRepo.SaveTrafficSplit(ctx, split); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &split.ProjectID,
+		Action:     model.AuditActionTrafficSplitSaved,
+		Resource:   "traffic_split",
+		ResourceID: split.WorkflowID.String(),
+		Before:     model.AuditSnapshot(existing),
+		After:      model.AuditSnapshot(split),
+	})
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// retrieveWorkflowSchedule returns the schedule only when it belongs to the workflow.
+func retrieveWorkflowSchedule(
+	ctx context.Context,
+	scheduleRepo repository.ScheduleRepository,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	scheduleID uuid.UUID,
+) (*model.Schedule, error) {
+	schedule, err := scheduleRepo.RetrieveSchedule(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "schedule", ID: scheduleID, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if schedule.ProjectID != projectID || schedule.WorkflowID != workflowID {
+		return nil, errs.NotFoundError{Resource: "schedule", ID: scheduleID}
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// scoringLease is how long a claimed run is not claimed again by another replica.
+const scoringLease = 5 * time.Minute
+
+type ScoreEvalRunsCommand struct {
+	BatchSize int
+}
+
+type ScoreEvalRunsHandler struct {
+	evalRepo repository.EvalRepository
+}
+
+func NewScoreEvalRunsHandler(evalRepo repository.EvalRepository) ScoreEvalRunsHandler {
+	if evalRepo == nil {
+		slog.Error("evalRepo is nil")
+		os.Exit(1)
+	}
+
+	return ScoreEvalRunsHandler{
+		evalRepo: evalRepo,
+	}
+}
+
+// Handle scores the evaluation runs whose batch is completed. Runs are claimed
+// in the database, so every replica can run it. It returns the number of runs claimed.
+func (h ScoreEvalRunsHandler) Handle(ctx context.Context, cmd ScoreEvalRunsCommand) (int, error) {
+	runs, err := h.evalRepo.ClaimScorableEvalRuns(ctx, cmd.BatchSize, scoringLease)
+	if err != nil {
+		return 0, errs.InternalError{Err: err}
+	}
+
+	for _, run := range runs {
+		if err = h.score(ctx, run); err != nil {
+			// the run is claimed again once the lease expires
+			slog.Error("error scoring eval run", "eval_run_id", run.ID, "error", err)
+		}
+	}
+
+	return len(runs), nil
+}
+
+func (h ScoreEvalRunsHandler) score(ctx context.Context, run *model.EvalRun) error {
+	cases, err := h.evalRepo.ListEvalCases(ctx, run.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = run.Score(cases); err != nil {
+		return err
+	}
+
+	return h.evalRepo.CompleteEvalRun(ctx, run, cases)
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// IssuedSession holds the tokens of a session, the access token
+// authenticates the requests until it is exchanged with the refresh token.
+type IssuedSession struct {
+	UserID       uuid.UUID
+	SessionID    uuid.UUID
+	AccessToken  auth.Token
+	ExpiresAt    time.Time
+	RefreshToken auth.OneTimeToken
+}
+
+func issueSession(
+	user *model.User,
+	session *model.Session,
+	refreshToken auth.OneTimeToken,
+	authKey string,
+	now time.Time,
+) (IssuedSession, error) {
+	token, err := auth.GenerateToken(user.ID, session.ID, user.Email, user.Name, authKey)
+	if err != nil {
+		return IssuedSession{}, errs.InternalError{
+			Err: errors.New("authentication failed"),
+		}
+	}
+
+	return IssuedSession{
+		UserID:       user.ID,
+		SessionID:    session.ID,
+		AccessToken:  token,
+		ExpiresAt:    now.Add(auth.AccessTokenTTL),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// revokeUserSessions logs the user out everywhere. The tokens are denied
+// first so that a failure leaves the sessions to be revoked again.
+func revokeUserSessions(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	denylist repository.SessionDenylist,
+	userID uuid.UUID,
+) error {
+	sessions, err := userRepo.ListActiveSessions(ctx, userID)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	ids := make([]uuid.UUID, len(sessions))
+	for i, session := range sessions {
+		ids[i] = session.ID
+	}
+
+	if err = denylist.Deny(ctx, ids...); err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	if err = userRepo.RevokeUserSessions(ctx, userID, time.Now()); err != nil {
+		return errs.UpdateError{Entity: "session", Err: err}
+	}
+
+	return nil
+}
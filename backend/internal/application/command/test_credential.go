@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type TestCredentialCommand struct {
+	ProjectID    uuid.UUID
+	CredentialID uuid.UUID
+}
+
+type TestCredentialHandler struct {
+	projectRepo repository.ProjectRepository
+	verifier    credentialVerifier
+	auditLogger repository.AuditLogger
+}
+
+func NewTestCredentialHandler(
+	projectRepo repository.ProjectRepository,
+	verifier credentialVerifier,
+	auditLogger repository.AuditLogger,
+) TestCredentialHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if verifier == nil {
+		slog.Error("verifier is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return TestCredentialHandler{
+		projectRepo: projectRepo,
+		verifier:    verifier,
+		auditLogger: auditLogger,
+	}
+}
+
+// Handle checks the credential against its provider and stores the outcome
+// on the credential. A rejected or unreachable provider is an outcome of
+// the test, not an error of the command.
+func (h TestCredentialHandler) Handle(ctx context.Context, cmd TestCredentialCommand) error {
+	project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	credential, ok := project.Credentials[cmd.CredentialID]
+	if !ok {
+		return errs.NotFoundError{Resource: "credential", ID: cmd.CredentialID}
+	}
+
+	// the checks authenticate with the API key of the credential
+	if credential.APIKey == "" || !h.verifier.Supports(credential.ProviderType) {
+		return errs.InvalidError{
+			Field:  "provider",
+			Reason: "credentials of " + credential.ProviderType.String() + " cannot be tested",
+		}
+	}
+
+	apiKey, err := credential.APIKey.Decrypt()
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	verifyErr := h.verifier.Verify(ctx, credential.ProviderType, apiKey)
+	credential.RecordVerification(verifyErr, time.Now())
+
+	if err = h.projectRepo.UpdateCredentialVerification(ctx, credential); err != nil {
+		return errs.UpdateError{Entity: "credential", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionCredentialTested,
+		Resource:   "credential",
+		ResourceID: credential.ID.String(),
+		Details: map[string]any{
+			"status": string(credential.Status),
+			"error":  credential.VerificationError,
+		},
+	})
+
+	return nil
+}
@@ -7,41 +7,90 @@ import (
 	"os"
 
 	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
 	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/ratelimit"
 )
 
 type TriggerWorkflowCommand struct {
 	WorkflowID model.WorkflowID
-	ProjectID  uuid.UUID
-	TriggerID  uuid.UUID
-	SessionID  uuid.UUID
-	Inputs     map[string]any
+	// Revision pins the trigger to a revision of the workflow, its traffic
+	// split is bypassed and the trigger fails once the workflow has changed.
+	Revision  string
+	ProjectID uuid.UUID
+	TriggerID uuid.UUID
+	SessionID uuid.UUID
+	// EndUserID is the authenticated end-user making the trigger, if any.
+	EndUserID string
+	// Caller is counted against the rate limit of the workflow.
+	Caller model.TriggerCaller
+	Inputs map[string]any
 }
 
 type TriggerWorkflowHandler struct {
 	projectRepo   repository.ProjectRepository
+	rolloutRepo   repository.RolloutRepository
+	budgetRepo    repository.BudgetRepository
+	rateLimitRepo repository.RateLimitRepository
+	rateLimiter   repository.RateLimiter
 	runnerService runnerService
+	events        triggerEvents
 }
 
 func NewTriggerWorkflowHandler(
 	projectRepo repository.ProjectRepository,
+	rolloutRepo repository.RolloutRepository,
+	budgetRepo repository.BudgetRepository,
+	rateLimitRepo repository.RateLimitRepository,
+	rateLimiter repository.RateLimiter,
 	runnerService runnerService,
+	events triggerEvents,
 ) TriggerWorkflowHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if rolloutRepo == nil {
+		slog.Error("rolloutRepo is nil")
+		os.Exit(1)
+	}
+
+	if budgetRepo == nil {
+		slog.Error("budgetRepo is nil")
+		os.Exit(1)
+	}
+
+	if rateLimitRepo == nil {
+		slog.Error("rateLimitRepo is nil")
+		os.Exit(1)
+	}
+
+	if rateLimiter == nil {
+		slog.Error("rateLimiter is nil")
+		os.Exit(1)
+	}
+
 	if runnerService == nil {
 		slog.Error("runnerService is nil")
 		os.Exit(1)
 	}
 
+	if events == nil {
+		slog.Error("events is nil")
+		os.Exit(1)
+	}
+
 	return TriggerWorkflowHandler{
 		projectRepo:   projectRepo,
+		rolloutRepo:   rolloutRepo,
+		budgetRepo:    budgetRepo,
+		rateLimitRepo: rateLimitRepo,
+		rateLimiter:   rateLimiter,
 		runnerService: runnerService,
+		events:        events,
 	}
 }
 
@@ -51,19 +100,69 @@ func (h TriggerWorkflowHandler) Handle(ctx context.Context, cmd TriggerWorkflowC
 		return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
 	}
 
-	workflow, err := project.ComputeWorkflow(cmd.WorkflowID)
+	if err = authorizeTriggerCaller(project, cmd); err != nil {
+		return err
+	}
+
+	if err = h.enforceRateLimit(ctx, cmd); err != nil {
+		return err
+	}
+
+	variantID, err := h.resolveVariant(ctx, project, cmd)
+	if err != nil {
+		return err
+	}
+
+	workflow, err := project.ComputeWorkflow(variantID)
 	if err != nil {
 		if errors.Is(err, model.ErrWorkflowNotFound) {
-			return errs.NotFoundError{Resource: "workflow", ID: cmd.WorkflowID}
+			return errs.NotFoundError{Resource: "workflow", ID: variantID}
 		}
-		if errors.Is(err, model.ErrCredentialNotFound) {
-			return errs.NotFoundError{Resource: "credential", ID: cmd.WorkflowID}
+		var missing model.CredentialNotFoundError
+		if errors.As(err, &missing) {
+			return errs.NotFoundError{Resource: "credential", ID: missing.ID, Err: err}
 		}
 		return errs.InvalidError{Reason: "unable to compute workflow", Err: err}
 	}
 
-	err = h.runnerService.QueueWorkflow(ctx, cmd.TriggerID, cmd.SessionID, workflow, cmd.Inputs)
+	if cmd.Revision != "" && workflow.Revision() != cmd.Revision {
+		return errs.InvalidError{
+			Field:  "revision",
+			Reason: "the workflow changed since revision " + cmd.Revision,
+		}
+	}
+
+	err = enforceBudgets(ctx, h.budgetRepo, project.ID, cmd.WorkflowID, workflow)
+	if err != nil {
+		return err
+	}
+
+	execution := model.NewExecution(cmd.TriggerID, cmd.SessionID, cmd.EndUserID, cmd.WorkflowID, workflow)
+	if err = h.rolloutRepo.CreateExecution(ctx, execution); err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "trigger", ID: cmd.TriggerID, Err: err}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	if cmd.EndUserID != "" {
+		err = h.events.BindEndUser(ctx, cmd.WorkflowID, cmd.TriggerID, cmd.EndUserID)
+		if err != nil {
+			execution.Fail(err)
+			_ = h.rolloutRepo.FinishExecution(ctx, execution)
+			return errs.InternalError{Err: err}
+		}
+	}
+
+	// the variant runs under the triggered workflow, so its events
+	// are listened to, stored and delivered as the workflow's own.
+	run := *workflow
+	run.ID = cmd.WorkflowID
+
+	err = h.runnerService.QueueWorkflow(ctx, cmd.TriggerID, cmd.SessionID, &run, cmd.Inputs)
 	if err != nil {
+		execution.Fail(err)
+		_ = h.rolloutRepo.FinishExecution(ctx, execution)
 		return errs.InternalError{Err: err}
 	}
 
@@ -74,3 +173,82 @@ func (h TriggerWorkflowHandler) Handle(ctx context.Context, cmd TriggerWorkflowC
 	}()
 	return nil
 }
+
+// authorizeTriggerCaller rejects the anonymous clients of a project with an
+// auth provider, its clients trigger with an end-user token or its secret key.
+func authorizeTriggerCaller(project *model.Project, cmd TriggerWorkflowCommand) error {
+	if !cmd.Caller.Client || project.AuthProvider == nil {
+		return nil
+	}
+
+	if cmd.EndUserID == "" && cmd.Caller.APIKey == "" {
+		return errs.UnauthorizedError{Err: errors.New("an end-user token is required to trigger this workflow")}
+	}
+
+	return nil
+}
+
+// enforceRateLimit rejects the trigger once a bucket of the rate limit of the
+// workflow is empty, the quota of its most restrictive bucket is reported to
+// the caller. Triggers are let through when the limiter is unavailable.
+func (h TriggerWorkflowHandler) enforceRateLimit(ctx context.Context, cmd TriggerWorkflowCommand) error {
+	limit, err := h.rateLimitRepo.RetrieveRateLimit(ctx, cmd.ProjectID, cmd.WorkflowID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	buckets := limit.Buckets(cmd.WorkflowID, cmd.Caller)
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	decision, err := h.rateLimiter.Take(ctx, buckets)
+	if err != nil {
+		slog.Error("rate limiter unavailable", "error", err)
+		return nil
+	}
+
+	ratelimit.Record(ctx, ratelimit.Quota{
+		Limit:     decision.Bucket.Burst,
+		Remaining: decision.Remaining,
+		Reset:     decision.Reset,
+	})
+
+	if !decision.Allowed {
+		return errs.RateLimitedError{Scope: decision.Bucket.Scope, RetryAfter: decision.RetryAfter}
+	}
+	return nil
+}
+
+// resolveVariant returns the workflow running the trigger, the triggered
+// workflow itself unless it has an enabled traffic split and the trigger is
+// not pinned to a revision. Variants deleted from the project fall back to
+// the triggered workflow.
+func (h TriggerWorkflowHandler) resolveVariant(
+	ctx context.Context,
+	project *model.Project,
+	cmd TriggerWorkflowCommand,
+) (model.WorkflowID, error) {
+	if cmd.Revision != "" {
+		return cmd.WorkflowID, nil
+	}
+
+	split, err := h.rolloutRepo.RetrieveTrafficSplit(ctx, cmd.ProjectID, cmd.WorkflowID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return cmd.WorkflowID, nil
+		}
+		return "", errs.InternalError{Err: err}
+	}
+
+	variantID := split.Resolve(cmd.SessionID, cmd.TriggerID)
+	if _, err = project.GetWorkflow(variantID); err != nil {
+		slog.Warn("traffic split variant not found", "workflow_id", cmd.WorkflowID, "variant_id", variantID)
+		return cmd.WorkflowID, nil
+	}
+
+	return variantID, nil
+}
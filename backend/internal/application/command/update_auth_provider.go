@@ -19,18 +19,26 @@ type UpdateAuthProviderCommand struct {
 
 type UpdateAuthProviderHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewUpdateAuthProviderHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) UpdateAuthProviderHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return UpdateAuthProviderHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -40,10 +48,24 @@ func (h UpdateAuthProviderHandler) Handle(ctx context.Context, cmd UpdateAuthPro
 		return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
 	}
 
+	before := model.AuditSnapshot(project.AuthProvider)
 	err = project.NewAuthProvider(cmd.ProviderType, cmd.Config)
 	if err != nil {
 		return errs.InvalidError{Reason: err.Error()}
 	}
 
-	return h.projectRepo.Update(ctx, project)
+	if err = h.projectRepo.Update(ctx, project); err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionProjectAuthProviderUpdated,
+		Resource:   "auth_provider",
+		ResourceID: cmd.ProviderType.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(project.AuthProvider),
+	})
+
+	return nil
 }
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type UpdateBudgetCommand struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	Period     model.BudgetPeriod
+	LimitUSD   float64
+	Thresholds []int
+	Hard       bool
+}
+
+type UpdateBudgetHandler struct {
+	budgetRepo  repository.BudgetRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewUpdateBudgetHandler(
+	budgetRepo repository.BudgetRepository,
+	auditLogger repository.AuditLogger,
+) UpdateBudgetHandler {
+	if budgetRepo == nil {
+		slog.Error("budgetRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return UpdateBudgetHandler{
+		budgetRepo:  budgetRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h UpdateBudgetHandler) Handle(ctx context.Context, cmd UpdateBudgetCommand) error {
+	budget, err := retrieveProjectBudget(ctx, h.budgetRepo, cmd.ProjectID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	before := model.AuditSnapshot(budget)
+	err = budget.Update(cmd.Period, cmd.LimitUSD, cmd.Thresholds, cmd.Hard)
+	if err != nil {
+		return err
+	}
+
+	err = h.budgetRepo.UpdateBudget(ctx, budget)
+	if err != nil {
+		return errs.UpdateError{Entity: "budget", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &budget.ProjectID,
+		Action:     model.AuditActionBudgetUpdated,
+		Resource:   "budget",
+		ResourceID: budget.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(budget),
+	})
+
+	return nil
+}
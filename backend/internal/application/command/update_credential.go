@@ -8,32 +8,43 @@ import (
 
 	"github.com/google/uuid"
 	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
 	"github.com/supallm/core/internal/pkg/errs"
-	"github.com/supallm/core/internal/pkg/secret"
 )
 
 type UpdateCredentialCommand struct {
 	ID        uuid.UUID
 	ProjectID uuid.UUID
-	Name      string
-	APIKey    secret.APIKey
+	// Name is kept when empty.
+	Name string
+	// Changes are applied to the payload: the fields set to nil are removed,
+	// the fields left out are kept.
+	Changes map[string]any
 }
 
 type UpdateCredentialHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewUpdateCredentialHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) UpdateCredentialHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return UpdateCredentialHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -46,9 +57,15 @@ func (h UpdateCredentialHandler) Handle(ctx context.Context, cmd UpdateCredentia
 		return errs.InternalError{Err: err}
 	}
 
-	err = project.UpdateCredential(cmd.ID, cmd.Name, cmd.APIKey)
+	credential, ok := project.Credentials[cmd.ID]
+	if !ok {
+		return errs.NotFoundError{Resource: "credential", ID: cmd.ID}
+	}
+
+	before := model.AuditSnapshot(credential)
+	err = project.UpdateCredential(cmd.ID, cmd.Name, cmd.Changes)
 	if err != nil {
-		return errs.InvalidError{Reason: err.Error()}
+		return errs.InvalidError{Reason: "unable to update credential", Err: err}
 	}
 
 	err = h.projectRepo.Update(ctx, project)
@@ -56,5 +73,14 @@ func (h UpdateCredentialHandler) Handle(ctx context.Context, cmd UpdateCredentia
 		return errs.UpdateError{Entity: "project", Err: err}
 	}
 
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionCredentialUpdated,
+		Resource:   "credential",
+		ResourceID: cmd.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(credential),
+	})
+
 	return nil
 }
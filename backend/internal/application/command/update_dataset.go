@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type UpdateDatasetCommand struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	Name        string
+	Description string
+}
+
+type UpdateDatasetHandler struct {
+	evalRepo    repository.EvalRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewUpdateDatasetHandler(
+	evalRepo repository.EvalRepository,
+	auditLogger repository.AuditLogger,
+) UpdateDatasetHandler {
+	if evalRepo == nil {
+		slog.Error("evalRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return UpdateDatasetHandler{
+		evalRepo:    evalRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h UpdateDatasetHandler) Handle(ctx context.Context, cmd UpdateDatasetCommand) error {
+	dataset, err := retrieveProjectDataset(ctx, h.evalRepo, cmd.ProjectID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	before := model.AuditSnapshot(dataset)
+	if err = dataset.Update(cmd.Name, cmd.Description); err != nil {
+		return err
+	}
+
+	err = h.evalRepo.UpdateDataset(ctx, dataset)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicate) {
+			return errs.DuplicateError{Resource: "dataset", ID: cmd.Name, Err: err}
+		}
+		return errs.UpdateError{Entity: "dataset", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &dataset.ProjectID,
+		Action:     model.AuditActionDatasetUpdated,
+		Resource:   "dataset",
+		ResourceID: dataset.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(dataset),
+	})
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/domain/repository"
 	"github.com/supallm/core/internal/pkg/errs"
 )
@@ -19,18 +20,26 @@ type UpdateProjectNameCommand struct {
 
 type UpdateProjectNameHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewUpdateProjectNameHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) UpdateProjectNameHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return UpdateProjectNameHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -40,6 +49,7 @@ func (h UpdateProjectNameHandler) Handle(ctx context.Context, cmd UpdateProjectN
 		return errs.InternalError{Err: err}
 	}
 
+	before := project.Name
 	err = project.UpdateName(cmd.Name)
 	if err != nil {
 		return err
@@ -56,5 +66,14 @@ func (h UpdateProjectNameHandler) Handle(ctx context.Context, cmd UpdateProjectN
 		return errs.InternalError{Err: err}
 	}
 
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &project.ID,
+		Action:     model.AuditActionProjectRenamed,
+		Resource:   "project",
+		ResourceID: project.ID.String(),
+		Before:     map[string]any{"Name": before},
+		After:      map[string]any{"Name": project.Name},
+	})
+
 	return nil
 }
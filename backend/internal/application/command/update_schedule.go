@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type UpdateScheduleCommand struct {
+	ID             uuid.UUID
+	ProjectID      uuid.UUID
+	WorkflowID     model.WorkflowID
+	CronExpression string
+	Timezone       string
+	Inputs         map[string]any
+	Enabled        bool
+}
+
+type UpdateScheduleHandler struct {
+	scheduleRepo repository.ScheduleRepository
+	auditLogger  repository.AuditLogger
+}
+
+func NewUpdateScheduleHandler(
+	scheduleRepo repository.ScheduleRepository,
+	auditLogger repository.AuditLogger,
+) UpdateScheduleHandler {
+	if scheduleRepo == nil {
+		slog.Error("scheduleRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return UpdateScheduleHandler{
+		scheduleRepo: scheduleRepo,
+		auditLogger:  auditLogger,
+	}
+}
+
+func (h UpdateScheduleHandler) Handle(ctx context.Context, cmd UpdateScheduleCommand) error {
+	schedule, err := retrieveWorkflowSchedule(ctx, h.scheduleRepo, cmd.ProjectID, cmd.WorkflowID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	before := model.AuditSnapshot(schedule)
+	err = schedule.Update(cmd.CronExpression, cmd.Timezone, cmd.Inputs, cmd.Enabled, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = h.scheduleRepo.UpdateSchedule(ctx, schedule)
+	if err != nil {
+		return errs.UpdateError{Entity: "schedule", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &schedule.ProjectID,
+		Action:     model.AuditActionScheduleUpdated,
+		Resource:   "schedule",
+		ResourceID: schedule.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(schedule),
+	})
+
+	return nil
+}
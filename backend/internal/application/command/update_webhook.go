@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type UpdateWebhookCommand struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	URL         string
+	EventTypes  []model.WorkflowEventType
+	WorkflowIDs []model.WorkflowID
+	Enabled     bool
+}
+
+type UpdateWebhookHandler struct {
+	projectRepo repository.ProjectRepository
+	webhookRepo repository.WebhookRepository
+	urlChecker  webhookURLChecker
+	auditLogger repository.AuditLogger
+}
+
+func NewUpdateWebhookHandler(
+	projectRepo repository.ProjectRepository,
+	webhookRepo repository.WebhookRepository,
+	urlChecker webhookURLChecker,
+	auditLogger repository.AuditLogger,
+) UpdateWebhookHandler {
+	if projectRepo == nil {
+		slog.Error("projectRepo is nil")
+		os.Exit(1)
+	}
+
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if urlChecker == nil {
+		slog.Error("urlChecker is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return UpdateWebhookHandler{
+		projectRepo: projectRepo,
+		webhookRepo: webhookRepo,
+		urlChecker:  urlChecker,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h UpdateWebhookHandler) Handle(ctx context.Context, cmd UpdateWebhookCommand) error {
+	webhook, err := retrieveProjectWebhook(ctx, h.webhookRepo, cmd.ProjectID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	err = validateWebhookWorkflows(ctx, h.projectRepo, cmd.ProjectID, cmd.WorkflowIDs)
+	if err != nil {
+		return err
+	}
+
+	before := model.AuditSnapshot(webhook)
+	err = webhook.Update(cmd.URL, cmd.EventTypes, cmd.WorkflowIDs, cmd.Enabled)
+	if err != nil {
+		return err
+	}
+
+	if err = checkWebhookURL(ctx, h.urlChecker, webhook.URL); err != nil {
+		return err
+	}
+
+	err = h.webhookRepo.UpdateWebhook(ctx, webhook)
+	if err != nil {
+		return errs.UpdateError{Entity: "webhook", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &webhook.ProjectID,
+		Action:     model.AuditActionWebhookUpdated,
+		Resource:   "webhook",
+		ResourceID: webhook.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(webhook),
+	})
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type UpdateWebhookTriggerCommand struct {
+	ID              uuid.UUID
+	ProjectID       uuid.UUID
+	WorkflowID      model.WorkflowID
+	Name            string
+	Verification    model.WebhookVerification
+	SignatureHeader string
+	Secret          string
+	InputMapping    map[string]string
+	RespondSync     bool
+	SyncTimeout     time.Duration
+	Enabled         bool
+}
+
+type UpdateWebhookTriggerHandler struct {
+	webhookRepo repository.WebhookRepository
+	auditLogger repository.AuditLogger
+}
+
+func NewUpdateWebhookTriggerHandler(
+	webhookRepo repository.WebhookRepository,
+	auditLogger repository.AuditLogger,
+) UpdateWebhookTriggerHandler {
+	if webhookRepo == nil {
+		slog.Error("webhookRepo is nil")
+		os.Exit(1)
+	}
+
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
+	return UpdateWebhookTriggerHandler{
+		webhookRepo: webhookRepo,
+		auditLogger: auditLogger,
+	}
+}
+
+func (h UpdateWebhookTriggerHandler) Handle(ctx context.Context, cmd UpdateWebhookTriggerCommand) error {
+	trigger, err := retrieveWorkflowWebhookTrigger(ctx, h.webhookRepo, cmd.ProjectID, cmd.WorkflowID, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	before := model.AuditSnapshot(trigger)
+	err = trigger.Update(
+		cmd.Name,
+		cmd.Verification,
+		cmd.SignatureHeader,
+		cmd.Secret,
+		cmd.InputMapping,
+		cmd.RespondSync,
+		cmd.SyncTimeout,
+		cmd.Enabled,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = h.webhookRepo.UpdateWebhookTrigger(ctx, trigger)
+	if err != nil {
+		return errs.UpdateError{Entity: "webhook trigger", Err: err}
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &trigger.ProjectID,
+		Action:     model.AuditActionWebhookTriggerUpdated,
+		Resource:   "webhook_trigger",
+		ResourceID: trigger.ID.String(),
+		Before:     before,
+		After:      model.AuditSnapshot(trigger),
+	})
+
+	return nil
+}
@@ -21,28 +21,43 @@ type UpdateWorkflowCommand struct {
 
 type UpdateWorkflowHandler struct {
 	projectRepo repository.ProjectRepository
+	auditLogger repository.AuditLogger
 }
 
 func NewUpdateWorkflowHandler(
 	projectRepo repository.ProjectRepository,
+	auditLogger repository.AuditLogger,
 ) UpdateWorkflowHandler {
 	if projectRepo == nil {
 		slog.Error("projectRepo is nil")
 		os.Exit(1)
 	}
 
+	if auditLogger == nil {
+		slog.Error("auditLogger is nil")
+		os.Exit(1)
+	}
+
 	return UpdateWorkflowHandler{
 		projectRepo: projectRepo,
+		auditLogger: auditLogger,
 	}
 }
 
 func (h UpdateWorkflowHandler) Handle(ctx context.Context, cmd UpdateWorkflowCommand) error {
-	return retryOnConflict(ctx, defaultRetryConfig, errs.InvalidError{}, func() error {
+	var before, after map[string]any
+	err := retryOnConflict(ctx, defaultRetryConfig, errs.InvalidError{}, func() error {
 		project, err := h.projectRepo.Retrieve(ctx, cmd.ProjectID)
 		if err != nil {
 			return errs.NotFoundError{Resource: "project", ID: cmd.ProjectID}
 		}
 
+		workflow, err := project.GetWorkflow(cmd.WorkflowID)
+		if err != nil {
+			return errs.InvalidError{Reason: err.Error()}
+		}
+		before = model.AuditSnapshot(workflow)
+
 		err = project.UpdateWorkflowName(cmd.WorkflowID, cmd.Name)
 		if err != nil {
 			return errs.InvalidError{Reason: err.Error()}
@@ -52,7 +67,22 @@ func (h UpdateWorkflowHandler) Handle(ctx context.Context, cmd UpdateWorkflowCom
 		if err != nil {
 			return errs.InvalidError{Reason: err.Error()}
 		}
+		after = model.AuditSnapshot(workflow)
 
 		return h.projectRepo.Update(ctx, project)
 	})
+	if err != nil {
+		return err
+	}
+
+	recordAudit(ctx, h.auditLogger, model.AuditEvent{
+		ProjectID:  &cmd.ProjectID,
+		Action:     model.AuditActionWorkflowUpdated,
+		Resource:   "workflow",
+		ResourceID: cmd.WorkflowID.String(),
+		Before:     before,
+		After:      after,
+	})
+
+	return nil
 }
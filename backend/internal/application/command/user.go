@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// IssuedToken is a one-time token handed out to a user, such as an
+// invitation or a password reset token.
+type IssuedToken struct {
+	Token     auth.OneTimeToken
+	ExpiresAt time.Time
+}
+
+func retrieveUser(ctx context.Context, userRepo repository.UserRepository, id uuid.UUID) (*model.User, error) {
+	user, err := userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "user", ID: id, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return user, nil
+}
+
+// ensureEmailAvailable rejects the emails of existing users.
+func ensureEmailAvailable(ctx context.Context, userRepo repository.UserRepository, email string) error {
+	_, err := userRepo.GetUserByEmail(ctx, email)
+	if err == nil {
+		return errs.DuplicateError{Resource: "user", ID: email}
+	}
+	if !errors.Is(err, repo.ErrNotFound) {
+		return errs.InternalError{Err: err}
+	}
+	return nil
+}
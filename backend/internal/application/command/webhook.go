@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	repo "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/domain/repository"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// retrieveProjectWebhook returns the webhook only when it belongs to the project.
+func retrieveProjectWebhook(
+	ctx context.Context,
+	webhookRepo repository.WebhookRepository,
+	projectID uuid.UUID,
+	webhookID uuid.UUID,
+) (*model.Webhook, error) {
+	webhook, err := webhookRepo.RetrieveWebhook(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "webhook", ID: webhookID, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if webhook.ProjectID != projectID {
+		return nil, errs.NotFoundError{Resource: "webhook", ID: webhookID}
+	}
+
+	return webhook, nil
+}
+
+// validateWebhookWorkflows checks that the filtered workflows belong to the project.
+func validateWebhookWorkflows(
+	ctx context.Context,
+	projectRepo repository.ProjectRepository,
+	projectID uuid.UUID,
+	workflowIDs []model.WorkflowID,
+) error {
+	project, err := projectRepo.Retrieve(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return errs.NotFoundError{Resource: "project", ID: projectID}
+		}
+		return errs.InternalError{Err: err}
+	}
+
+	for _, id := range workflowIDs {
+		if _, err = project.GetWorkflow(id); err != nil {
+			return errs.InvalidError{Field: "workflowIds", Reason: "unknown workflow " + id.String(), Err: err}
+		}
+	}
+
+	return nil
+}
+
+// checkWebhookURL rejects the URLs resolving to internal addresses,
+// the sender checks them again on each delivery.
+func checkWebhookURL(ctx context.Context, urlChecker webhookURLChecker, url string) error {
+	if err := urlChecker.CheckURL(ctx, url); err != nil {
+		return errs.InvalidError{Field: "url", Reason: "url must resolve to a public address", Err: err}
+	}
+	return nil
+}
+
+// retrieveWorkflowWebhookTrigger returns the webhook trigger only when it belongs to the workflow.
+func retrieveWorkflowWebhookTrigger(
+	ctx context.Context,
+	webhookRepo repository.WebhookRepository,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	triggerID uuid.UUID,
+) (*model.WebhookTrigger, error) {
+	trigger, err := webhookRepo.RetrieveWebhookTrigger(ctx, triggerID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "webhook trigger", ID: triggerID, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if trigger.ProjectID != projectID || trigger.WorkflowID != workflowID {
+		return nil, errs.NotFoundError{Resource: "webhook trigger", ID: triggerID}
+	}
+
+	return trigger, nil
+}
@@ -0,0 +1,255 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AuditActionLoginSucceeded = "login.succeeded"
+	AuditActionLoginFailed    = "login.failed"
+	AuditActionLoginLocked    = "login.locked"
+
+	AuditActionProjectCreated             = "project.created"
+	AuditActionProjectRenamed             = "project.renamed"
+	AuditActionProjectAuthProviderUpdated = "project.auth_provider_updated"
+	AuditActionAPIKeyCreated              = "api_key.created"
+
+	AuditActionOrganizationCreated       = "organization.created"
+	AuditActionOrganizationMemberSaved   = "organization_member.saved"
+	AuditActionOrganizationMemberRemoved = "organization_member.removed"
+	AuditActionProjectMemberSaved        = "project_member.saved"
+	AuditActionProjectMemberRemoved      = "project_member.removed"
+
+	AuditActionWorkflowCreated  = "workflow.created"
+	AuditActionWorkflowUpdated  = "workflow.updated"
+	AuditActionWorkflowRemoved  = "workflow.removed"
+	AuditActionWorkflowCanceled = "workflow.canceled"
+
+	AuditActionCredentialCreated  = "credential.created"
+	AuditActionCredentialUpdated  = "credential.updated"
+	AuditActionCredentialRemoved  = "credential.removed"
+	AuditActionCredentialTested   = "credential.tested"
+	AuditActionCredentialReplaced = "credential.replaced"
+
+	AuditActionWebhookCreated     = "webhook.created"
+	AuditActionWebhookUpdated     = "webhook.updated"
+	AuditActionWebhookRemoved     = "webhook.removed"
+	AuditActionWebhookRedelivered = "webhook.redelivered"
+
+	AuditActionWebhookTriggerCreated = "webhook_trigger.created"
+	AuditActionWebhookTriggerUpdated = "webhook_trigger.updated"
+	AuditActionWebhookTriggerRemoved = "webhook_trigger.removed"
+
+	AuditActionScheduleCreated = "schedule.created"
+	AuditActionScheduleUpdated = "schedule.updated"
+	AuditActionScheduleRemoved = "schedule.removed"
+
+	AuditActionDatasetCreated    = "dataset.created"
+	AuditActionDatasetUpdated    = "dataset.updated"
+	AuditActionDatasetRemoved    = "dataset.removed"
+	AuditActionDatasetCasesAdded = "dataset.cases_added"
+
+	AuditActionBatchCreated   = "batch.created"
+	AuditActionEvalRunCreated = "eval_run.created"
+
+	AuditActionTrafficSplitSaved   = "traffic_split.saved"
+	AuditActionTrafficSplitRemoved = "traffic_split.removed"
+
+	AuditActionBudgetCreated = "budget.created"
+	AuditActionBudgetUpdated = "budget.updated"
+	AuditActionBudgetRemoved = "budget.removed"
+
+	AuditActionRateLimitSaved   = "rate_limit.saved"
+	AuditActionRateLimitRemoved = "rate_limit.removed"
+
+	AuditActionUserCreated            = "user.created"
+	AuditActionUserInvited            = "user.invited"
+	AuditActionInvitationRevoked      = "invitation.revoked"
+	AuditActionInvitationAccepted     = "invitation.accepted"
+	AuditActionPasswordChanged        = "user.password_changed"
+	AuditActionPasswordResetIssued    = "user.password_reset_issued"
+	AuditActionPasswordReset          = "user.password_reset"
+	AuditActionUserDeactivated        = "user.deactivated"
+	AuditActionUserActivated          = "user.activated"
+	AuditActionMFAEnabled             = "user.mfa_enabled"
+	AuditActionMFADisabled            = "user.mfa_disabled"
+	AuditActionMFAReset               = "user.mfa_reset"
+	AuditActionRecoveryCodesGenerated = "user.recovery_codes_generated"
+	AuditActionSessionRevoked         = "session.revoked"
+	AuditActionUserSessionsRevoked    = "user.sessions_revoked"
+
+	// AuditRedacted replaces the secrets in the recorded resources.
+	AuditRedacted = "[redacted]"
+)
+
+// auditSecretFields are the names of the fields holding secrets, matched
+// in lower case. Only their text values are redacted.
+//
+//nolint:gochecknoglobals // matched against every recorded field
+var auditSecretFields = []string{"password", "secret", "apikey", "api_key", "token", "digest", "hash", "totp", "recovery"}
+
+// AuditEvent records a security-relevant action or a change of configuration.
+// Events are appended to the audit log, they are never updated.
+type AuditEvent struct {
+	ID uuid.UUID `exhaustruct:"optional"`
+	// ProjectID is the project of the resource, nil for the account events.
+	ProjectID *uuid.UUID `exhaustruct:"optional"`
+	// ActorType is filled from the request when empty, along with the
+	// actor and its address.
+	ActorType string `exhaustruct:"optional"`
+	// ActorID is the user behind the action, nil for the other actors.
+	ActorID    *uuid.UUID `exhaustruct:"optional"`
+	IPAddress  string     `exhaustruct:"optional"`
+	Action     string
+	Resource   string
+	ResourceID string
+	// Before and After are the snapshots of the resource, nil for the
+	// created and removed resources. Only the changed fields are recorded.
+	Before     map[string]any `exhaustruct:"optional"`
+	After      map[string]any `exhaustruct:"optional"`
+	Details    map[string]any `exhaustruct:"optional"`
+	OccurredAt time.Time      `exhaustruct:"optional"`
+}
+
+// auditSnapshotter is implemented by the resources recorded with fields of
+// their own rather than all of their fields.
+type auditSnapshotter interface {
+	auditSnapshot() map[string]any
+}
+
+// AuditSnapshot returns the fields of a resource as recorded in the audit log,
+// nil when the resource is nil or cannot be represented.
+func AuditSnapshot(resource any) map[string]any {
+	if resource == nil {
+		return nil
+	}
+	if v := reflect.ValueOf(resource); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+
+	if snapshotter, ok := resource.(auditSnapshotter); ok {
+		return snapshotter.auditSnapshot()
+	}
+
+	snapshot, _ := auditValue(resource).(map[string]any)
+	return snapshot
+}
+
+// auditValue returns the JSON representation of a value, nil when it has none.
+func auditValue(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var value any
+	if err = json.Unmarshal(b, &value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// Diff reduces the snapshots to the fields which changed, then redacts
+// their secrets. A changed secret is recorded as redacted on both sides.
+func (e *AuditEvent) Diff() {
+	if e.Before != nil && e.After != nil {
+		e.Before, e.After = diffAuditFields(e.Before, e.After)
+	}
+	e.Before = redactAuditFields(e.Before)
+	e.After = redactAuditFields(e.After)
+	e.Details = redactAuditFields(e.Details)
+}
+
+func diffAuditFields(before, after map[string]any) (map[string]any, map[string]any) {
+	changedBefore := map[string]any{}
+	changedAfter := map[string]any{}
+
+	for key, old := range before {
+		value, ok := after[key]
+		if !ok {
+			changedBefore[key] = old
+			continue
+		}
+		if reflect.DeepEqual(old, value) {
+			continue
+		}
+
+		oldFields, oldIsMap := old.(map[string]any)
+		fields, isMap := value.(map[string]any)
+		if oldIsMap && isMap {
+			changedBefore[key], changedAfter[key] = diffAuditFields(oldFields, fields)
+			continue
+		}
+
+		changedBefore[key] = old
+		changedAfter[key] = value
+	}
+
+	for key, value := range after {
+		if _, ok := before[key]; !ok {
+			changedAfter[key] = value
+		}
+	}
+
+	return changedBefore, changedAfter
+}
+
+func redactAuditFields(fields map[string]any) map[string]any {
+	if fields == nil {
+		return nil
+	}
+
+	redacted := make(map[string]any, len(fields))
+	for key, value := range fields {
+		if isAuditSecret(key) && isAuditText(value) {
+			redacted[key] = AuditRedacted
+			continue
+		}
+		redacted[key] = redactAuditValue(value)
+	}
+	return redacted
+}
+
+func isAuditText(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	default:
+		return false
+	}
+}
+
+func redactAuditValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return redactAuditFields(v)
+	case []any:
+		values := make([]any, len(v))
+		for i, item := range v {
+			values[i] = redactAuditValue(item)
+		}
+		return values
+	default:
+		return v
+	}
+}
+
+func isAuditSecret(field string) bool {
+	field = strings.ToLower(field)
+	if field == "key" {
+		return true
+	}
+	for _, secret := range auditSecretFields {
+		if strings.Contains(field, secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,8 +1,10 @@
 package model
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/supallm/core/internal/pkg/secret"
 )
@@ -16,6 +18,9 @@ func (t AuthProviderType) String() string {
 const (
 	AuthProviderSupabase AuthProviderType = "supabase"
 	AuthProviderClerk    AuthProviderType = "clerk"
+	AuthProviderOIDC     AuthProviderType = "oidc"
+	AuthProviderAuth0    AuthProviderType = "auth0"
+	AuthProviderFirebase AuthProviderType = "firebase"
 )
 
 type AuthProvider interface {
@@ -24,9 +29,14 @@ type AuthProvider interface {
 	Config() map[string]any
 }
 
+// SupabaseAuthProvider verifies the access tokens of a Supabase project,
+// signed with the legacy JWT secret when it is set, with the signing keys
+// published by the project otherwise.
 type SupabaseAuthProvider struct {
-	URL string
-	Key secret.APIKey
+	URL       string
+	Key       secret.APIKey
+	JWTSecret secret.APIKey
+	JWKSURL   string
 }
 
 func (s SupabaseAuthProvider) GetType() AuthProviderType {
@@ -44,15 +54,38 @@ func (s SupabaseAuthProvider) Validate() error {
 }
 
 func (s SupabaseAuthProvider) Config() map[string]any {
-	return map[string]any{
+	config := map[string]any{
 		"url": s.URL,
 		"key": s.Key,
 	}
+	if s.JWTSecret != "" {
+		config["jwt_secret"] = s.JWTSecret
+	}
+	if s.JWKSURL != "" {
+		config["jwks_url"] = s.JWKSURL
+	}
+	return config
+}
+
+// Issuer returns the issuer of the access tokens of the project.
+func (s SupabaseAuthProvider) Issuer() string {
+	return strings.TrimSuffix(s.URL, "/") + "/auth/v1"
 }
 
+// KeysURL returns the JWKS endpoint publishing the signing keys of the project.
+func (s SupabaseAuthProvider) KeysURL() string {
+	if s.JWKSURL != "" {
+		return s.JWKSURL
+	}
+	return s.Issuer() + "/.well-known/jwks.json"
+}
+
+// ClerkAuthProvider verifies the session tokens of a Clerk instance with the
+// signing keys published by its frontend API, found in the publishable key.
 type ClerkAuthProvider struct {
 	PublishableKey secret.APIKey
 	SecretKey      secret.APIKey
+	JWKSURL        string
 }
 
 func (c ClerkAuthProvider) GetType() AuthProviderType {
@@ -66,14 +99,50 @@ func (c ClerkAuthProvider) Validate() error {
 	if c.SecretKey == "" {
 		return errors.New("clerk secret key is required")
 	}
+	if _, err := c.KeysURL(); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (c ClerkAuthProvider) Config() map[string]any {
-	return map[string]any{
+	config := map[string]any{
 		"publishable_key": c.PublishableKey,
 		"secret_key":      c.SecretKey,
 	}
+	if c.JWKSURL != "" {
+		config["jwks_url"] = c.JWKSURL
+	}
+	return config
+}
+
+// Issuer returns the frontend API of the instance issuing the session tokens,
+// encoded in the publishable key as pk_<env>_base64(<host>$).
+func (c ClerkAuthProvider) Issuer() (string, error) {
+	parts := strings.SplitN(c.PublishableKey.String(), "_", 3)
+	if len(parts) != 3 || parts[0] != "pk" {
+		return "", errors.New("invalid clerk publishable key")
+	}
+
+	host, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(parts[2], "="))
+	if err != nil || !strings.HasSuffix(string(host), "$") {
+		return "", errors.New("invalid clerk publishable key")
+	}
+
+	return "https://" + strings.TrimSuffix(string(host), "$"), nil
+}
+
+// KeysURL returns the JWKS endpoint publishing the signing keys of the instance.
+func (c ClerkAuthProvider) KeysURL() (string, error) {
+	if c.JWKSURL != "" {
+		return c.JWKSURL, nil
+	}
+
+	issuer, err := c.Issuer()
+	if err != nil {
+		return "", err
+	}
+	return issuer + "/.well-known/jwks.json", nil
 }
 
 func UnmarshalAuthProvider(providerType AuthProviderType, config map[string]any) (AuthProvider, error) {
@@ -87,9 +156,13 @@ func UnmarshalAuthProvider(providerType AuthProviderType, config map[string]any)
 		if !ok {
 			return nil, errors.New("key is required")
 		}
+		jwtSecret, _ := config["jwt_secret"].(string)
+		jwksURL, _ := config["jwks_url"].(string)
 		return SupabaseAuthProvider{
-			URL: url,
-			Key: secret.APIKey(key),
+			URL:       url,
+			Key:       secret.APIKey(key),
+			JWTSecret: secret.APIKey(jwtSecret),
+			JWKSURL:   jwksURL,
 		}, nil
 	case AuthProviderClerk:
 		publishableKey, ok := config["publishable_key"].(string)
@@ -100,10 +173,18 @@ func UnmarshalAuthProvider(providerType AuthProviderType, config map[string]any)
 		if !ok {
 			return nil, errors.New("secret key is required")
 		}
+		jwksURL, _ := config["jwks_url"].(string)
 		return ClerkAuthProvider{
 			PublishableKey: secret.APIKey(publishableKey),
 			SecretKey:      secret.APIKey(secretKey),
+			JWKSURL:        jwksURL,
 		}, nil
+	case AuthProviderOIDC:
+		return unmarshalOIDCAuthProvider(config)
+	case AuthProviderAuth0:
+		return unmarshalAuth0AuthProvider(config)
+	case AuthProviderFirebase:
+		return unmarshalFirebaseAuthProvider(config)
 	default:
 		return nil, fmt.Errorf("unsupported auth provider type: %s", providerType)
 	}
@@ -0,0 +1,252 @@
+package model
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const (
+	firebaseIssuerURL = "https://securetoken.google.com/"
+	firebaseKeysURL   = "https://www.googleapis.com/service_accounts/v1/jwk/securetoken@system.gserviceaccount.com"
+)
+
+// OIDCProvider is implemented by the auth providers whose tokens are
+// verified as the ID or access tokens of an OpenID Connect issuer.
+type OIDCProvider interface {
+	OIDC() OIDCAuthProvider
+}
+
+// ClaimMapping names the token claims holding the end-user ID and email.
+// Nested claims are addressed with dotted paths.
+type ClaimMapping struct {
+	UserID string
+	Email  string
+}
+
+// OIDCAuthProvider verifies the tokens of any OpenID Connect issuer, with the
+// keys published at JWKSURL or with static keys given as JSON Web Keys.
+type OIDCAuthProvider struct {
+	Issuer   string
+	Audience string
+	JWKSURL  string
+	Keys     []map[string]any
+	Claims   ClaimMapping
+}
+
+func (o OIDCAuthProvider) GetType() AuthProviderType {
+	return AuthProviderOIDC
+}
+
+func (o OIDCAuthProvider) Validate() error {
+	if o.Issuer == "" {
+		return errors.New("oidc issuer is required")
+	}
+	if o.JWKSURL == "" && len(o.Keys) == 0 {
+		return errors.New("oidc jwks url or keys are required")
+	}
+	if o.JWKSURL != "" {
+		u, err := url.Parse(o.JWKSURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return errors.New("oidc jwks url is invalid")
+		}
+	}
+	return nil
+}
+
+func (o OIDCAuthProvider) Config() map[string]any {
+	config := map[string]any{
+		"issuer": o.Issuer,
+	}
+	if o.Audience != "" {
+		config["audience"] = o.Audience
+	}
+	if o.JWKSURL != "" {
+		config["jwks_url"] = o.JWKSURL
+	}
+	if len(o.Keys) > 0 {
+		keys := make([]any, 0, len(o.Keys))
+		for _, k := range o.Keys {
+			keys = append(keys, k)
+		}
+		config["keys"] = keys
+	}
+	if claims := o.Claims.config(); len(claims) > 0 {
+		config["claims"] = claims
+	}
+	return config
+}
+
+func (o OIDCAuthProvider) OIDC() OIDCAuthProvider {
+	return o
+}
+
+// ClaimNames returns the claim mapping with the standard claims as defaults.
+func (o OIDCAuthProvider) ClaimNames() ClaimMapping {
+	claims := o.Claims
+	if claims.UserID == "" {
+		claims.UserID = "sub"
+	}
+	if claims.Email == "" {
+		claims.Email = "email"
+	}
+	return claims
+}
+
+// Auth0AuthProvider verifies the tokens issued by an Auth0 tenant for an API.
+type Auth0AuthProvider struct {
+	Domain   string
+	Audience string
+	Claims   ClaimMapping
+}
+
+func (a Auth0AuthProvider) GetType() AuthProviderType {
+	return AuthProviderAuth0
+}
+
+func (a Auth0AuthProvider) Validate() error {
+	if a.domain() == "" {
+		return errors.New("auth0 domain is required")
+	}
+	return nil
+}
+
+func (a Auth0AuthProvider) Config() map[string]any {
+	config := map[string]any{
+		"domain": a.Domain,
+	}
+	if a.Audience != "" {
+		config["audience"] = a.Audience
+	}
+	if claims := a.Claims.config(); len(claims) > 0 {
+		config["claims"] = claims
+	}
+	return config
+}
+
+func (a Auth0AuthProvider) OIDC() OIDCAuthProvider {
+	issuer := "https://" + a.domain() + "/"
+	return OIDCAuthProvider{
+		Issuer:   issuer,
+		Audience: a.Audience,
+		JWKSURL:  issuer + ".well-known/jwks.json",
+		Keys:     nil,
+		Claims:   a.Claims,
+	}
+}
+
+func (a Auth0AuthProvider) domain() string {
+	domain := strings.TrimPrefix(a.Domain, "https://")
+	return strings.TrimSuffix(domain, "/")
+}
+
+// FirebaseAuthProvider verifies the ID tokens of a Firebase project,
+// custom claims set with the admin SDK can hold the end-user ID and email.
+type FirebaseAuthProvider struct {
+	ProjectID string
+	Claims    ClaimMapping
+}
+
+func (f FirebaseAuthProvider) GetType() AuthProviderType {
+	return AuthProviderFirebase
+}
+
+func (f FirebaseAuthProvider) Validate() error {
+	if f.ProjectID == "" {
+		return errors.New("firebase project id is required")
+	}
+	return nil
+}
+
+func (f FirebaseAuthProvider) Config() map[string]any {
+	config := map[string]any{
+		"project_id": f.ProjectID,
+	}
+	if claims := f.Claims.config(); len(claims) > 0 {
+		config["claims"] = claims
+	}
+	return config
+}
+
+func (f FirebaseAuthProvider) OIDC() OIDCAuthProvider {
+	return OIDCAuthProvider{
+		Issuer:   firebaseIssuerURL + f.ProjectID,
+		Audience: f.ProjectID,
+		JWKSURL:  firebaseKeysURL,
+		Keys:     nil,
+		Claims:   f.Claims,
+	}
+}
+
+func (c ClaimMapping) config() map[string]any {
+	config := map[string]any{}
+	if c.UserID != "" {
+		config["user_id"] = c.UserID
+	}
+	if c.Email != "" {
+		config["email"] = c.Email
+	}
+	return config
+}
+
+func unmarshalClaimMapping(config map[string]any) ClaimMapping {
+	claims, _ := config["claims"].(map[string]any)
+	userID, _ := claims["user_id"].(string)
+	email, _ := claims["email"].(string)
+	return ClaimMapping{
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+func unmarshalOIDCAuthProvider(config map[string]any) (AuthProvider, error) {
+	issuer, ok := config["issuer"].(string)
+	if !ok {
+		return nil, errors.New("issuer is required")
+	}
+	audience, _ := config["audience"].(string)
+	jwksURL, _ := config["jwks_url"].(string)
+
+	var keys []map[string]any
+	if raw, ok := config["keys"].([]any); ok {
+		for _, k := range raw {
+			key, ok := k.(map[string]any)
+			if !ok {
+				return nil, errors.New("keys must be JSON web keys")
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	return OIDCAuthProvider{
+		Issuer:   issuer,
+		Audience: audience,
+		JWKSURL:  jwksURL,
+		Keys:     keys,
+		Claims:   unmarshalClaimMapping(config),
+	}, nil
+}
+
+func unmarshalAuth0AuthProvider(config map[string]any) (AuthProvider, error) {
+	domain, ok := config["domain"].(string)
+	if !ok {
+		return nil, errors.New("domain is required")
+	}
+	audience, _ := config["audience"].(string)
+	return Auth0AuthProvider{
+		Domain:   domain,
+		Audience: audience,
+		Claims:   unmarshalClaimMapping(config),
+	}, nil
+}
+
+func unmarshalFirebaseAuthProvider(config map[string]any) (AuthProvider, error) {
+	projectID, ok := config["project_id"].(string)
+	if !ok {
+		return nil, errors.New("project id is required")
+	}
+	return FirebaseAuthProvider{
+		ProjectID: projectID,
+		Claims:    unmarshalClaimMapping(config),
+	}, nil
+}
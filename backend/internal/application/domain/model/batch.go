@@ -0,0 +1,149 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type (
+	BatchStatus     string
+	BatchItemStatus string
+)
+
+const (
+	BatchRunning   BatchStatus = "running"
+	BatchCompleted BatchStatus = "completed"
+
+	BatchItemPending   BatchItemStatus = "pending"
+	BatchItemRunning   BatchItemStatus = "running"
+	BatchItemSucceeded BatchItemStatus = "succeeded"
+	BatchItemFailed    BatchItemStatus = "failed"
+
+	MaxBatchItems           = 10000
+	DefaultBatchConcurrency = 5
+	MaxBatchConcurrency     = 50
+
+	resultEventKey = "result"
+	errorEventKey  = "error"
+)
+
+// Batch runs a workflow once per input set, at most Concurrency executions at a time.
+// Items are only loaded when the batch is created. Revision is the revision of the
+// workflow when the batch was created, the items only run that revision.
+type Batch struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	WorkflowID  WorkflowID
+	Revision    string
+	Status      BatchStatus
+	Concurrency int
+	Items       []*BatchItem
+}
+
+// BatchItem is the execution of the workflow for one input set of a batch.
+type BatchItem struct {
+	BatchID    uuid.UUID
+	Index      int
+	TriggerID  uuid.UUID
+	Inputs     map[string]any
+	Status     BatchItemStatus
+	Result     any
+	Error      string
+	FinishedAt *time.Time
+}
+
+func NewBatch(
+	id uuid.UUID,
+	projectID uuid.UUID,
+	workflow *Workflow,
+	inputs []map[string]any,
+	concurrency int,
+) (*Batch, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	if len(inputs) == 0 {
+		return nil, errs.InvalidError{Field: "inputs", Reason: "at least one input set is required"}
+	}
+
+	if len(inputs) > MaxBatchItems {
+		return nil, errs.InvalidError{
+			Field:  "inputs",
+			Reason: "a batch is limited to " + strconv.Itoa(MaxBatchItems) + " input sets",
+		}
+	}
+
+	if concurrency == 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	if concurrency < 1 || concurrency > MaxBatchConcurrency {
+		return nil, errs.InvalidError{
+			Field:  "concurrency",
+			Reason: "concurrency must be between 1 and " + strconv.Itoa(MaxBatchConcurrency),
+		}
+	}
+
+	items := make([]*BatchItem, len(inputs))
+	for i, in := range inputs {
+		items[i] = &BatchItem{
+			BatchID:    id,
+			Index:      i,
+			TriggerID:  uuid.New(),
+			Inputs:     in,
+			Status:     BatchItemPending,
+			Result:     nil,
+			Error:      "",
+			FinishedAt: nil,
+		}
+	}
+
+	return &Batch{
+		ID:          id,
+		ProjectID:   projectID,
+		WorkflowID:  workflow.ID,
+		Revision:    workflow.Revision(),
+		Status:      BatchRunning,
+		Concurrency: concurrency,
+		Items:       items,
+	}, nil
+}
+
+// Slots returns how many items can be started while the others are running.
+func (b *Batch) Slots(running int) int {
+	return max(b.Concurrency-running, 0)
+}
+
+// Finish records the terminal event of the item execution.
+func (i *BatchItem) Finish(eventType WorkflowEventType, data map[string]any) {
+	now := time.Now()
+	i.FinishedAt = &now
+
+	switch eventType {
+	case WorkflowCompleted:
+		i.Status = BatchItemSucceeded
+		i.Result = data[resultEventKey]
+	case WorkflowCancelled:
+		i.Status = BatchItemFailed
+		i.Error = "workflow cancelled"
+	default:
+		i.Status = BatchItemFailed
+		i.Error, _ = data[errorEventKey].(string)
+	}
+}
+
+// Fail records an item that could not be queued.
+func (i *BatchItem) Fail(err error) {
+	now := time.Now()
+	i.FinishedAt = &now
+	i.Status = BatchItemFailed
+	i.Error = err.Error()
+}
@@ -0,0 +1,247 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type BudgetScope string
+
+const (
+	BudgetScopeProject    BudgetScope = "project"
+	BudgetScopeWorkflow   BudgetScope = "workflow"
+	BudgetScopeCredential BudgetScope = "credential"
+)
+
+type BudgetPeriod string
+
+const (
+	BudgetPeriodDaily   BudgetPeriod = "daily"
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+)
+
+// Budget caps the cost of the token usage of a project, of the triggers of
+// one of its workflows or of the calls made with one of its credentials.
+// Thresholds are percentages of the limit alerted once per period, a hard
+// budget also rejects the triggers it applies to once the limit is reached.
+type Budget struct {
+	ID           uuid.UUID
+	ProjectID    uuid.UUID
+	Scope        BudgetScope
+	WorkflowID   WorkflowID
+	CredentialID *uuid.UUID
+	Period       BudgetPeriod
+	LimitUSD     float64
+	Thresholds   []int
+	Hard         bool
+
+	// AlertedThreshold is the highest threshold alerted in the period
+	// starting at AlertedPeriod.
+	AlertedThreshold int
+	AlertedPeriod    *time.Time
+}
+
+func NewBudget(
+	id uuid.UUID,
+	projectID uuid.UUID,
+	scope BudgetScope,
+	workflowID WorkflowID,
+	credentialID *uuid.UUID,
+	period BudgetPeriod,
+	limitUSD float64,
+	thresholds []int,
+	hard bool,
+) (*Budget, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	switch scope {
+	case BudgetScopeProject:
+		workflowID, credentialID = "", nil
+	case BudgetScopeWorkflow:
+		if workflowID == "" {
+			return nil, errs.InvalidError{Field: "workflowId", Reason: "workflowId is required for a workflow budget"}
+		}
+		credentialID = nil
+	case BudgetScopeCredential:
+		if credentialID == nil {
+			return nil, errs.InvalidError{Field: "credentialId", Reason: "credentialId is required for a credential budget"}
+		}
+		workflowID = ""
+	default:
+		return nil, errs.InvalidError{Field: "scope", Reason: "unknown scope " + string(scope)}
+	}
+
+	budget := &Budget{
+		ID:               id,
+		ProjectID:        projectID,
+		Scope:            scope,
+		WorkflowID:       workflowID,
+		CredentialID:     credentialID,
+		Period:           "",
+		LimitUSD:         0,
+		Thresholds:       nil,
+		Hard:             false,
+		AlertedThreshold: 0,
+		AlertedPeriod:    nil,
+	}
+
+	if err := budget.Update(period, limitUSD, thresholds, hard); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+// Update changes the limits of the budget, the thresholds of the current
+// period are alerted again against the new limit.
+func (b *Budget) Update(period BudgetPeriod, limitUSD float64, thresholds []int, hard bool) error {
+	if period != BudgetPeriodDaily && period != BudgetPeriodMonthly {
+		return errs.InvalidError{Field: "period", Reason: "period must be daily or monthly"}
+	}
+
+	if limitUSD <= 0 {
+		return errs.InvalidError{Field: "limitUsd", Reason: "limitUsd must be positive"}
+	}
+
+	for _, threshold := range thresholds {
+		if threshold < 1 || threshold > 100 {
+			return errs.InvalidError{Field: "thresholds", Reason: "thresholds are percentages between 1 and 100"}
+		}
+	}
+
+	sorted := slices.Clone(thresholds)
+	slices.Sort(sorted)
+
+	b.Period = period
+	b.LimitUSD = limitUSD
+	b.Thresholds = slices.Compact(sorted)
+	b.Hard = hard
+	b.AlertedThreshold = 0
+	b.AlertedPeriod = nil
+	return nil
+}
+
+// PeriodStart returns the start of the period containing t, in UTC.
+func (b *Budget) PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	if b.Period == BudgetPeriodDaily {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Applies reports whether a trigger of the workflow run by the variant using
+// the credentials is counted against the budget. A workflow budget counts the
+// triggers of the workflow and the ones it runs as the variant of another.
+func (b *Budget) Applies(workflowID WorkflowID, variantID WorkflowID, credentialIDs []uuid.UUID) bool {
+	switch b.Scope {
+	case BudgetScopeWorkflow:
+		return b.WorkflowID == workflowID || b.WorkflowID == variantID
+	case BudgetScopeCredential:
+		return slices.Contains(credentialIDs, *b.CredentialID)
+	default:
+		return true
+	}
+}
+
+// Exhausted reports whether a hard budget rejects new triggers.
+func (b *Budget) Exhausted(spentUSD float64) bool {
+	return b.Hard && spentUSD >= b.LimitUSD
+}
+
+// Alert returns the highest threshold reached by the spend of the period
+// starting at periodStart that was not alerted yet, and records it.
+func (b *Budget) Alert(spentUSD float64, periodStart time.Time) (int, bool) {
+	alerted := 0
+	if b.AlertedPeriod != nil && b.AlertedPeriod.Equal(periodStart) {
+		alerted = b.AlertedThreshold
+	}
+
+	reached := 0
+	for _, threshold := range b.Thresholds {
+		if spentUSD*100 >= b.LimitUSD*float64(threshold) {
+			reached = threshold
+		}
+	}
+
+	if reached <= alerted {
+		return 0, false
+	}
+
+	b.AlertedThreshold = reached
+	b.AlertedPeriod = &periodStart
+	return reached, true
+}
+
+// BudgetAlert is the payload of a BudgetThresholdReached event, it follows
+// the envelope of the workflow events delivered to webhooks.
+type BudgetAlert struct {
+	Type       WorkflowEventType `json:"type"`
+	ProjectID  uuid.UUID         `json:"projectId"`
+	WorkflowID WorkflowID        `json:"workflowId"`
+	TriggerID  uuid.UUID         `json:"triggerId"`
+	Data       BudgetAlertData   `json:"data"`
+}
+
+type BudgetAlertData struct {
+	BudgetID     uuid.UUID    `json:"budgetId"`
+	Scope        BudgetScope  `json:"scope"`
+	WorkflowID   WorkflowID   `json:"workflowId,omitempty"`
+	CredentialID *uuid.UUID   `json:"credentialId,omitempty"`
+	Period       BudgetPeriod `json:"period"`
+	PeriodStart  time.Time    `json:"periodStart"`
+	Threshold    int          `json:"threshold"`
+	LimitUSD     float64      `json:"limitUsd"`
+	SpentUSD     float64      `json:"spentUsd"`
+	Hard         bool         `json:"hard"`
+}
+
+// NewAlert builds the alert of a threshold reached by the execution of a workflow.
+func (b *Budget) NewAlert(
+	workflowID WorkflowID,
+	triggerID uuid.UUID,
+	threshold int,
+	spentUSD float64,
+	periodStart time.Time,
+) ([]byte, error) {
+	return json.Marshal(BudgetAlert{
+		Type:       BudgetThresholdReached,
+		ProjectID:  b.ProjectID,
+		WorkflowID: workflowID,
+		TriggerID:  triggerID,
+		Data: BudgetAlertData{
+			BudgetID:     b.ID,
+			Scope:        b.Scope,
+			WorkflowID:   b.WorkflowID,
+			CredentialID: b.CredentialID,
+			Period:       b.Period,
+			PeriodStart:  periodStart,
+			Threshold:    threshold,
+			LimitUSD:     b.LimitUSD,
+			SpentUSD:     spentUSD,
+			Hard:         b.Hard,
+		},
+	})
+}
+
+// CredentialIDs returns the credentials configured on the nodes of the workflow.
+func (w *Workflow) CredentialIDs() []uuid.UUID {
+	var ids []uuid.UUID
+	for _, node := range w.BuilderFlow.Nodes {
+		_, credentialID := nodeModelConfig(node.Data)
+		if credentialID != nil && !slices.Contains(ids, *credentialID) {
+			ids = append(ids, *credentialID)
+		}
+	}
+	return ids
+}
@@ -1,23 +1,126 @@
 package model
 
 import (
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/supallm/core/internal/pkg/errs"
 	"github.com/supallm/core/internal/pkg/secret"
 )
 
+type CredentialStatus string
+
+const (
+	CredentialUnverified CredentialStatus = "unverified"
+	CredentialVerified   CredentialStatus = "verified"
+	CredentialFailed     CredentialStatus = "failed"
+)
+
 type Credential struct {
 	ID           uuid.UUID
 	ProviderType ProviderType
 	Name         string
-	APIKey       secret.Encrypted
+	// APIKey is the primary secret of the payload, handed to the nodes as
+	// their API key. It is empty when the schema of the provider has none.
+	APIKey secret.Encrypted
+	// Payload is the JSON of the fields of the credential, encrypted as a whole.
+	// It is empty for the credentials stored before the payloads.
+	Payload secret.Encrypted `exhaustruct:"optional"`
+	// Status is the outcome of the last test of the credential against its
+	// provider, unverified until the credential is tested.
+	Status            CredentialStatus `exhaustruct:"optional"`
+	VerificationError string           `exhaustruct:"optional"`
+	LastVerifiedAt    *time.Time       `exhaustruct:"optional"`
+}
+
+// RecordVerification stores the outcome of a test of the credential.
+func (c *Credential) RecordVerification(verifyErr error, at time.Time) {
+	c.LastVerifiedAt = &at
+	if verifyErr != nil {
+		c.Status = CredentialFailed
+		c.VerificationError = verifyErr.Error()
+		return
+	}
+	c.Status = CredentialVerified
+	c.VerificationError = ""
+}
+
+// DecryptPayload returns the fields of the credential, secrets included.
+func (c *Credential) DecryptPayload() (map[string]any, error) {
+	if c.Payload == "" {
+		// credentials stored before the payloads hold an API key only
+		if c.APIKey == "" {
+			return map[string]any{}, nil
+		}
+		apiKey, err := c.APIKey.Decrypt()
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt api key: %w", err)
+		}
+		return map[string]any{CredentialAPIKeyField: apiKey.String()}, nil
+	}
+
+	decrypted, err := c.Payload.Decrypt()
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt payload: %w", err)
+	}
+
+	var payload map[string]any
+	if err = json.Unmarshal([]byte(decrypted), &payload); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal payload: %w", err)
+	}
+	return payload, nil
+}
+
+// Preview returns the fields of the credential with their secrets obfuscated.
+func (c *Credential) Preview() (map[string]any, error) {
+	payload, err := c.DecryptPayload()
+	if err != nil {
+		return nil, err
+	}
+	return CredentialSchemaOf(c.ProviderType).Preview(payload), nil
+}
+
+// setPayload validates the payload against the schema of the provider, then
+// encrypts it along with its primary secret. The new payload is untested.
+func (c *Credential) setPayload(payload map[string]any) error {
+	schema := CredentialSchemaOf(c.ProviderType)
+	checked, err := schema.Check(payload)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(checked)
+	if err != nil {
+		return err
+	}
+	encrypted, err := secret.APIKey(b).Encrypt()
+	if err != nil {
+		return err
+	}
+
+	apiKey := secret.Encrypted("")
+	if primary, ok := checked[schema.Primary].(string); ok {
+		apiKey, err = secret.APIKey(primary).Encrypt()
+		if err != nil {
+			return err
+		}
+	}
+
+	c.Payload = encrypted
+	c.APIKey = apiKey
+	c.Status = CredentialUnverified
+	c.VerificationError = ""
+	c.LastVerifiedAt = nil
+	return nil
 }
 
 func (p *Project) CreateCredential(
 	id uuid.UUID,
 	name string,
 	providerType ProviderType,
-	apiKey secret.APIKey,
+	payload map[string]any,
 ) (*Credential, error) {
 	if id == uuid.Nil {
 		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
@@ -31,37 +134,65 @@ func (p *Project) CreateCredential(
 		return nil, errs.InvalidError{Field: "providerType", Reason: "providerType is required"}
 	}
 
-	if apiKey == "" {
-		return nil, errs.InvalidError{Field: "apiKey", Reason: "apiKey is required"}
+	credential := &Credential{
+		ID:           id,
+		Name:         name,
+		ProviderType: providerType,
+		APIKey:       "",
 	}
-
-	encrypted, err := apiKey.Encrypt()
-	if err != nil {
+	if err := credential.setPayload(payload); err != nil {
 		return nil, err
 	}
 
-	credential := &Credential{ID: id, Name: name, ProviderType: providerType, APIKey: encrypted}
 	p.Credentials[credential.ID] = credential
 	return credential, nil
 }
 
-func (p *Project) UpdateCredential(id uuid.UUID, name string, apiKey secret.APIKey) error {
-	if name == "" {
-		return errs.InvalidError{Field: "name", Reason: "name is required"}
-	}
-
+// UpdateCredential renames the credential when the name is set, and applies
+// the changes to its payload: the fields set to nil are removed, the fields
+// left out are kept, so that secrets need not be entered again.
+func (p *Project) UpdateCredential(id uuid.UUID, name string, changes map[string]any) error {
 	credential, ok := p.Credentials[id]
 	if !ok {
 		return ErrCredentialNotFound
 	}
 
-	encrypted, err := apiKey.Encrypt()
-	if err != nil {
-		return err
+	if len(changes) > 0 {
+		payload, err := credential.DecryptPayload()
+		if err != nil {
+			return err
+		}
+
+		for field, value := range changes {
+			if value == nil {
+				delete(payload, field)
+				continue
+			}
+			payload[field] = value
+		}
+
+		if err = credential.setPayload(payload); err != nil {
+			return err
+		}
 	}
 
-	credential.Name = name
-	credential.APIKey = encrypted
+	if name != "" {
+		credential.Name = name
+	}
 
 	return nil
 }
+
+// auditSnapshot records the preview of the payload rather than its
+// ciphertext, which changes on every update.
+func (c *Credential) auditSnapshot() map[string]any {
+	snapshot := map[string]any{
+		"Name":         c.Name,
+		"ProviderType": c.ProviderType.String(),
+		"Status":       string(c.Status),
+	}
+	if preview, err := c.Preview(); err == nil {
+		snapshot["Payload"] = auditValue(preview)
+	}
+	return snapshot
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// CredentialReference is a node of a workflow using a credential: an LLM
+// node, the model of an agent or a tool.
+type CredentialReference struct {
+	CredentialID uuid.UUID
+	WorkflowID   WorkflowID
+	WorkflowName string
+	NodeID       string
+	NodeType     string
+}
+
+// CredentialReferences returns the nodes of the workflow using a credential,
+// in the order of the builder.
+func (w *Workflow) CredentialReferences() []CredentialReference {
+	var references []CredentialReference
+	for _, node := range w.BuilderFlow.Nodes {
+		_, credentialID := nodeModelConfig(node.Data)
+		if credentialID == nil {
+			continue
+		}
+		references = append(references, CredentialReference{
+			CredentialID: *credentialID,
+			WorkflowID:   w.ID,
+			WorkflowName: w.Name,
+			NodeID:       node.ID,
+			NodeType:     node.Type,
+		})
+	}
+	return references
+}
+
+// CredentialReferences returns the nodes of the workflows of the project
+// using the credential, by workflow then node.
+func (p *Project) CredentialReferences(credentialID uuid.UUID) []CredentialReference {
+	var references []CredentialReference
+	for _, workflow := range p.Workflows {
+		for _, reference := range workflow.CredentialReferences() {
+			if reference.CredentialID == credentialID {
+				references = append(references, reference)
+			}
+		}
+	}
+
+	slices.SortFunc(references, func(a, b CredentialReference) int {
+		if c := strings.Compare(a.WorkflowID.String(), b.WorkflowID.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.NodeID, b.NodeID)
+	})
+	return references
+}
+
+// ReplaceCredential points the nodes using a credential to its replacement,
+// in the given workflows or in all of them when none is given. The credential
+// replaced may already be removed, to repair the workflows still using it.
+func (p *Project) ReplaceCredential(
+	credentialID uuid.UUID,
+	replacementID uuid.UUID,
+	workflowIDs []WorkflowID,
+) ([]CredentialReference, error) {
+	if credentialID == replacementID {
+		return nil, errs.InvalidError{Field: "replacementId", Reason: "replacement must be another credential"}
+	}
+
+	replacement, ok := p.Credentials[replacementID]
+	if !ok {
+		return nil, errs.NotFoundError{Resource: "credential", ID: replacementID}
+	}
+
+	if credential, ok := p.Credentials[credentialID]; ok && credential.ProviderType != replacement.ProviderType {
+		return nil, errs.InvalidError{
+			Field:  "replacementId",
+			Reason: "replacement must be a credential of " + credential.ProviderType.String(),
+		}
+	}
+
+	for _, id := range workflowIDs {
+		if _, ok := p.Workflows[id]; !ok {
+			return nil, errs.NotFoundError{Resource: "workflow", ID: id}
+		}
+	}
+
+	var replaced []CredentialReference
+	for _, reference := range p.CredentialReferences(credentialID) {
+		if len(workflowIDs) > 0 && !slices.Contains(workflowIDs, reference.WorkflowID) {
+			continue
+		}
+
+		workflow := p.Workflows[reference.WorkflowID]
+		if err := workflow.replaceNodeCredential(reference.NodeID, replacementID); err != nil {
+			return nil, err
+		}
+		// the runner flow holds the key of the credential replaced
+		workflow.RunnerFlow = nil
+		replaced = append(replaced, reference)
+	}
+
+	return replaced, nil
+}
+
+func (w *Workflow) replaceNodeCredential(nodeID string, credentialID uuid.UUID) error {
+	i := slices.IndexFunc(w.BuilderFlow.Nodes, func(node BuilderNode) bool { return node.ID == nodeID })
+	if i < 0 {
+		return errs.NotFoundError{Resource: "node", ID: nodeID}
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(w.BuilderFlow.Nodes[i].Data, &data); err != nil {
+		return fmt.Errorf("unable to unmarshal node data: %w", err)
+	}
+	data["credentialId"] = credentialID.String()
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal node data: %w", err)
+	}
+	w.BuilderFlow.Nodes[i].Data = b
+	return nil
+}
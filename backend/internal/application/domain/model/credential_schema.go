@@ -0,0 +1,290 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+type CredentialFieldType string
+
+const (
+	CredentialFieldString  CredentialFieldType = "string"
+	CredentialFieldInteger CredentialFieldType = "integer"
+	CredentialFieldBoolean CredentialFieldType = "boolean"
+	// CredentialFieldHeaders holds an object of header names to values.
+	CredentialFieldHeaders CredentialFieldType = "headers"
+
+	// CredentialAPIKeyField is the field of the credentials made of a key only.
+	CredentialAPIKeyField = "apiKey"
+)
+
+type CredentialField struct {
+	Name     string
+	Type     CredentialFieldType
+	Required bool
+	// Secret fields are never returned, only a preview of their values.
+	Secret bool
+}
+
+// CredentialSchema lists the fields of the credentials of a provider.
+type CredentialSchema struct {
+	Fields []CredentialField
+	// Primary is the secret field handed to the nodes as their API key,
+	// empty when the nodes read the whole payload.
+	Primary string
+	// Payload tells whether the nodes read the whole payload, the others
+	// only get the primary secret.
+	Payload bool `exhaustruct:"optional"`
+	// Unsupported credentials are read by no node yet, the workflows using
+	// them are refused rather than run without the fields they need.
+	Unsupported bool `exhaustruct:"optional"`
+	// Validate checks the payload as a whole, once its fields are valid.
+	Validate func(payload map[string]any) error `exhaustruct:"optional"`
+}
+
+// credentialSchemas are the schemas by provider type. The providers without
+// a schema of their own take an API key.
+//
+//nolint:gochecknoglobals // registry of the provider schemas
+var credentialSchemas = map[ProviderType]CredentialSchema{
+	"azure": {
+		Fields: []CredentialField{
+			{Name: CredentialAPIKeyField, Type: CredentialFieldString, Required: true, Secret: true},
+			{Name: "endpoint", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: "deployment", Type: CredentialFieldString, Required: false, Secret: false},
+			{Name: "apiVersion", Type: CredentialFieldString, Required: false, Secret: false},
+		},
+		Primary:     CredentialAPIKeyField,
+		Unsupported: true,
+	},
+	"bedrock": {
+		Fields: []CredentialField{
+			{Name: "region", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: "accessKeyId", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: "secretAccessKey", Type: CredentialFieldString, Required: true, Secret: true},
+			{Name: "sessionToken", Type: CredentialFieldString, Required: false, Secret: true},
+		},
+		Primary:     "",
+		Unsupported: true,
+	},
+	"ollama": {
+		Fields: []CredentialField{
+			{Name: "baseUrl", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: CredentialAPIKeyField, Type: CredentialFieldString, Required: false, Secret: true},
+		},
+		Primary: CredentialAPIKeyField,
+		Payload: true,
+	},
+	"confluence": {
+		Fields: []CredentialField{
+			{Name: "baseUrl", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: "email", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: CredentialAPIKeyField, Type: CredentialFieldString, Required: true, Secret: true},
+		},
+		Primary: CredentialAPIKeyField,
+	},
+	"postgres": {
+		Fields: []CredentialField{
+			// url is the connection URL, used instead of the other fields
+			{Name: "url", Type: CredentialFieldString, Required: false, Secret: true},
+			{Name: "host", Type: CredentialFieldString, Required: false, Secret: false},
+			{Name: "port", Type: CredentialFieldInteger, Required: false, Secret: false},
+			{Name: "database", Type: CredentialFieldString, Required: false, Secret: false},
+			{Name: "user", Type: CredentialFieldString, Required: false, Secret: false},
+			{Name: "password", Type: CredentialFieldString, Required: false, Secret: true},
+			{Name: "sslMode", Type: CredentialFieldString, Required: false, Secret: false},
+		},
+		Primary: "url",
+		Payload: true,
+		Validate: func(payload map[string]any) error {
+			if payload["url"] == nil && payload["host"] == nil {
+				return errs.InvalidError{Field: "url", Reason: "url or host is required"}
+			}
+			return nil
+		},
+	},
+	"http": {
+		Fields: []CredentialField{
+			{Name: "baseUrl", Type: CredentialFieldString, Required: true, Secret: false},
+			{Name: "headers", Type: CredentialFieldHeaders, Required: false, Secret: true},
+		},
+		Primary: "",
+		Payload: true,
+	},
+}
+
+// RegisterCredentialSchema adds or replaces the schema of a provider type.
+func RegisterCredentialSchema(providerType ProviderType, schema CredentialSchema) {
+	credentialSchemas[providerType] = schema
+}
+
+// CredentialSchemaOf returns the schema of the credentials of a provider.
+func CredentialSchemaOf(providerType ProviderType) CredentialSchema {
+	if schema, ok := credentialSchemas[providerType]; ok {
+		return schema
+	}
+	return CredentialSchema{
+		Fields: []CredentialField{
+			{Name: CredentialAPIKeyField, Type: CredentialFieldString, Required: true, Secret: true},
+		},
+		Primary: CredentialAPIKeyField,
+	}
+}
+
+func (s CredentialSchema) field(name string) (CredentialField, bool) {
+	i := slices.IndexFunc(s.Fields, func(f CredentialField) bool { return f.Name == name })
+	if i < 0 {
+		return CredentialField{}, false
+	}
+	return s.Fields[i], true
+}
+
+// Check validates the payload against the schema: unknown fields are
+// rejected and the values are normalized to the types of their fields.
+func (s CredentialSchema) Check(payload map[string]any) (map[string]any, error) {
+	checked := make(map[string]any, len(payload))
+	for name, value := range payload {
+		field, ok := s.field(name)
+		if !ok {
+			return nil, errs.InvalidError{Field: name, Reason: "unknown field"}
+		}
+
+		normalized, err := field.normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		if normalized != nil {
+			checked[name] = normalized
+		}
+	}
+
+	for _, field := range s.Fields {
+		if field.Required && checked[field.Name] == nil {
+			return nil, errs.InvalidError{Field: field.Name, Reason: field.Name + " is required"}
+		}
+	}
+
+	if s.Validate != nil {
+		if err := s.Validate(checked); err != nil {
+			return nil, err
+		}
+	}
+	return checked, nil
+}
+
+// normalize returns the value in the type of the field, nil for empty values.
+func (f CredentialField) normalize(value any) (any, error) {
+	invalid := errs.InvalidError{Field: f.Name, Reason: f.Name + " must be of type " + string(f.Type)}
+
+	switch f.Type {
+	case CredentialFieldString:
+		if value == nil {
+			return nil, nil
+		}
+		s, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		if s == "" {
+			return nil, nil
+		}
+		return s, nil
+	case CredentialFieldInteger:
+		switch v := value.(type) {
+		case nil:
+			return nil, nil
+		case int:
+			return v, nil
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, invalid
+			}
+			return int(v), nil
+		case json.Number:
+			i, err := v.Int64()
+			if err != nil {
+				return nil, invalid
+			}
+			return int(i), nil
+		default:
+			return nil, invalid
+		}
+	case CredentialFieldBoolean:
+		if value == nil {
+			return nil, nil
+		}
+		b, ok := value.(bool)
+		if !ok {
+			return nil, invalid
+		}
+		return b, nil
+	case CredentialFieldHeaders:
+		return normalizeHeaders(value, invalid)
+	default:
+		return nil, fmt.Errorf("unsupported credential field type %s", f.Type)
+	}
+}
+
+func normalizeHeaders(value any, invalid error) (any, error) {
+	var headers map[string]string
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case map[string]string:
+		headers = v
+	case map[string]any:
+		headers = make(map[string]string, len(v))
+		for name, h := range v {
+			s, ok := h.(string)
+			if !ok {
+				return nil, invalid
+			}
+			headers[name] = s
+		}
+	default:
+		return nil, invalid
+	}
+
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	return headers, nil
+}
+
+// Preview returns the payload with the values of the secret fields obfuscated.
+func (s CredentialSchema) Preview(payload map[string]any) map[string]any {
+	preview := make(map[string]any, len(payload))
+	for name, value := range payload {
+		field, ok := s.field(name)
+		if !ok || !field.Secret {
+			preview[name] = value
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			preview[name] = secret.APIKey(v).Obfuscate()
+		case map[string]string:
+			obfuscated := make(map[string]string, len(v))
+			for key, h := range v {
+				obfuscated[key] = secret.APIKey(h).Obfuscate()
+			}
+			preview[name] = obfuscated
+		case map[string]any:
+			obfuscated := make(map[string]any, len(v))
+			for key, h := range v {
+				text, _ := h.(string)
+				obfuscated[key] = secret.APIKey(text).Obfuscate()
+			}
+			preview[name] = obfuscated
+		default:
+			preview[name] = "**"
+		}
+	}
+	return preview
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const MaxDatasetCases = MaxBatchItems
+
+// Dataset is a named collection of workflow inputs, with the outputs expected
+// from them when known, evaluation runs execute a workflow across it.
+type Dataset struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	Name        string
+	Description string
+}
+
+// DatasetCase is an input set of a dataset, Expected is nil when unknown.
+type DatasetCase struct {
+	Inputs   map[string]any
+	Expected any
+}
+
+func NewDataset(id uuid.UUID, projectID uuid.UUID, name string, description string) (*Dataset, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	dataset := &Dataset{
+		ID:          id,
+		ProjectID:   projectID,
+		Name:        "",
+		Description: "",
+	}
+
+	if err := dataset.Update(name, description); err != nil {
+		return nil, err
+	}
+
+	return dataset, nil
+}
+
+func (d *Dataset) Update(name string, description string) error {
+	if name == "" {
+		return errs.InvalidError{Field: "name", Reason: "name is required"}
+	}
+
+	d.Name = name
+	d.Description = description
+	return nil
+}
+
+// ValidateDatasetCases checks the cases added to a dataset holding count cases.
+func ValidateDatasetCases(count int, cases []DatasetCase) error {
+	if len(cases) == 0 {
+		return errs.InvalidError{Field: "cases", Reason: "at least one case is required"}
+	}
+
+	if count+len(cases) > MaxDatasetCases {
+		return errs.InvalidError{
+			Field:  "cases",
+			Reason: "a dataset is limited to " + strconv.Itoa(MaxDatasetCases) + " cases",
+		}
+	}
+
+	for i, c := range cases {
+		if c.Inputs == nil {
+			return errs.InvalidError{Field: "cases", Reason: "case " + strconv.Itoa(i) + " has no inputs"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package model
+
+// EndUser is a user of the application built on a project, authenticated
+// by a token issued by the auth provider of the project.
+type EndUser struct {
+	ID    string
+	Email string
+}
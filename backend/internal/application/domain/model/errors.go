@@ -1,5 +1,7 @@
 package model
 
+import "github.com/google/uuid"
+
 type Error string
 
 func (e Error) Error() string {
@@ -32,3 +34,17 @@ const (
 
 	ErrInvalidNodeError Error = "invalid node type"
 )
+
+// CredentialNotFoundError names the missing credential of a workflow,
+// it matches ErrCredentialNotFound.
+type CredentialNotFoundError struct {
+	ID uuid.UUID
+}
+
+func (e CredentialNotFoundError) Error() string {
+	return ErrCredentialNotFound.Error() + ": " + e.ID.String()
+}
+
+func (e CredentialNotFoundError) Unwrap() error {
+	return ErrCredentialNotFound
+}
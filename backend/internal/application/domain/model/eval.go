@@ -0,0 +1,225 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type EvalRunStatus string
+
+const (
+	EvalRunRunning   EvalRunStatus = "running"
+	EvalRunCompleted EvalRunStatus = "completed"
+)
+
+// EvalRun executes a revision of a workflow across a dataset, through a batch,
+// and scores the results once the batch is completed.
+type EvalRun struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	WorkflowID WorkflowID
+	DatasetID  uuid.UUID
+	BatchID    uuid.UUID
+	Revision   string
+	Scorers    []ScorerConfig
+	Status     EvalRunStatus
+	Metrics    *EvalMetrics
+	// Expected snapshots the expected outputs of the dataset cases when the run is created.
+	Expected []any
+}
+
+// EvalCase is the outcome of a dataset case in a run.
+type EvalCase struct {
+	Index    int
+	Inputs   map[string]any
+	Expected any
+	Status   BatchItemStatus
+	Result   any
+	Error    string
+	Duration time.Duration
+	// Scores holds the score of each scorer by name, scorers unable to score the case are left out.
+	Scores map[string]float64
+	Passed bool
+}
+
+// EvalMetrics aggregates the scores of a run.
+type EvalMetrics struct {
+	Cases         int                      `json:"cases"`
+	Succeeded     int                      `json:"succeeded"`
+	Failed        int                      `json:"failed"`
+	Passed        int                      `json:"passed"`
+	PassRate      float64                  `json:"passRate"`
+	AvgDurationMs float64                  `json:"avgDurationMs"`
+	Scorers       map[string]ScorerMetrics `json:"scorers"`
+}
+
+type ScorerMetrics struct {
+	Scored   int     `json:"scored"`
+	Mean     float64 `json:"mean"`
+	PassRate float64 `json:"passRate"`
+}
+
+func NewEvalRun(
+	id uuid.UUID,
+	projectID uuid.UUID,
+	workflow *Workflow,
+	datasetID uuid.UUID,
+	batchID uuid.UUID,
+	scorers []ScorerConfig,
+	cases []DatasetCase,
+) (*EvalRun, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if len(cases) == 0 {
+		return nil, errs.InvalidError{Field: "datasetId", Reason: "dataset has no cases"}
+	}
+
+	if err := validateScorers(scorers); err != nil {
+		return nil, err
+	}
+
+	expected := make([]any, len(cases))
+	for i, c := range cases {
+		expected[i] = c.Expected
+	}
+
+	return &EvalRun{
+		ID:         id,
+		ProjectID:  projectID,
+		WorkflowID: workflow.ID,
+		DatasetID:  datasetID,
+		BatchID:    batchID,
+		Revision:   workflow.Revision(),
+		Scorers:    scorers,
+		Status:     EvalRunRunning,
+		Metrics:    nil,
+		Expected:   expected,
+	}, nil
+}
+
+func validateScorers(scorers []ScorerConfig) error {
+	if len(scorers) == 0 {
+		return errs.InvalidError{Field: "scorers", Reason: "at least one scorer is required"}
+	}
+
+	names := make(map[string]bool, len(scorers))
+	for _, config := range scorers {
+		if config.Name == "" {
+			return errs.InvalidError{Field: "scorers", Reason: "scorer name is required"}
+		}
+
+		if names[config.Name] {
+			return errs.InvalidError{Field: "scorers", Reason: "duplicate scorer name " + config.Name}
+		}
+		names[config.Name] = true
+
+		if _, err := NewScorer(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Score scores the finished cases of the run and completes it. Failed
+// executions score 0 with every scorer.
+func (r *EvalRun) Score(cases []*EvalCase) error {
+	scorers := make([]Scorer, len(r.Scorers))
+	for i, config := range r.Scorers {
+		scorer, err := NewScorer(config)
+		if err != nil {
+			return err
+		}
+		scorers[i] = scorer
+	}
+
+	for _, c := range cases {
+		c.Scores = make(map[string]float64, len(scorers))
+		c.Passed = c.Status == BatchItemSucceeded
+
+		for i, scorer := range scorers {
+			config := r.Scorers[i]
+			if c.Status != BatchItemSucceeded {
+				c.Scores[config.Name] = 0
+				continue
+			}
+
+			score, err := scorer.Score(config.value(c.Result), c.Expected)
+			if errors.Is(err, ErrNoExpectedOutput) {
+				continue
+			}
+			if err != nil {
+				score = 0
+			}
+
+			c.Scores[config.Name] = score
+			if score < config.threshold() {
+				c.Passed = false
+			}
+		}
+	}
+
+	r.Metrics = r.aggregate(cases)
+	r.Status = EvalRunCompleted
+	return nil
+}
+
+func (r *EvalRun) aggregate(cases []*EvalCase) *EvalMetrics {
+	metrics := &EvalMetrics{
+		Cases:         len(cases),
+		Succeeded:     0,
+		Failed:        0,
+		Passed:        0,
+		PassRate:      0,
+		AvgDurationMs: 0,
+		Scorers:       make(map[string]ScorerMetrics, len(r.Scorers)),
+	}
+
+	var duration time.Duration
+	for _, c := range cases {
+		if c.Status == BatchItemSucceeded {
+			metrics.Succeeded++
+		} else {
+			metrics.Failed++
+		}
+
+		if c.Passed {
+			metrics.Passed++
+		}
+		duration += c.Duration
+	}
+
+	if len(cases) > 0 {
+		metrics.PassRate = float64(metrics.Passed) / float64(len(cases))
+		metrics.AvgDurationMs = float64(duration.Milliseconds()) / float64(len(cases))
+	}
+
+	for _, config := range r.Scorers {
+		m := ScorerMetrics{Scored: 0, Mean: 0, PassRate: 0}
+		passed := 0
+		for _, c := range cases {
+			score, ok := c.Scores[config.Name]
+			if !ok {
+				continue
+			}
+
+			m.Scored++
+			m.Mean += score
+			if score >= config.threshold() {
+				passed++
+			}
+		}
+
+		if m.Scored > 0 {
+			m.Mean /= float64(m.Scored)
+			m.PassRate = float64(passed) / float64(m.Scored)
+		}
+		metrics.Scorers[config.Name] = m
+	}
+
+	return metrics
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExecutionStatus string
+
+const (
+	ExecutionRunning   ExecutionStatus = "running"
+	ExecutionSucceeded ExecutionStatus = "succeeded"
+	ExecutionFailed    ExecutionStatus = "failed"
+)
+
+// Execution records a trigger of a workflow with the variant that ran it,
+// VariantID is the workflow ID itself when the workflow has no traffic split,
+// EndUserID is empty unless the trigger was made by an authenticated end-user.
+type Execution struct {
+	TriggerID  uuid.UUID
+	ProjectID  uuid.UUID
+	WorkflowID WorkflowID
+	SessionID  uuid.UUID
+	EndUserID  string
+	VariantID  WorkflowID
+	Revision   string
+	Status     ExecutionStatus
+	Error      string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+func NewExecution(
+	triggerID uuid.UUID,
+	sessionID uuid.UUID,
+	endUserID string,
+	workflowID WorkflowID,
+	variant *Workflow,
+) *Execution {
+	return &Execution{
+		TriggerID:  triggerID,
+		ProjectID:  variant.ProjectID,
+		WorkflowID: workflowID,
+		SessionID:  sessionID,
+		EndUserID:  endUserID,
+		VariantID:  variant.ID,
+		Revision:   variant.Revision(),
+		Status:     ExecutionRunning,
+		Error:      "",
+		StartedAt:  time.Now(),
+		FinishedAt: nil,
+	}
+}
+
+// Finish records the terminal event of the execution.
+func (e *Execution) Finish(eventType WorkflowEventType, data map[string]any) {
+	now := time.Now()
+	e.FinishedAt = &now
+
+	switch eventType {
+	case WorkflowCompleted:
+		e.Status = ExecutionSucceeded
+	case WorkflowCancelled:
+		e.Status = ExecutionFailed
+		e.Error = "workflow cancelled"
+	default:
+		e.Status = ExecutionFailed
+		e.Error, _ = data[errorEventKey].(string)
+	}
+}
+
+// Fail records an execution that could not be queued.
+func (e *Execution) Fail(err error) {
+	now := time.Now()
+	e.FinishedAt = &now
+	e.Status = ExecutionFailed
+	e.Error = err.Error()
+}
+
+// ListenableBy reports whether the end-user can listen to the events of the
+// execution, anonymous executions are only listenable by anonymous listeners
+// and the executions of authenticated end-users by themselves.
+func (e *Execution) ListenableBy(endUserID string) bool {
+	return e.EndUserID == endUserID
+}
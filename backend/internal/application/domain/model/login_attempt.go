@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// LoginThrottle slows down the logins failing repeatedly for a same account
+// or address, then locks them out for a while.
+type LoginThrottle struct {
+	Scope string
+	// FreeFailures are let through, each further failure doubles the delay
+	// before the next attempt, from BaseDelay up to MaxDelay.
+	FreeFailures int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	// LockoutFailures lock the logins out for LockoutDuration.
+	LockoutFailures int
+	LockoutDuration time.Duration
+}
+
+//nolint:gochecknoglobals // login policies
+var (
+	AccountLoginThrottle = LoginThrottle{
+		Scope:           "account",
+		FreeFailures:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutFailures: 10,
+		LockoutDuration: 15 * time.Minute,
+	}
+	IPLoginThrottle = LoginThrottle{
+		Scope:           "ip",
+		FreeFailures:    20,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutFailures: 100,
+		LockoutDuration: 15 * time.Minute,
+	}
+)
+
+// LoginAttempts counts the failed logins of an account or an address,
+// the count is forgotten once Retention passed without failure.
+type LoginAttempts struct {
+	Failures      int
+	LastFailureAt time.Time
+}
+
+// Retention is how long the failures are counted after the last one.
+func (t LoginThrottle) Retention() time.Duration {
+	return t.LockoutDuration
+}
+
+// Check rejects a login until the delay following the last failure passed,
+// or while the lockout lasts.
+func (t LoginThrottle) Check(attempts LoginAttempts, now time.Time) error {
+	if attempts.Failures >= t.LockoutFailures {
+		if wait := attempts.LastFailureAt.Add(t.LockoutDuration).Sub(now); wait > 0 {
+			return errs.LockedError{Scope: t.Scope, RetryAfter: wait}
+		}
+		return nil
+	}
+
+	if wait := attempts.LastFailureAt.Add(t.delay(attempts.Failures)).Sub(now); wait > 0 {
+		return errs.RateLimitedError{Scope: "login", RetryAfter: wait}
+	}
+	return nil
+}
+
+// LocksOut tells whether the failures reached the lockout.
+func (t LoginThrottle) LocksOut(attempts LoginAttempts) bool {
+	return attempts.Failures >= t.LockoutFailures
+}
+
+func (t LoginThrottle) delay(failures int) time.Duration {
+	if failures <= t.FreeFailures {
+		return 0
+	}
+
+	delay := t.BaseDelay
+	for range failures - t.FreeFailures - 1 {
+		delay *= 2
+		if delay >= t.MaxDelay {
+			return t.MaxDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+const (
+	// MFAIssuer names the accounts in the authenticator apps.
+	MFAIssuer = "Supallm"
+
+	recoveryCodeCount = 10
+)
+
+// TOTP is the authenticator app of a user, asked for a code on login once confirmed.
+type TOTP struct {
+	Secret    secret.Encrypted
+	EnabledAt *time.Time
+	// LastStep is the time step of the last code accepted, each code is used once.
+	LastStep int64
+	// RecoveryCodeDigests are the recovery codes left, each one standing in for a code once.
+	RecoveryCodeDigests []string
+}
+
+// SecondFactor is the code used up by a login, the time step of a code of
+// the app or else the digest of a recovery code.
+type SecondFactor struct {
+	TOTPStep           int64
+	RecoveryCodeDigest string
+}
+
+// TOTPEnrollment is handed to the user to register the secret in their app.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+func (u *User) MFAEnabled() bool {
+	return u.TOTP != nil && u.TOTP.EnabledAt != nil
+}
+
+// EnrollTOTP generates the secret of the user, enabled once confirmed with a code.
+// Enrolling again replaces a secret not confirmed yet.
+func (u *User) EnrollTOTP() (TOTPEnrollment, error) {
+	if u.MFAEnabled() {
+		return TOTPEnrollment{}, errs.InvalidError{Reason: "two-factor authentication is already enabled"}
+	}
+
+	totpSecret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+
+	encrypted, err := secret.APIKey(totpSecret).Encrypt()
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+
+	u.TOTP = &TOTP{
+		Secret:              encrypted,
+		EnabledAt:           nil,
+		LastStep:            0,
+		RecoveryCodeDigests: nil,
+	}
+
+	return TOTPEnrollment{
+		Secret: totpSecret,
+		URI:    auth.TOTPURI(totpSecret, MFAIssuer, u.Email),
+	}, nil
+}
+
+// ConfirmTOTP enables the enrolled secret with a code of the app,
+// the recovery codes are returned once.
+func (u *User) ConfirmTOTP(code string, now time.Time) ([]auth.OneTimeToken, error) {
+	if u.TOTP == nil {
+		return nil, errs.InvalidError{Reason: "two-factor authentication is not enrolled"}
+	}
+
+	if u.MFAEnabled() {
+		return nil, errs.InvalidError{Reason: "two-factor authentication is already enabled"}
+	}
+
+	if err := u.verifyTOTP(code, now); err != nil {
+		return nil, errs.InvalidError{Field: "code", Reason: "invalid code", Err: err}
+	}
+
+	u.TOTP.EnabledAt = &now
+	return u.generateRecoveryCodes()
+}
+
+// VerifySecondFactor checks a code of the app or a recovery code, both used once.
+// The factor returned is the code to use up.
+func (u *User) VerifySecondFactor(code string, now time.Time) (SecondFactor, error) {
+	if !u.MFAEnabled() {
+		return SecondFactor{}, errs.InvalidError{Reason: "two-factor authentication is not enabled"}
+	}
+
+	if u.verifyTOTP(code, now) == nil {
+		return SecondFactor{TOTPStep: u.TOTP.LastStep, RecoveryCodeDigest: ""}, nil
+	}
+
+	digest := auth.NormalizeRecoveryCode(code).Digest()
+	if i := slices.Index(u.TOTP.RecoveryCodeDigests, digest); i >= 0 {
+		u.TOTP.RecoveryCodeDigests = slices.Delete(u.TOTP.RecoveryCodeDigests, i, i+1)
+		return SecondFactor{TOTPStep: 0, RecoveryCodeDigest: digest}, nil
+	}
+
+	return SecondFactor{}, errs.UnauthorizedError{Err: errors.New("invalid two-factor code")}
+}
+
+// DisableTOTP turns two-factor authentication off, proven with a code.
+func (u *User) DisableTOTP(code string, now time.Time) error {
+	if err := u.proveSecondFactor(code, now); err != nil {
+		return err
+	}
+
+	u.ResetMFA()
+	return nil
+}
+
+// ResetMFA turns two-factor authentication off, such as for a user who lost their app.
+func (u *User) ResetMFA() {
+	u.TOTP = nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes, proven with a code.
+func (u *User) RegenerateRecoveryCodes(code string, now time.Time) ([]auth.OneTimeToken, error) {
+	if err := u.proveSecondFactor(code, now); err != nil {
+		return nil, err
+	}
+
+	return u.generateRecoveryCodes()
+}
+
+// proveSecondFactor verifies the code of an authenticated user, a wrong
+// code is an invalid request rather than a failed authentication.
+func (u *User) proveSecondFactor(code string, now time.Time) error {
+	_, err := u.VerifySecondFactor(code, now)
+	var unauthorized errs.UnauthorizedError
+	if errors.As(err, &unauthorized) {
+		return errs.InvalidError{Field: "code", Reason: "invalid code", Err: err}
+	}
+	return err
+}
+
+func (u *User) verifyTOTP(code string, now time.Time) error {
+	totpSecret, err := u.TOTP.Secret.Decrypt()
+	if err != nil {
+		return err
+	}
+
+	step, ok := auth.VerifyTOTP(totpSecret.String(), code, now, u.TOTP.LastStep)
+	if !ok {
+		return errors.New("invalid code")
+	}
+
+	u.TOTP.LastStep = step
+	return nil
+}
+
+func (u *User) generateRecoveryCodes() ([]auth.OneTimeToken, error) {
+	codes := make([]auth.OneTimeToken, recoveryCodeCount)
+	digests := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := auth.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		digests[i] = code.Digest()
+	}
+
+	u.TOTP.RecoveryCodeDigests = digests
+	return codes, nil
+}
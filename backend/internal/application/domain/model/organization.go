@@ -0,0 +1,83 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// Organization shares its projects with its members, according to their role.
+type Organization struct {
+	ID      uuid.UUID
+	Name    string
+	Members map[uuid.UUID]Role
+}
+
+// NewOrganization creates an organization owned by its creator.
+func NewOrganization(id uuid.UUID, name string, ownerID uuid.UUID) (*Organization, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errs.InvalidError{Field: "name", Reason: "name is required"}
+	}
+
+	if ownerID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "ownerId", Reason: "owner is required"}
+	}
+
+	return &Organization{
+		ID:      id,
+		Name:    name,
+		Members: map[uuid.UUID]Role{ownerID: RoleOwner},
+	}, nil
+}
+
+func (o *Organization) RoleOf(userID uuid.UUID) (Role, bool) {
+	role, ok := o.Members[userID]
+	return role, ok
+}
+
+// SetMember adds a member or changes their role, an organization
+// always keeps an owner.
+func (o *Organization) SetMember(userID uuid.UUID, role Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	if role != RoleOwner && o.isLastOwner(userID) {
+		return errs.InvalidError{Field: "role", Reason: "an organization must keep an owner"}
+	}
+
+	o.Members[userID] = role
+	return nil
+}
+
+func (o *Organization) RemoveMember(userID uuid.UUID) error {
+	if _, ok := o.Members[userID]; !ok {
+		return errs.NotFoundError{Resource: "member", ID: userID}
+	}
+
+	if o.isLastOwner(userID) {
+		return errs.InvalidError{Field: "userId", Reason: "an organization must keep an owner"}
+	}
+
+	delete(o.Members, userID)
+	return nil
+}
+
+func (o *Organization) isLastOwner(userID uuid.UUID) bool {
+	if o.Members[userID] != RoleOwner {
+		return false
+	}
+
+	for id, role := range o.Members {
+		if id != userID && role == RoleOwner {
+			return false
+		}
+	}
+	return true
+}
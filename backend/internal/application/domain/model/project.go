@@ -6,16 +6,18 @@ import (
 )
 
 type Project struct {
-	ID           uuid.UUID
-	UserID       string
-	Name         string
-	AuthProvider AuthProvider
-	Credentials  map[uuid.UUID]*Credential
-	Workflows    map[WorkflowID]*Workflow
-	APIKeys      []*APIKey
+	ID     uuid.UUID
+	UserID string
+	// OrganizationID shares the project with the members of the organization.
+	OrganizationID *uuid.UUID
+	Name           string
+	AuthProvider   AuthProvider
+	Credentials    map[uuid.UUID]*Credential
+	Workflows      map[WorkflowID]*Workflow
+	APIKeys        []*APIKey
 }
 
-func NewProject(id uuid.UUID, userID string, name string) (*Project, error) {
+func NewProject(id uuid.UUID, userID string, name string, organizationID *uuid.UUID) (*Project, error) {
 	if id == uuid.Nil {
 		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
 	}
@@ -29,13 +31,14 @@ func NewProject(id uuid.UUID, userID string, name string) (*Project, error) {
 	}
 
 	p := &Project{
-		ID:           id,
-		UserID:       userID,
-		Name:         name,
-		AuthProvider: nil,
-		Credentials:  map[uuid.UUID]*Credential{},
-		Workflows:    map[WorkflowID]*Workflow{},
-		APIKeys:      make([]*APIKey, 0),
+		ID:             id,
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Name:           name,
+		AuthProvider:   nil,
+		Credentials:    map[uuid.UUID]*Credential{},
+		Workflows:      map[WorkflowID]*Workflow{},
+		APIKeys:        make([]*APIKey, 0),
 	}
 
 	err := p.addAPIKey()
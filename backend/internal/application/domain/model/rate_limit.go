@@ -0,0 +1,143 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// RateLimit caps the triggers of a project, or of one of its workflows when
+// WorkflowID is set, in requests per minute. The project limit is shared by
+// all the callers while the API key and end-user limits are counted for each
+// of them. A limit of 0 leaves the triggers unlimited on that key, Burst
+// defaults to the limit of each key.
+type RateLimit struct {
+	ProjectID                uuid.UUID
+	WorkflowID               WorkflowID
+	RequestsPerMinute        int
+	APIKeyRequestsPerMinute  int
+	EndUserRequestsPerMinute int
+	Burst                    int
+}
+
+func NewRateLimit(
+	projectID uuid.UUID,
+	workflowID WorkflowID,
+	requestsPerMinute int,
+	apiKeyRequestsPerMinute int,
+	endUserRequestsPerMinute int,
+	burst int,
+) (*RateLimit, error) {
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	if requestsPerMinute < 0 || apiKeyRequestsPerMinute < 0 || endUserRequestsPerMinute < 0 {
+		return nil, errs.InvalidError{Field: "requestsPerMinute", Reason: "limits cannot be negative"}
+	}
+
+	if requestsPerMinute == 0 && apiKeyRequestsPerMinute == 0 && endUserRequestsPerMinute == 0 {
+		return nil, errs.InvalidError{Field: "requestsPerMinute", Reason: "at least one limit is required"}
+	}
+
+	if burst < 0 {
+		return nil, errs.InvalidError{Field: "burst", Reason: "burst cannot be negative"}
+	}
+
+	return &RateLimit{
+		ProjectID:                projectID,
+		WorkflowID:               workflowID,
+		RequestsPerMinute:        requestsPerMinute,
+		APIKeyRequestsPerMinute:  apiKeyRequestsPerMinute,
+		EndUserRequestsPerMinute: endUserRequestsPerMinute,
+		Burst:                    burst,
+	}, nil
+}
+
+// TriggerCaller identifies who a trigger is counted against: the API key it
+// was made with, the end-user it was made for or else the address it came
+// from. The triggers made by the platform, such as schedules and batches,
+// have no caller and are only counted on the shared limit.
+// Client is set for the triggers made by the clients of the project rather
+// than by the dashboard or the platform.
+type TriggerCaller struct {
+	APIKey    string
+	EndUserID string
+	IP        string
+	Client    bool
+}
+
+// RateBucket is a token bucket counted on Key: it holds up to Burst triggers
+// and is refilled with PerMinute triggers a minute. Scope names the limit in
+// the problem returned once the bucket is empty.
+type RateBucket struct {
+	Key       string
+	Scope     string
+	PerMinute int
+	Burst     int
+}
+
+// RateDecision tells whether a trigger is allowed, else the wait before the
+// bucket rejecting it holds a trigger again. Bucket is the most restrictive
+// bucket, Remaining the triggers it holds and Reset the wait before it is full.
+type RateDecision struct {
+	Allowed    bool
+	Bucket     RateBucket    `exhaustruct:"optional"`
+	Remaining  int           `exhaustruct:"optional"`
+	RetryAfter time.Duration `exhaustruct:"optional"`
+	Reset      time.Duration `exhaustruct:"optional"`
+}
+
+// Buckets returns the buckets a trigger of the workflow by the caller is
+// counted against: one shared by the callers of the project or workflow,
+// one per API key and one per end-user, anonymous callers by address.
+func (l *RateLimit) Buckets(workflowID WorkflowID, caller TriggerCaller) []RateBucket {
+	prefix := l.ProjectID.String()
+	scope := "project"
+	if l.WorkflowID != "" {
+		prefix += ":" + workflowID.String()
+		scope = "workflow"
+	}
+
+	var buckets []RateBucket
+	if l.RequestsPerMinute > 0 {
+		buckets = append(buckets, l.bucket(prefix, scope, l.RequestsPerMinute))
+	}
+
+	if caller.APIKey != "" && l.APIKeyRequestsPerMinute > 0 {
+		key := prefix + ":key:" + keyDigest(caller.APIKey)
+		buckets = append(buckets, l.bucket(key, "api key", l.APIKeyRequestsPerMinute))
+	}
+
+	if l.EndUserRequestsPerMinute > 0 {
+		var key string
+		switch {
+		case caller.EndUserID != "":
+			key = prefix + ":user:" + keyDigest(caller.EndUserID)
+		case caller.IP != "":
+			key = prefix + ":ip:" + keyDigest(caller.IP)
+		}
+		if key != "" {
+			buckets = append(buckets, l.bucket(key, "end user", l.EndUserRequestsPerMinute))
+		}
+	}
+
+	return buckets
+}
+
+func (l *RateLimit) bucket(key string, scope string, perMinute int) RateBucket {
+	burst := perMinute
+	if l.Burst > 0 {
+		burst = l.Burst
+	}
+	return RateBucket{Key: key, Scope: scope, PerMinute: perMinute, Burst: burst}
+}
+
+// keyDigest keeps secrets and user identifiers out of the limiter keys.
+func keyDigest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:12])
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// Role is the access of a user to the projects of an organization,
+// or to a single project.
+type Role string
+
+const (
+	// RoleOwner manages the project and its members.
+	RoleOwner Role = "owner"
+	// RoleEditor edits the workflows, credentials and settings of the project.
+	RoleEditor Role = "editor"
+	// RoleRunner triggers the workflows of the project.
+	RoleRunner Role = "runner"
+	// RoleViewer reads the workflows and executions of the project.
+	RoleViewer Role = "viewer"
+)
+
+// Permission is an action on a project granted from a minimal role.
+type Permission string
+
+const (
+	PermissionView            Permission = "view"
+	PermissionRun             Permission = "run"
+	PermissionReadCredentials Permission = "read_credentials"
+	PermissionEdit            Permission = "edit"
+	PermissionManage          Permission = "manage"
+)
+
+//nolint:gochecknoglobals // ranks of the roles, higher roles include the lower ones
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleRunner: 2,
+	RoleEditor: 3,
+	RoleOwner:  4,
+}
+
+//nolint:gochecknoglobals // minimal role granting each permission
+var permissionRoles = map[Permission]Role{
+	PermissionView:            RoleViewer,
+	PermissionRun:             RoleRunner,
+	PermissionReadCredentials: RoleEditor,
+	PermissionEdit:            RoleEditor,
+	PermissionManage:          RoleOwner,
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) Validate() error {
+	if _, ok := roleRanks[r]; !ok {
+		return errs.InvalidError{Field: "role", Reason: "role must be one of owner, editor, runner or viewer"}
+	}
+	return nil
+}
+
+// Can tells whether the role grants the permission.
+func (r Role) Can(permission Permission) bool {
+	required, ok := permissionRoles[permission]
+	if !ok {
+		return false
+	}
+	return roleRanks[r] >= roleRanks[required]
+}
+
+// HighestRole returns the highest of the roles a user holds on a project,
+// through the project itself, its organization or as its creator.
+func HighestRole(roles []Role) (Role, bool) {
+	var highest Role
+	for _, role := range roles {
+		if roleRanks[role] > roleRanks[highest] {
+			highest = role
+		}
+	}
+	return highest, highest != ""
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const defaultScheduleTimezone = "UTC"
+
+// Schedule triggers a workflow with fixed inputs at the times of a cron expression,
+// evaluated in the timezone of the schedule. Standard five-field expressions and
+// descriptors such as @daily or @every 1h are supported.
+type Schedule struct {
+	ID             uuid.UUID
+	ProjectID      uuid.UUID
+	WorkflowID     WorkflowID
+	CronExpression string
+	Timezone       string
+	Inputs         map[string]any
+	Enabled        bool
+	NextFireAt     time.Time
+	LastFiredAt    *time.Time
+	LastTriggerID  *uuid.UUID
+	LastError      string
+}
+
+func NewSchedule(
+	id uuid.UUID,
+	projectID uuid.UUID,
+	workflowID WorkflowID,
+	cronExpression string,
+	timezone string,
+	inputs map[string]any,
+	enabled bool,
+	now time.Time,
+) (*Schedule, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	if workflowID == "" {
+		return nil, errs.InvalidError{Field: "workflowId", Reason: "workflowId is required"}
+	}
+
+	schedule := &Schedule{
+		ID:             id,
+		ProjectID:      projectID,
+		WorkflowID:     workflowID,
+		CronExpression: "",
+		Timezone:       "",
+		Inputs:         nil,
+		Enabled:        false,
+		NextFireAt:     time.Time{},
+		LastFiredAt:    nil,
+		LastTriggerID:  nil,
+		LastError:      "",
+	}
+
+	if err := schedule.Update(cronExpression, timezone, inputs, enabled, now); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// Update replaces the schedule settings and computes the next fire time from now.
+func (s *Schedule) Update(
+	cronExpression string,
+	timezone string,
+	inputs map[string]any,
+	enabled bool,
+	now time.Time,
+) error {
+	if timezone == "" {
+		timezone = defaultScheduleTimezone
+	}
+
+	next, err := nextFireTime(cronExpression, timezone, now)
+	if err != nil {
+		return err
+	}
+
+	s.CronExpression = cronExpression
+	s.Timezone = timezone
+	s.Inputs = inputs
+	s.Enabled = enabled
+	s.NextFireAt = next
+	return nil
+}
+
+// Fire records a fire of the schedule and returns the trigger ID of its execution.
+// Fires missed while no replica was running are not caught up, the next fire
+// time is computed from now.
+func (s *Schedule) Fire(now time.Time) (uuid.UUID, error) {
+	next, err := nextFireTime(s.CronExpression, s.Timezone, now)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	triggerID := uuid.New()
+	s.NextFireAt = next
+	s.LastFiredAt = &now
+	s.LastTriggerID = &triggerID
+	s.LastError = ""
+	return triggerID, nil
+}
+
+// Failed records why the last fire did not start the workflow.
+func (s *Schedule) Failed(err error) {
+	s.LastError = err.Error()
+}
+
+func nextFireTime(cronExpression string, timezone string, now time.Time) (time.Time, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, errs.InvalidError{Field: "timezone", Reason: "unknown timezone " + timezone, Err: err}
+	}
+
+	schedule, err := cron.ParseStandard(cronExpression)
+	if err != nil {
+		return time.Time{}, errs.InvalidError{Field: "cron", Reason: "invalid cron expression", Err: err}
+	}
+
+	next := schedule.Next(now.In(location))
+	if next.IsZero() {
+		return time.Time{}, errs.InvalidError{Field: "cron", Reason: "cron expression never fires"}
+	}
+	return next, nil
+}
@@ -0,0 +1,350 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ScorerType string
+
+const (
+	ScorerExact      ScorerType = "exact"
+	ScorerRegex      ScorerType = "regex"
+	ScorerJSONSchema ScorerType = "json_schema"
+	ScorerContains   ScorerType = "contains"
+	ScorerNumeric    ScorerType = "numeric"
+
+	defaultScoreThreshold = 1.0
+	jsonSchemaResource    = "scorer.json"
+)
+
+// ErrNoExpectedOutput is returned by the scorers comparing the result to the
+// expected output of a case that has none, the case is not scored.
+var ErrNoExpectedOutput = errors.New("case has no expected output")
+
+// ScorerConfig configures a scorer of an evaluation run. The scorer reads the
+// field of the result at Field, a dot separated path, or the whole result.
+type ScorerConfig struct {
+	Name string     `json:"name"`
+	Type ScorerType `json:"type"`
+	// Field is the path of the scored value in the result.
+	Field string `json:"field,omitempty"`
+	// Pattern of the regex scorer, the expected output when empty.
+	Pattern string `json:"pattern,omitempty"`
+	// Schema of the json_schema scorer, the expected output when empty.
+	Schema map[string]any `json:"schema,omitempty"`
+	// Tolerance of the numeric scorer, as an absolute difference.
+	Tolerance float64 `json:"tolerance,omitempty"`
+	// IgnoreCase applies to the exact and contains scorers.
+	IgnoreCase bool `json:"ignoreCase,omitempty"`
+	// Threshold is the score a case needs to pass, 1 by default.
+	Threshold float64 `json:"threshold,omitempty"`
+}
+
+// Scorer scores the value of a result against the expected output of a case,
+// between 0 and 1.
+type Scorer interface {
+	Score(actual any, expected any) (float64, error)
+}
+
+// ScorerFactory builds the scorer of a configuration, validating it.
+type ScorerFactory func(config ScorerConfig) (Scorer, error)
+
+var (
+	scorerFactoriesMu sync.RWMutex
+	scorerFactories   = map[ScorerType]ScorerFactory{
+		ScorerExact:      newExactScorer,
+		ScorerRegex:      newRegexScorer,
+		ScorerJSONSchema: newJSONSchemaScorer,
+		ScorerContains:   newContainsScorer,
+		ScorerNumeric:    newNumericScorer,
+	}
+)
+
+// RegisterScorer makes a scorer type available to evaluation runs.
+func RegisterScorer(scorerType ScorerType, factory ScorerFactory) {
+	scorerFactoriesMu.Lock()
+	defer scorerFactoriesMu.Unlock()
+	scorerFactories[scorerType] = factory
+}
+
+// NewScorer builds the scorer of a configuration.
+func NewScorer(config ScorerConfig) (Scorer, error) {
+	scorerFactoriesMu.RLock()
+	factory, ok := scorerFactories[config.Type]
+	scorerFactoriesMu.RUnlock()
+	if !ok {
+		return nil, errs.InvalidError{Field: "scorers", Reason: "unknown scorer type " + string(config.Type)}
+	}
+
+	return factory(config)
+}
+
+func (c ScorerConfig) threshold() float64 {
+	if c.Threshold == 0 {
+		return defaultScoreThreshold
+	}
+	return c.Threshold
+}
+
+// value returns the field of the result read by the scorer.
+func (c ScorerConfig) value(result any) any {
+	if c.Field == "" {
+		return result
+	}
+
+	value := result
+	for _, key := range strings.Split(c.Field, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+type exactScorer struct {
+	ignoreCase bool
+}
+
+func newExactScorer(config ScorerConfig) (Scorer, error) {
+	return exactScorer{ignoreCase: config.IgnoreCase}, nil
+}
+
+func (s exactScorer) Score(actual any, expected any) (float64, error) {
+	if expected == nil {
+		return 0, ErrNoExpectedOutput
+	}
+
+	if a, ok := actual.(string); ok {
+		if e, ok := expected.(string); ok {
+			a, e = strings.TrimSpace(a), strings.TrimSpace(e)
+			if s.ignoreCase {
+				return boolScore(strings.EqualFold(a, e)), nil
+			}
+			return boolScore(a == e), nil
+		}
+	}
+
+	return boolScore(reflect.DeepEqual(normalizeJSON(actual), normalizeJSON(expected))), nil
+}
+
+type regexScorer struct {
+	pattern *regexp.Regexp
+}
+
+func newRegexScorer(config ScorerConfig) (Scorer, error) {
+	if config.Pattern == "" {
+		return regexScorer{pattern: nil}, nil
+	}
+
+	pattern, err := regexp.Compile(config.Pattern)
+	if err != nil {
+		return nil, errs.InvalidError{Field: "pattern", Reason: "invalid pattern of scorer " + config.Name, Err: err}
+	}
+	return regexScorer{pattern: pattern}, nil
+}
+
+func (s regexScorer) Score(actual any, expected any) (float64, error) {
+	pattern := s.pattern
+	if pattern == nil {
+		e, ok := expected.(string)
+		if !ok {
+			return 0, ErrNoExpectedOutput
+		}
+
+		var err error
+		pattern, err = regexp.Compile(e)
+		if err != nil {
+			return 0, fmt.Errorf("invalid expected pattern: %w", err)
+		}
+	}
+
+	return boolScore(pattern.MatchString(stringValue(actual))), nil
+}
+
+type jsonSchemaScorer struct {
+	schema *jsonschema.Schema
+}
+
+func newJSONSchemaScorer(config ScorerConfig) (Scorer, error) {
+	if config.Schema == nil {
+		return jsonSchemaScorer{schema: nil}, nil
+	}
+
+	schema, err := compileJSONSchema(config.Schema)
+	if err != nil {
+		return nil, errs.InvalidError{Field: "schema", Reason: "invalid schema of scorer " + config.Name, Err: err}
+	}
+	return jsonSchemaScorer{schema: schema}, nil
+}
+
+func (s jsonSchemaScorer) Score(actual any, expected any) (float64, error) {
+	schema := s.schema
+	if schema == nil {
+		if expected == nil {
+			return 0, ErrNoExpectedOutput
+		}
+
+		var err error
+		schema, err = compileJSONSchema(expected)
+		if err != nil {
+			return 0, fmt.Errorf("invalid expected schema: %w", err)
+		}
+	}
+
+	// results of text handles often hold JSON documents
+	if text, ok := actual.(string); ok {
+		var document any
+		if err := json.Unmarshal([]byte(text), &document); err == nil {
+			actual = document
+		}
+	}
+
+	return boolScore(schema.Validate(normalizeJSON(actual)) == nil), nil
+}
+
+func compileJSONSchema(doc any) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(jsonSchemaResource, normalizeJSON(doc)); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(jsonSchemaResource)
+}
+
+type containsScorer struct {
+	ignoreCase bool
+}
+
+func newContainsScorer(config ScorerConfig) (Scorer, error) {
+	return containsScorer{ignoreCase: config.IgnoreCase}, nil
+}
+
+func (s containsScorer) Score(actual any, expected any) (float64, error) {
+	var substrings []string
+	switch e := expected.(type) {
+	case nil:
+		return 0, ErrNoExpectedOutput
+	case []any:
+		for _, v := range e {
+			substrings = append(substrings, stringValue(v))
+		}
+	default:
+		substrings = []string{stringValue(e)}
+	}
+
+	text := stringValue(actual)
+	if s.ignoreCase {
+		text = strings.ToLower(text)
+	}
+
+	// every expected substring counts for a share of the score
+	found := 0
+	for _, sub := range substrings {
+		if s.ignoreCase {
+			sub = strings.ToLower(sub)
+		}
+		if strings.Contains(text, sub) {
+			found++
+		}
+	}
+
+	if len(substrings) == 0 {
+		return 1, nil
+	}
+	return float64(found) / float64(len(substrings)), nil
+}
+
+type numericScorer struct {
+	tolerance float64
+}
+
+func newNumericScorer(config ScorerConfig) (Scorer, error) {
+	if config.Tolerance < 0 {
+		return nil, errs.InvalidError{Field: "tolerance", Reason: "tolerance of scorer " + config.Name + " is negative"}
+	}
+	return numericScorer{tolerance: config.Tolerance}, nil
+}
+
+func (s numericScorer) Score(actual any, expected any) (float64, error) {
+	if expected == nil {
+		return 0, ErrNoExpectedOutput
+	}
+
+	e, ok := numberValue(expected)
+	if !ok {
+		return 0, errors.New("expected output is not a number")
+	}
+
+	a, ok := numberValue(actual)
+	if !ok {
+		return 0, nil
+	}
+
+	return boolScore(math.Abs(a-e) <= s.tolerance), nil
+}
+
+func boolScore(ok bool) float64 {
+	if ok {
+		return 1
+	}
+	return 0
+}
+
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+func numberValue(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// normalizeJSON converts a value to its JSON decoded form, so values built
+// in Go compare equal to the ones read from the database.
+func normalizeJSON(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var normalized any
+	if err = json.Unmarshal(b, &normalized); err != nil {
+		return v
+	}
+	return normalized
+}
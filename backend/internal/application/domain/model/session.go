@@ -0,0 +1,93 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// SessionTTL is how long a session lasts without being refreshed.
+const SessionTTL = 30 * 24 * time.Hour
+
+// Session is a login of a user, it issues short-lived access tokens in
+// exchange for its refresh token, which rotates on every use.
+type Session struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	TokenDigest string
+	// PreviousTokenDigest is the rotated refresh token, it being presented
+	// again means it leaked.
+	PreviousTokenDigest string
+	UserAgent           string
+	IPAddress           string
+	ExpiresAt           time.Time
+	LastUsedAt          time.Time
+	RevokedAt           *time.Time
+}
+
+func NewSession(
+	id uuid.UUID,
+	userID uuid.UUID,
+	userAgent string,
+	ipAddress string,
+	now time.Time,
+) (*Session, auth.OneTimeToken, error) {
+	if id == uuid.Nil {
+		return nil, "", errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	token, err := auth.GenerateOneTimeToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Session{
+		ID:                  id,
+		UserID:              userID,
+		TokenDigest:         token.Digest(),
+		PreviousTokenDigest: "",
+		UserAgent:           userAgent,
+		IPAddress:           ipAddress,
+		ExpiresAt:           now.Add(SessionTTL),
+		LastUsedAt:          now,
+		RevokedAt:           nil,
+	}, token, nil
+}
+
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// Refresh exchanges the refresh token of the session for a new one.
+// The session is revoked when a rotated token is presented.
+func (s *Session) Refresh(tokenDigest string, ipAddress string, now time.Time) (auth.OneTimeToken, error) {
+	if !s.IsActive(now) {
+		return "", errs.UnauthorizedError{Err: errors.New("session is expired")}
+	}
+
+	if tokenDigest != s.TokenDigest {
+		s.Revoke(now)
+		return "", errs.UnauthorizedError{Err: errors.New("refresh token reused, session is revoked")}
+	}
+
+	token, err := auth.GenerateOneTimeToken()
+	if err != nil {
+		return "", err
+	}
+
+	s.PreviousTokenDigest = s.TokenDigest
+	s.TokenDigest = token.Digest()
+	s.IPAddress = ipAddress
+	s.ExpiresAt = now.Add(SessionTTL)
+	s.LastUsedAt = now
+	return token, nil
+}
+
+func (s *Session) Revoke(now time.Time) {
+	if s.RevokedAt == nil {
+		s.RevokedAt = &now
+	}
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	MaxTrafficSplitVariants = 10
+
+	// trafficSplitBuckets is the resolution of the split, sessions keep
+	// their bucket when the weights change.
+	trafficSplitBuckets = 10000
+)
+
+// TrafficSplitVariant receives a share of the triggers of the alias
+// proportional to its weight.
+type TrafficSplitVariant struct {
+	WorkflowID WorkflowID `json:"workflowId"`
+	Weight     int        `json:"weight"`
+}
+
+// TrafficSplit routes the triggers of a workflow, the alias, to variant
+// workflows of the project by weight. The alias can be one of its variants.
+// Triggers of a session always resolve to the same variant while the
+// weights are unchanged.
+type TrafficSplit struct {
+	ProjectID  uuid.UUID
+	WorkflowID WorkflowID
+	Variants   []TrafficSplitVariant
+	Enabled    bool
+}
+
+func NewTrafficSplit(
+	projectID uuid.UUID,
+	workflowID WorkflowID,
+	variants []TrafficSplitVariant,
+	enabled bool,
+) (*TrafficSplit, error) {
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	if workflowID == "" {
+		return nil, errs.InvalidError{Field: "workflowId", Reason: "workflowId is required"}
+	}
+
+	split := &TrafficSplit{
+		ProjectID:  projectID,
+		WorkflowID: workflowID,
+		Variants:   nil,
+		Enabled:    false,
+	}
+
+	if err := split.Update(variants, enabled); err != nil {
+		return nil, err
+	}
+
+	return split, nil
+}
+
+func (s *TrafficSplit) Update(variants []TrafficSplitVariant, enabled bool) error {
+	if len(variants) == 0 {
+		return errs.InvalidError{Field: "variants", Reason: "at least one variant is required"}
+	}
+
+	if len(variants) > MaxTrafficSplitVariants {
+		return errs.InvalidError{
+			Field:  "variants",
+			Reason: "a traffic split is limited to " + strconv.Itoa(MaxTrafficSplitVariants) + " variants",
+		}
+	}
+
+	total := 0
+	seen := make(map[WorkflowID]bool, len(variants))
+	for _, variant := range variants {
+		if variant.WorkflowID == "" {
+			return errs.InvalidError{Field: "variants", Reason: "variant workflowId is required"}
+		}
+
+		if seen[variant.WorkflowID] {
+			return errs.InvalidError{Field: "variants", Reason: "duplicate variant " + variant.WorkflowID.String()}
+		}
+		seen[variant.WorkflowID] = true
+
+		if variant.Weight < 0 {
+			return errs.InvalidError{Field: "variants", Reason: "weight of " + variant.WorkflowID.String() + " is negative"}
+		}
+		total += variant.Weight
+	}
+
+	if total == 0 {
+		return errs.InvalidError{Field: "variants", Reason: "at least one variant needs a weight"}
+	}
+
+	s.Variants = variants
+	s.Enabled = enabled
+	return nil
+}
+
+// Resolve returns the workflow running a trigger of the alias. Triggers are
+// bucketed by session, or by trigger when they have no session.
+func (s *TrafficSplit) Resolve(sessionID uuid.UUID, triggerID uuid.UUID) WorkflowID {
+	if !s.Enabled {
+		return s.WorkflowID
+	}
+
+	key := sessionID
+	if key == uuid.Nil {
+		key = triggerID
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(s.WorkflowID))
+	_, _ = hash.Write(key[:])
+	bucket := hash.Sum64() % trafficSplitBuckets
+
+	total := 0
+	for _, variant := range s.Variants {
+		total += variant.Weight
+	}
+
+	// scale the bucket to the weights, variants own consecutive ranges
+	point := int(bucket) * total / trafficSplitBuckets //nolint:gosec // bounded by trafficSplitBuckets
+	for _, variant := range s.Variants {
+		if point < variant.Weight {
+			return variant.WorkflowID
+		}
+		point -= variant.Weight
+	}
+	return s.Variants[len(s.Variants)-1].WorkflowID
+}
@@ -0,0 +1,202 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const usageEventKey = "usage"
+
+// TokenUsage is the token consumption of a single LLM call of a node.
+type TokenUsage struct {
+	Model        string
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// NodeUsage is a priced token usage attributed to a node of an execution,
+// Sequence is the sequence of the event that reported it.
+type NodeUsage struct {
+	TriggerID    uuid.UUID
+	Sequence     uint64
+	ProjectID    uuid.UUID
+	WorkflowID   WorkflowID
+	VariantID    WorkflowID
+	NodeID       string
+	NodeType     string
+	CredentialID *uuid.UUID
+	Usage        TokenUsage
+	Cost         float64
+	Priced       bool
+}
+
+// ExtractTokenUsage reads the usage the runner sums on the completion event of
+// a node. Providers name the counters differently, every known spelling is accepted.
+func ExtractTokenUsage(eventType WorkflowEventType, data map[string]any) (TokenUsage, bool) {
+	if eventType != WorkflowNodeCompleted {
+		return TokenUsage{}, false
+	}
+
+	usage, ok := data[usageEventKey].(map[string]any)
+	if !ok {
+		return TokenUsage{}, false
+	}
+
+	tokens := TokenUsage{
+		Model:        "",
+		InputTokens:  firstCount(usage, "inputTokens", "promptTokens", "input_tokens", "prompt_tokens"),
+		OutputTokens: firstCount(usage, "outputTokens", "completionTokens", "output_tokens", "completion_tokens"),
+	}
+	if tokens.InputTokens == 0 && tokens.OutputTokens == 0 {
+		return TokenUsage{}, false
+	}
+
+	if model, ok := usage["model"].(string); ok {
+		tokens.Model = model
+	} else if model, ok := data["model"].(string); ok {
+		tokens.Model = model
+	}
+	return tokens, true
+}
+
+func firstCount(values map[string]any, keys ...string) int64 {
+	for _, key := range keys {
+		switch v := values[key].(type) {
+		case float64:
+			return int64(v)
+		case int64:
+			return v
+		case int:
+			return int64(v)
+		case json.Number:
+			n, err := v.Int64()
+			if err == nil {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+// ModelPrice is the price in USD of a million tokens.
+type ModelPrice struct {
+	Input  float64
+	Output float64
+}
+
+// PriceTable prices token usage by model name. Unknown names fall back to
+// the longest known prefix so dated releases use the price of their family.
+type PriceTable map[string]ModelPrice
+
+func DefaultPriceTable() PriceTable {
+	return PriceTable{
+		"gpt-4o":            {Input: 2.5, Output: 10},
+		"gpt-4o-mini":       {Input: 0.15, Output: 0.6},
+		"gpt-4.1":           {Input: 2, Output: 8},
+		"gpt-4.1-mini":      {Input: 0.4, Output: 1.6},
+		"gpt-4.1-nano":      {Input: 0.1, Output: 0.4},
+		"gpt-4-turbo":       {Input: 10, Output: 30},
+		"gpt-3.5-turbo":     {Input: 0.5, Output: 1.5},
+		"o1":                {Input: 15, Output: 60},
+		"o1-mini":           {Input: 1.1, Output: 4.4},
+		"o3-mini":           {Input: 1.1, Output: 4.4},
+		"o4-mini":           {Input: 1.1, Output: 4.4},
+		"claude-3-7-sonnet": {Input: 3, Output: 15},
+		"claude-3-5-sonnet": {Input: 3, Output: 15},
+		"claude-3-5-haiku":  {Input: 0.8, Output: 4},
+		"claude-3-opus":     {Input: 15, Output: 75},
+		"claude-3-haiku":    {Input: 0.25, Output: 1.25},
+		"gemini-2.0-flash":  {Input: 0.1, Output: 0.4},
+		"gemini-1.5-pro":    {Input: 1.25, Output: 5},
+		"gemini-1.5-flash":  {Input: 0.075, Output: 0.3},
+		"mistral-large":     {Input: 2, Output: 6},
+		"mistral-small":     {Input: 0.1, Output: 0.3},
+		"deepseek-chat":     {Input: 0.27, Output: 1.1},
+		"deepseek-reasoner": {Input: 0.55, Output: 2.19},
+	}
+}
+
+// Merge returns a copy of the table with the given prices taking precedence.
+func (t PriceTable) Merge(prices PriceTable) PriceTable {
+	merged := make(PriceTable, len(t)+len(prices))
+	for model, price := range t {
+		merged[model] = price
+	}
+	for model, price := range prices {
+		merged[model] = price
+	}
+	return merged
+}
+
+func (t PriceTable) Lookup(model string) (ModelPrice, bool) {
+	if price, ok := t[model]; ok {
+		return price, true
+	}
+
+	best := ""
+	for name := range t {
+		if strings.HasPrefix(model, name) && len(name) > len(best) {
+			best = name
+		}
+	}
+	if best == "" {
+		return ModelPrice{}, false
+	}
+	return t[best], true
+}
+
+// Cost returns the cost in USD of the usage, false when the model has no price.
+func (t PriceTable) Cost(usage TokenUsage) (float64, bool) {
+	price, ok := t.Lookup(usage.Model)
+	if !ok {
+		return 0, false
+	}
+
+	return (float64(usage.InputTokens)*price.Input + float64(usage.OutputTokens)*price.Output) / 1_000_000, true
+}
+
+// NodeModel returns the model and credential configured on a node, agents
+// are configured through the model node connected to them.
+func (w *Workflow) NodeModel(nodeID string) (string, *uuid.UUID) {
+	for _, node := range w.BuilderFlow.Nodes {
+		if node.ID != nodeID {
+			continue
+		}
+
+		model, credentialID := nodeModelConfig(node.Data)
+		if credentialID != nil {
+			return model, credentialID
+		}
+
+		for _, edge := range w.BuilderFlow.Edges {
+			if edge.Source != nodeID || !strings.HasPrefix(edge.SourceHandle, AITypePrefix) {
+				continue
+			}
+			for _, target := range w.BuilderFlow.Nodes {
+				if target.ID == edge.Target {
+					return nodeModelConfig(target.Data)
+				}
+			}
+		}
+		return model, nil
+	}
+	return "", nil
+}
+
+func nodeModelConfig(data json.RawMessage) (string, *uuid.UUID) {
+	var config struct {
+		Model        string `json:"model"`
+		CredentialID string `json:"credentialId"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", nil
+	}
+
+	credentialID, err := uuid.Parse(config.CredentialID)
+	if err != nil {
+		return config.Model, nil
+	}
+	return config.Model, &credentialID
+}
@@ -1,8 +1,22 @@
 package model
 
 import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72 // bcrypt ignores the bytes past 72
+
+	InvitationTTL    = 7 * 24 * time.Hour
+	PasswordResetTTL = 24 * time.Hour
 )
 
 type User struct {
@@ -10,4 +24,238 @@ type User struct {
 	Email        string
 	Name         string
 	PasswordHash auth.Hash
+
+	// Admin users manage the other accounts.
+	Admin bool
+	// MustChangePassword is set on the fixture user and on the users created
+	// with a temporary password, they are limited to changing it.
+	MustChangePassword bool
+	DeactivatedAt      *time.Time
+	// TOTP is nil until the user enrolls an authenticator app.
+	TOTP *TOTP
+}
+
+// NewUser creates an active user with a password of their own.
+func NewUser(id uuid.UUID, email string, name string, password string, admin bool) (*User, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	email, err := validateEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errs.InvalidError{Field: "name", Reason: "name is required"}
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &User{
+		ID:                 id,
+		Email:              email,
+		Name:               name,
+		PasswordHash:       hash,
+		Admin:              admin,
+		MustChangePassword: false,
+		DeactivatedAt:      nil,
+		TOTP:               nil,
+	}, nil
+}
+
+func (u *User) IsActive() bool {
+	return u.DeactivatedAt == nil
+}
+
+// RequirePasswordChange limits the user to changing their password,
+// such as after being given a temporary one.
+func (u *User) RequirePasswordChange() {
+	u.MustChangePassword = true
+}
+
+// ChangePassword replaces the password of the user, who proves to know the current one.
+func (u *User) ChangePassword(current string, password string) error {
+	if !auth.CheckPassword(current, u.PasswordHash) {
+		return errs.InvalidError{Field: "currentPassword", Reason: "current password is incorrect"}
+	}
+
+	if current == password {
+		return errs.InvalidError{Field: "newPassword", Reason: "new password must differ from the current one"}
+	}
+
+	return u.ResetPassword(password)
+}
+
+// ResetPassword replaces the password of the user without the current one.
+func (u *User) ResetPassword(password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	u.PasswordHash = hash
+	u.MustChangePassword = false
+	return nil
+}
+
+func (u *User) Deactivate(now time.Time) error {
+	if !u.IsActive() {
+		return errs.InvalidError{Field: "userId", Reason: "user is already deactivated"}
+	}
+	u.DeactivatedAt = &now
+	return nil
+}
+
+func (u *User) Activate() {
+	u.DeactivatedAt = nil
+}
+
+// Invitation lets the holder of its token create their account.
+// Invitations are not emailed, the link is handed out by the admin.
+type Invitation struct {
+	ID          uuid.UUID
+	Email       string
+	Name        string
+	Admin       bool
+	TokenDigest string
+	InvitedBy   uuid.UUID
+	ExpiresAt   time.Time
+	AcceptedAt  *time.Time
+}
+
+func NewInvitation(
+	id uuid.UUID,
+	email string,
+	name string,
+	admin bool,
+	invitedBy uuid.UUID,
+	now time.Time,
+) (*Invitation, auth.OneTimeToken, error) {
+	if id == uuid.Nil {
+		return nil, "", errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	email, err := validateEmail(email)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := auth.GenerateOneTimeToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Invitation{
+		ID:          id,
+		Email:       email,
+		Name:        strings.TrimSpace(name),
+		Admin:       admin,
+		TokenDigest: token.Digest(),
+		InvitedBy:   invitedBy,
+		ExpiresAt:   now.Add(InvitationTTL),
+		AcceptedAt:  nil,
+	}, token, nil
+}
+
+// Accept creates the account of the invited user, named as in the invitation
+// when name is empty.
+func (i *Invitation) Accept(userID uuid.UUID, name string, password string, now time.Time) (*User, error) {
+	if i.AcceptedAt != nil {
+		return nil, errs.InvalidError{Field: "token", Reason: "invitation already accepted"}
+	}
+
+	if now.After(i.ExpiresAt) {
+		return nil, errs.InvalidError{Field: "token", Reason: "invitation expired"}
+	}
+
+	if strings.TrimSpace(name) == "" {
+		name = i.Name
+	}
+
+	user, err := NewUser(userID, i.Email, name, password, i.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	i.AcceptedAt = &now
+	return user, nil
+}
+
+// PasswordReset lets the holder of its token set a new password for the user.
+type PasswordReset struct {
+	TokenDigest string
+	UserID      uuid.UUID
+	ExpiresAt   time.Time
+	UsedAt      *time.Time
+}
+
+func NewPasswordReset(userID uuid.UUID, now time.Time) (*PasswordReset, auth.OneTimeToken, error) {
+	token, err := auth.GenerateOneTimeToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &PasswordReset{
+		TokenDigest: token.Digest(),
+		UserID:      userID,
+		ExpiresAt:   now.Add(PasswordResetTTL),
+		UsedAt:      nil,
+	}, token, nil
+}
+
+// Use resets the password of the user of the token, which can be used once.
+func (p *PasswordReset) Use(user *User, password string, now time.Time) error {
+	if p.UsedAt != nil || now.After(p.ExpiresAt) {
+		return errs.InvalidError{Field: "token", Reason: "password reset token is expired"}
+	}
+
+	if !user.IsActive() {
+		return errs.ForbiddenError{Err: errors.New("user is deactivated")}
+	}
+
+	if err := user.ResetPassword(password); err != nil {
+		return err
+	}
+
+	p.UsedAt = &now
+	return nil
+}
+
+func validateEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", errs.InvalidError{Field: "email", Reason: "invalid email address", Err: err}
+	}
+	return email, nil
+}
+
+func hashPassword(password string) (auth.Hash, error) {
+	if len(password) < minPasswordLength {
+		return "", errs.InvalidError{Field: "password", Reason: "password must be at least 8 characters"}
+	}
+
+	if len(password) > maxPasswordLength {
+		return "", errs.InvalidError{Field: "password", Reason: "password must be at most 72 bytes"}
+	}
+
+	return auth.HashPassword(password)
+}
+
+// auditSnapshot leaves the credentials of the user out, their changes are
+// recorded by the actions.
+func (u *User) auditSnapshot() map[string]any {
+	return map[string]any{
+		"Email":              u.Email,
+		"Name":               u.Name,
+		"Admin":              u.Admin,
+		"MustChangePassword": u.MustChangePassword,
+		"DeactivatedAt":      u.DeactivatedAt,
+		"MFAEnabled":         u.MFAEnabled(),
+	}
 }
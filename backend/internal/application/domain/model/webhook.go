@@ -0,0 +1,224 @@
+package model
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+
+	// MaxWebhookAttempts is the number of attempts before a delivery is given up,
+	// the delay between attempts doubles from webhookRetryBaseDelay up to webhookRetryMaxDelay.
+	MaxWebhookAttempts    = 8
+	webhookRetryBaseDelay = 10 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+
+	maxWebhookResponseBody = 1024
+)
+
+// Webhook subscribes an URL to the workflow events of a project.
+// Empty event types or workflow IDs subscribe to all of them.
+type Webhook struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	URL         string
+	EventTypes  []WorkflowEventType
+	WorkflowIDs []WorkflowID
+	Secret      secret.Encrypted
+	Enabled     bool
+}
+
+// WebhookDelivery is a workflow event sent to a webhook, with the outcome of its last attempt.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventType      WorkflowEventType
+	TriggerID      uuid.UUID
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	ResponseBody   string
+	Error          string
+	DeliveredAt    *time.Time
+}
+
+func NewWebhook(
+	id uuid.UUID,
+	projectID uuid.UUID,
+	rawURL string,
+	eventTypes []WorkflowEventType,
+	workflowIDs []WorkflowID,
+) (*Webhook, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	if err := validateWebhookURL(rawURL); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookEventTypes(eventTypes); err != nil {
+		return nil, err
+	}
+
+	_, encrypted, err := secret.GenerateSigningSecret()
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return &Webhook{
+		ID:          id,
+		ProjectID:   projectID,
+		URL:         rawURL,
+		EventTypes:  eventTypes,
+		WorkflowIDs: workflowIDs,
+		Secret:      encrypted,
+		Enabled:     true,
+	}, nil
+}
+
+func (w *Webhook) Update(
+	rawURL string,
+	eventTypes []WorkflowEventType,
+	workflowIDs []WorkflowID,
+	enabled bool,
+) error {
+	if err := validateWebhookURL(rawURL); err != nil {
+		return err
+	}
+
+	if err := validateWebhookEventTypes(eventTypes); err != nil {
+		return err
+	}
+
+	w.URL = rawURL
+	w.EventTypes = eventTypes
+	w.WorkflowIDs = workflowIDs
+	w.Enabled = enabled
+	return nil
+}
+
+// Subscribes reports whether an event of the workflow is sent to the webhook.
+func (w *Webhook) Subscribes(eventType WorkflowEventType, workflowID WorkflowID) bool {
+	if !w.Enabled {
+		return false
+	}
+
+	if len(w.EventTypes) > 0 && !slices.Contains(w.EventTypes, eventType) {
+		return false
+	}
+
+	return len(w.WorkflowIDs) == 0 || slices.Contains(w.WorkflowIDs, workflowID)
+}
+
+func (w *Webhook) NewDelivery(eventType WorkflowEventType, triggerID uuid.UUID, payload []byte) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             uuid.New(),
+		WebhookID:      w.ID,
+		EventType:      eventType,
+		TriggerID:      triggerID,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		Attempts:       0,
+		NextAttemptAt:  time.Now(),
+		ResponseStatus: 0,
+		ResponseBody:   "",
+		Error:          "",
+		DeliveredAt:    nil,
+	}
+}
+
+// Redeliver queues a new delivery of the same payload.
+func (d *WebhookDelivery) Redeliver() *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             uuid.New(),
+		WebhookID:      d.WebhookID,
+		EventType:      d.EventType,
+		TriggerID:      d.TriggerID,
+		Payload:        d.Payload,
+		Status:         WebhookDeliveryPending,
+		Attempts:       0,
+		NextAttemptAt:  time.Now(),
+		ResponseStatus: 0,
+		ResponseBody:   "",
+		Error:          "",
+		DeliveredAt:    nil,
+	}
+}
+
+// Succeed records a 2xx response.
+func (d *WebhookDelivery) Succeed(status int, body string) {
+	now := time.Now()
+	d.Attempts++
+	d.Status = WebhookDeliverySucceeded
+	d.ResponseStatus = status
+	d.ResponseBody = truncate(body, maxWebhookResponseBody)
+	d.Error = ""
+	d.DeliveredAt = &now
+}
+
+// Fail records a failed attempt and schedules the next one with an exponential backoff,
+// the delivery fails for good once MaxWebhookAttempts is reached.
+func (d *WebhookDelivery) Fail(status int, body string, reason string) {
+	d.Attempts++
+	d.ResponseStatus = status
+	d.ResponseBody = truncate(body, maxWebhookResponseBody)
+	d.Error = reason
+
+	if d.Attempts >= MaxWebhookAttempts {
+		d.Status = WebhookDeliveryFailed
+		return
+	}
+
+	delay := webhookRetryBaseDelay << (d.Attempts - 1)
+	if delay > webhookRetryMaxDelay {
+		delay = webhookRetryMaxDelay
+	}
+	d.NextAttemptAt = time.Now().Add(delay)
+}
+
+// Abandon fails the delivery for good, when its webhook is disabled.
+func (d *WebhookDelivery) Abandon(reason string) {
+	d.Status = WebhookDeliveryFailed
+	d.Error = reason
+}
+
+func validateWebhookEventTypes(eventTypes []WorkflowEventType) error {
+	for _, t := range eventTypes {
+		if !t.IsValid() {
+			return errs.InvalidError{Field: "eventTypes", Reason: "unknown event type " + t.String()}
+		}
+	}
+	return nil
+}
+
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return errs.InvalidError{Field: "url", Reason: "url must be an absolute http(s) URL", Err: err}
+	}
+	return nil
+}
+
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen]
+}
@@ -0,0 +1,422 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/secret"
+)
+
+type WebhookVerification string
+
+const (
+	// WebhookVerificationNone accepts every request, the trigger URL being the only secret.
+	WebhookVerificationNone WebhookVerification = "none"
+	// WebhookVerificationToken compares a header, or the token query parameter, with the secret.
+	WebhookVerificationToken WebhookVerification = "token"
+	// WebhookVerificationHMAC checks a hex HMAC-SHA256 of the body, optionally prefixed by "sha256=".
+	WebhookVerificationHMAC WebhookVerification = "hmac_sha256"
+	// WebhookVerificationGitHub checks the X-Hub-Signature-256 header sent by GitHub.
+	WebhookVerificationGitHub WebhookVerification = "github"
+	// WebhookVerificationStripe checks the timestamped Stripe-Signature header sent by Stripe.
+	WebhookVerificationStripe WebhookVerification = "stripe"
+
+	defaultTokenHeader     = "X-Webhook-Token"
+	defaultSignatureHeader = "X-Webhook-Signature"
+	githubSignatureHeader  = "X-Hub-Signature-256"
+	stripeSignatureHeader  = "Stripe-Signature"
+	tokenQueryParam        = "token"
+	signaturePrefix        = "sha256="
+	stripeTolerance        = 5 * time.Minute
+
+	// DefaultWebhookSyncTimeout is used when a synchronous trigger has no timeout,
+	// MaxWebhookSyncTimeout bounds how long a caller is kept waiting.
+	DefaultWebhookSyncTimeout = 30 * time.Second
+	MaxWebhookSyncTimeout     = 2 * time.Minute
+
+	// input mapping sources
+	mappingBody    = "body"
+	mappingRaw     = "raw"
+	mappingHeaders = "headers."
+	mappingQuery   = "query."
+)
+
+// WebhookTrigger starts a workflow from a request sent by a third-party system.
+// InputMapping maps entrypoint inputs to a source of the request: "body", "raw",
+// "body.<path>", "headers.<name>" or "query.<name>". Without mapping, the inputs
+// are the fields of a JSON or form body.
+type WebhookTrigger struct {
+	ID              uuid.UUID
+	ProjectID       uuid.UUID
+	WorkflowID      WorkflowID
+	Name            string
+	Verification    WebhookVerification
+	SignatureHeader string
+	Secret          secret.Encrypted
+	InputMapping    map[string]string
+	RespondSync     bool
+	SyncTimeout     time.Duration
+	Enabled         bool
+}
+
+// InboundRequest is the part of an incoming HTTP request a webhook trigger reads.
+type InboundRequest struct {
+	Header http.Header
+	Query  url.Values
+	Body   []byte
+}
+
+func NewWebhookTrigger(
+	id uuid.UUID,
+	projectID uuid.UUID,
+	workflowID WorkflowID,
+	name string,
+	verification WebhookVerification,
+	signatureHeader string,
+	signingSecret string,
+	inputMapping map[string]string,
+	respondSync bool,
+	syncTimeout time.Duration,
+) (*WebhookTrigger, error) {
+	if id == uuid.Nil {
+		return nil, errs.InvalidError{Field: "id", Reason: "id is required"}
+	}
+
+	if projectID == uuid.Nil {
+		return nil, errs.InvalidError{Field: "projectId", Reason: "projectId is required"}
+	}
+
+	if workflowID == "" {
+		return nil, errs.InvalidError{Field: "workflowId", Reason: "workflowId is required"}
+	}
+
+	trigger := &WebhookTrigger{
+		ID:              id,
+		ProjectID:       projectID,
+		WorkflowID:      workflowID,
+		Name:            "",
+		Verification:    "",
+		SignatureHeader: "",
+		Secret:          "",
+		InputMapping:    nil,
+		RespondSync:     false,
+		SyncTimeout:     0,
+		Enabled:         true,
+	}
+
+	err := trigger.Update(name, verification, signatureHeader, signingSecret, inputMapping, respondSync, syncTimeout, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// Update replaces the trigger settings. An empty secret keeps the current one,
+// or generates one when the verification scheme needs it and none is set.
+func (t *WebhookTrigger) Update(
+	name string,
+	verification WebhookVerification,
+	signatureHeader string,
+	signingSecret string,
+	inputMapping map[string]string,
+	respondSync bool,
+	syncTimeout time.Duration,
+	enabled bool,
+) error {
+	if name == "" {
+		return errs.InvalidError{Field: "name", Reason: "name is required"}
+	}
+
+	if err := validateVerification(verification); err != nil {
+		return err
+	}
+
+	if err := validateInputMapping(inputMapping); err != nil {
+		return err
+	}
+
+	if syncTimeout < 0 || syncTimeout > MaxWebhookSyncTimeout {
+		return errs.InvalidError{
+			Field:  "syncTimeout",
+			Reason: "syncTimeout must be between 0 and " + MaxWebhookSyncTimeout.String(),
+		}
+	}
+
+	if err := t.setSecret(verification, signingSecret); err != nil {
+		return err
+	}
+
+	t.Name = name
+	t.Verification = verification
+	t.SignatureHeader = signatureHeader
+	t.InputMapping = inputMapping
+	t.RespondSync = respondSync
+	t.SyncTimeout = syncTimeout
+	t.Enabled = enabled
+	return nil
+}
+
+func (t *WebhookTrigger) setSecret(verification WebhookVerification, signingSecret string) error {
+	switch {
+	case verification == WebhookVerificationNone:
+		t.Secret = ""
+	case signingSecret != "":
+		encrypted, err := secret.APIKey(signingSecret).Encrypt()
+		if err != nil {
+			return errs.InternalError{Err: err}
+		}
+		t.Secret = encrypted
+	case t.Secret == "":
+		_, encrypted, err := secret.GenerateSigningSecret()
+		if err != nil {
+			return errs.InternalError{Err: err}
+		}
+		t.Secret = encrypted
+	}
+	return nil
+}
+
+// Timeout returns how long a synchronous trigger waits for the workflow result.
+func (t *WebhookTrigger) Timeout() time.Duration {
+	if t.SyncTimeout == 0 {
+		return DefaultWebhookSyncTimeout
+	}
+	return t.SyncTimeout
+}
+
+// Verify authenticates the request with the verification scheme of the trigger.
+func (t *WebhookTrigger) Verify(req InboundRequest, now time.Time) error {
+	if !t.Enabled {
+		return errs.ForbiddenError{Err: errors.New("webhook trigger is disabled")}
+	}
+
+	if t.Verification == WebhookVerificationNone {
+		return nil
+	}
+
+	signingSecret, err := t.Secret.Decrypt()
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+	key := []byte(signingSecret)
+
+	switch t.Verification {
+	case WebhookVerificationToken:
+		token := req.Header.Get(t.header(defaultTokenHeader))
+		if token == "" {
+			token = req.Query.Get(tokenQueryParam)
+		}
+		if subtle.ConstantTimeCompare([]byte(token), key) != 1 {
+			return errs.UnauthorizedError{Err: errors.New("invalid webhook token")}
+		}
+		return nil
+	case WebhookVerificationHMAC:
+		return verifyHexSignature(key, req.Body, req.Header.Get(t.header(defaultSignatureHeader)))
+	case WebhookVerificationGitHub:
+		return verifyHexSignature(key, req.Body, req.Header.Get(githubSignatureHeader))
+	case WebhookVerificationStripe:
+		return verifyStripeSignature(key, req.Body, req.Header.Get(stripeSignatureHeader), now)
+	default:
+		return errs.InternalError{Err: errors.New("unknown webhook verification " + string(t.Verification))}
+	}
+}
+
+func (t *WebhookTrigger) header(fallback string) string {
+	if t.SignatureHeader != "" {
+		return t.SignatureHeader
+	}
+	return fallback
+}
+
+// Inputs builds the entrypoint inputs of the workflow from the request.
+func (t *WebhookTrigger) Inputs(req InboundRequest) (map[string]any, error) {
+	body, err := parseInboundBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(t.InputMapping) == 0 {
+		if fields, ok := body.(map[string]any); ok {
+			return fields, nil
+		}
+		return map[string]any{mappingBody: body}, nil
+	}
+
+	inputs := make(map[string]any, len(t.InputMapping))
+	for input, source := range t.InputMapping {
+		var value any
+		switch {
+		case source == mappingBody:
+			value = body
+		case source == mappingRaw:
+			value = string(req.Body)
+		case strings.HasPrefix(source, mappingBody+"."):
+			value = lookupPath(body, strings.Split(strings.TrimPrefix(source, mappingBody+"."), "."))
+		case strings.HasPrefix(source, mappingHeaders):
+			value = optional(req.Header.Get(strings.TrimPrefix(source, mappingHeaders)))
+		case strings.HasPrefix(source, mappingQuery):
+			value = optional(req.Query.Get(strings.TrimPrefix(source, mappingQuery)))
+		}
+
+		if value != nil {
+			inputs[input] = value
+		}
+	}
+	return inputs, nil
+}
+
+func validateVerification(verification WebhookVerification) error {
+	switch verification {
+	case WebhookVerificationNone,
+		WebhookVerificationToken,
+		WebhookVerificationHMAC,
+		WebhookVerificationGitHub,
+		WebhookVerificationStripe:
+		return nil
+	default:
+		return errs.InvalidError{Field: "verification", Reason: "unknown verification " + string(verification)}
+	}
+}
+
+func validateInputMapping(inputMapping map[string]string) error {
+	for input, source := range inputMapping {
+		if input == "" {
+			return errs.InvalidError{Field: "inputMapping", Reason: "input name is required"}
+		}
+
+		valid := source == mappingBody ||
+			source == mappingRaw ||
+			(strings.HasPrefix(source, mappingBody+".") && len(source) > len(mappingBody)+1) ||
+			(strings.HasPrefix(source, mappingHeaders) && len(source) > len(mappingHeaders)) ||
+			(strings.HasPrefix(source, mappingQuery) && len(source) > len(mappingQuery))
+		if !valid {
+			return errs.InvalidError{Field: "inputMapping", Reason: "invalid source " + source + " for input " + input}
+		}
+	}
+	return nil
+}
+
+func verifyHexSignature(key []byte, body []byte, header string) error {
+	signature, err := hex.DecodeString(strings.TrimPrefix(header, signaturePrefix))
+	if err != nil || header == "" {
+		return errs.UnauthorizedError{Err: errors.New("missing or malformed webhook signature")}
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errs.UnauthorizedError{Err: errors.New("invalid webhook signature")}
+	}
+	return nil
+}
+
+// verifyStripeSignature checks a "t=<unix>,v1=<hex>" header, the signed payload
+// being "<unix>.<body>". Old timestamps are rejected to prevent replays.
+func verifyStripeSignature(key []byte, body []byte, header string, now time.Time) error {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			timestamp = v
+		case "v1":
+			signatures = append(signatures, v)
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return errs.UnauthorizedError{Err: errors.New("missing or malformed webhook signature")}
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); age > stripeTolerance || age < -stripeTolerance {
+		return errs.UnauthorizedError{Err: errors.New("webhook signature timestamp is outside the tolerance")}
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	for _, s := range signatures {
+		signature, err := hex.DecodeString(s)
+		if err == nil && hmac.Equal(signature, expected) {
+			return nil
+		}
+	}
+	return errs.UnauthorizedError{Err: errors.New("invalid webhook signature")}
+}
+
+// parseInboundBody decodes form and JSON bodies, other bodies are kept as text.
+func parseInboundBody(req InboundRequest) (any, error) {
+	if len(req.Body) == 0 {
+		return map[string]any{}, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(req.Body))
+		if err != nil {
+			return nil, errs.InvalidError{Field: "body", Reason: "unable to parse form body", Err: err}
+		}
+
+		fields := make(map[string]any, len(values))
+		for k := range values {
+			fields[k] = values.Get(k)
+		}
+		return fields, nil
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var body any
+		if err := json.Unmarshal(req.Body, &body); err != nil {
+			return nil, errs.InvalidError{Field: "body", Reason: "unable to parse json body", Err: err}
+		}
+		return body, nil
+	default:
+		var body any
+		if err := json.Unmarshal(req.Body, &body); err == nil {
+			return body, nil
+		}
+		return string(req.Body), nil
+	}
+}
+
+// lookupPath walks objects by key and arrays by index, nil when the path is missing.
+func lookupPath(value any, path []string) any {
+	for _, key := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			value = v[i]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+func optional(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
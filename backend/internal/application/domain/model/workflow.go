@@ -2,6 +2,8 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -205,6 +207,14 @@ func (p *Project) UpdateWorkflowBuilderFlow(id WorkflowID, builderFlow json.RawM
 	return nil
 }
 
+func (p *Project) GetWorkflow(id WorkflowID) (*Workflow, error) {
+	w, ok := p.Workflows[id]
+	if !ok {
+		return nil, ErrWorkflowNotFound
+	}
+	return w, nil
+}
+
 func (p *Project) ComputeWorkflow(id WorkflowID) (*Workflow, error) {
 	w, ok := p.Workflows[id]
 	if !ok {
@@ -302,11 +312,9 @@ func (p *Project) getConnectedTools(nodeID string, edges []BuilderEdge, nodeMap
 						if err != nil {
 							return nil, fmt.Errorf("invalid credential ID: %w", err)
 						}
-						apiKey, err := p.getCredentialAPIKey(credentialID)
-						if err != nil {
-							return nil, fmt.Errorf("unable to get credential API key: %w", err)
+						if err = p.setCredentialConfig(toolConfig, credentialID); err != nil {
+							return nil, fmt.Errorf("unable to get credential: %w", err)
 						}
-						toolConfig["apiKey"] = apiKey
 					}
 
 					if name, ok := toolConfig["name"].(string); ok {
@@ -396,6 +404,8 @@ func (p *Project) getNodeProcessor(nodeType string) (func(BuilderNode, []Builder
 		return p.processLLMNode, nil
 	case nodeType == "code-executor":
 		return p.processCodeExecutorNode, nil
+	case nodeType == "human-input":
+		return p.processHumanInputNode, nil
 	default:
 		return nil, nil
 	}
@@ -479,6 +489,36 @@ func (p *Project) processCodeExecutorNode(node BuilderNode, edges []BuilderEdge,
 	}, nil
 }
 
+// HumanInputNodeData pauses the workflow until a client answers the prompt,
+// the runner gives up after TimeoutSeconds, one hour when it is not set.
+type HumanInputNodeData struct {
+	Prompt         string `json:"prompt"`
+	TimeoutSeconds int    `json:"timeoutSeconds,omitempty"`
+}
+
+func (p *Project) processHumanInputNode(node BuilderNode, edges []BuilderEdge, nodeMap map[string]BuilderNode, tools []RunnerTool, memory *RunnerMemory) (*RunnerNode, error) {
+	var data HumanInputNodeData
+	if err := json.Unmarshal(node.Data, &data); err != nil {
+		return nil, errs.InvalidError{Reason: "unable to unmarshal human input node data", Err: err}
+	}
+
+	config := map[string]any{
+		"prompt": data.Prompt,
+	}
+	if data.TimeoutSeconds > 0 {
+		config["timeoutSeconds"] = data.TimeoutSeconds
+	}
+
+	return &RunnerNode{
+		Type:    "human-input",
+		Config:  config,
+		Tools:   tools,
+		Memory:  memory,
+		Inputs:  p.buildNodeInputs(node.ID, edges),
+		Outputs: p.buildNodeOutputs(node.ID, edges, nodeMap),
+	}, nil
+}
+
 // processLLMNode processes an LLM node
 func (p *Project) processLLMNode(node BuilderNode, edges []BuilderEdge, nodeMap map[string]BuilderNode, tools []RunnerTool, memory *RunnerMemory) (*RunnerNode, error) {
 	var nodeConfig map[string]any
@@ -493,12 +533,9 @@ func (p *Project) processLLMNode(node BuilderNode, edges []BuilderEdge, nodeMap
 			return nil, fmt.Errorf("invalid credential ID: %w", err)
 		}
 
-		apiKey, err := p.getCredentialAPIKey(credentialID)
-		if err != nil {
-			return nil, fmt.Errorf("unable to get credential API key: %w", err)
+		if err = p.setCredentialConfig(nodeConfig, credentialID); err != nil {
+			return nil, fmt.Errorf("unable to get credential: %w", err)
 		}
-
-		nodeConfig["apiKey"] = apiKey
 	}
 
 	// Build inputs and outputs
@@ -527,14 +564,13 @@ func (p *Project) processAIAgentNode(node BuilderNode, edges []BuilderEdge, node
 		return nil, err
 	}
 
-	apiKey, err := p.getCredentialAPIKey(uuid.MustParse(agentModel.CredentialID))
+	err = p.setCredentialConfig(nodeConfig, uuid.MustParse(agentModel.CredentialID))
 	if err != nil {
-		return nil, fmt.Errorf("unable to get credential API key: %w", err)
+		return nil, fmt.Errorf("unable to get credential: %w", err)
 	}
 
 	nodeConfig["provider"] = agentModel.Provider
 	nodeConfig["model"] = agentModel.Model
-	nodeConfig["apiKey"] = apiKey
 
 	// Build inputs and outputs
 	inputs := p.buildNodeInputs(node.ID, edges)
@@ -714,15 +750,83 @@ func (p *Project) parseHandle(handle string) []string {
 	return strings.Split(handle, "__")
 }
 
-func (p *Project) getCredentialAPIKey(credentialID uuid.UUID) (string, error) {
+// setCredentialConfig hands the encrypted credential to a node: its API key,
+// and its whole payload for the nodes reading more than a key. Credentials
+// holding neither, or read by no node, are not supported by the runner.
+func (p *Project) setCredentialConfig(config map[string]any, credentialID uuid.UUID) error {
 	credential, ok := p.Credentials[credentialID]
 	if !ok {
-		return "", ErrCredentialNotFound
+		return CredentialNotFoundError{ID: credentialID}
 	}
 
-	return credential.APIKey.String(), nil
+	schema := CredentialSchemaOf(credential.ProviderType)
+	if schema.Unsupported || (credential.APIKey == "" && !schema.Payload) {
+		return fmt.Errorf("%w: %s credentials cannot be used by the nodes",
+			ErrCredentialNotSupported, credential.ProviderType)
+	}
+
+	config["apiKey"] = credential.APIKey.String()
+	if schema.Payload && credential.Payload != "" {
+		config["credentialPayload"] = credential.Payload.String()
+	}
+	return nil
 }
 
 func (w *Workflow) UpdateStatus(status WorkflowStatus) {
 	w.Status = status
 }
+
+// Revision identifies the behaviour of the workflow: a digest of its nodes and
+// edges, ignoring how they are laid out in the builder.
+func (w *Workflow) Revision() string {
+	type node struct {
+		ID   string          `json:"id"`
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	type edge struct {
+		Source       string `json:"source"`
+		Target       string `json:"target"`
+		SourceHandle string `json:"sourceHandle"`
+		TargetHandle string `json:"targetHandle"`
+	}
+
+	flow := struct {
+		Nodes []node `json:"nodes"`
+		Edges []edge `json:"edges"`
+	}{
+		Nodes: make([]node, len(w.BuilderFlow.Nodes)),
+		Edges: make([]edge, len(w.BuilderFlow.Edges)),
+	}
+	for i, n := range w.BuilderFlow.Nodes {
+		flow.Nodes[i] = node{ID: n.ID, Type: n.Type, Data: n.Data}
+	}
+	for i, e := range w.BuilderFlow.Edges {
+		flow.Edges[i] = edge{Source: e.Source, Target: e.Target, SourceHandle: e.SourceHandle, TargetHandle: e.TargetHandle}
+	}
+
+	b, _ := json.Marshal(flow)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:6])
+}
+
+// auditSnapshot keys the nodes by their ID so that a changed node, such as an
+// edited prompt, is recorded alone. The layout of the builder is left out.
+func (w *Workflow) auditSnapshot() map[string]any {
+	nodes := make(map[string]any, len(w.BuilderFlow.Nodes))
+	for _, n := range w.BuilderFlow.Nodes {
+		nodes[n.ID] = map[string]any{"Type": n.Type, "Data": auditValue(n.Data)}
+	}
+
+	edges := make([]any, len(w.BuilderFlow.Edges))
+	for i, e := range w.BuilderFlow.Edges {
+		edges[i] = e.Source + ":" + e.SourceHandle + " -> " + e.Target + ":" + e.TargetHandle
+	}
+
+	return map[string]any{
+		"Name":   w.Name,
+		"Status": w.Status.String(),
+		"Nodes":  nodes,
+		"Edges":  edges,
+	}
+}
@@ -1,15 +1,61 @@
 package model
 
+import "slices"
+
 type WorkflowEventType string
 
 const (
 	WorkflowStarted           WorkflowEventType = "WORKFLOW_STARTED"
 	WorkflowCompleted         WorkflowEventType = "WORKFLOW_COMPLETED"
 	WorkflowFailed            WorkflowEventType = "WORKFLOW_FAILED"
+	WorkflowCancelled         WorkflowEventType = "WORKFLOW_CANCELLED"
 	WorkflowNodeStarted       WorkflowEventType = "NODE_STARTED"
 	WorkflowNodeCompleted     WorkflowEventType = "NODE_COMPLETED"
 	WorkflowNodeFailed        WorkflowEventType = "NODE_FAILED"
 	WorkflowEventNodeResult   WorkflowEventType = "NODE_RESULT"
 	WorkflowEventNodeLog      WorkflowEventType = "NODE_LOG"
 	WorkflowAgentNotification WorkflowEventType = "AGENT_NOTIFICATION"
+	WorkflowHumanInputRequest WorkflowEventType = "HUMAN_INPUT_REQUESTED"
+	WorkflowToolStarted       WorkflowEventType = "TOOL_STARTED"
+	WorkflowToolCompleted     WorkflowEventType = "TOOL_COMPLETED"
+	WorkflowToolFailed        WorkflowEventType = "TOOL_FAILED"
+
+	// BudgetThresholdReached is not emitted by the runner, it is delivered to
+	// the webhooks of the project when an execution makes a budget reach
+	// one of its thresholds.
+	BudgetThresholdReached WorkflowEventType = "BUDGET_THRESHOLD_REACHED"
 )
+
+// workflowEventTypes are the events a webhook can subscribe to.
+//
+//nolint:gochecknoglobals // list of the event types
+var workflowEventTypes = []WorkflowEventType{
+	WorkflowStarted,
+	WorkflowCompleted,
+	WorkflowFailed,
+	WorkflowCancelled,
+	WorkflowNodeStarted,
+	WorkflowNodeCompleted,
+	WorkflowNodeFailed,
+	WorkflowEventNodeResult,
+	WorkflowEventNodeLog,
+	WorkflowAgentNotification,
+	WorkflowHumanInputRequest,
+	WorkflowToolStarted,
+	WorkflowToolCompleted,
+	WorkflowToolFailed,
+	BudgetThresholdReached,
+}
+
+func (t WorkflowEventType) String() string {
+	return string(t)
+}
+
+func (t WorkflowEventType) IsValid() bool {
+	return slices.Contains(workflowEventTypes, t)
+}
+
+// IsTerminal reports whether no event follows this one in an execution.
+func (t WorkflowEventType) IsTerminal() bool {
+	return t == WorkflowCompleted || t == WorkflowFailed || t == WorkflowCancelled
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/supallm/core/internal/application/domain/model"
@@ -18,6 +19,7 @@ type ProjectRepository interface {
 	DeleteWorkflow(ctx context.Context, id model.WorkflowID) error
 
 	AddCredential(ctx context.Context, projectID uuid.UUID, credential *model.Credential) error
+	UpdateCredentialVerification(ctx context.Context, credential *model.Credential) error
 	DeleteCredential(ctx context.Context, id uuid.UUID) error
 }
 
@@ -25,4 +27,208 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *model.User) error
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+	UpdateUser(ctx context.Context, user *model.User) error
+	// UseSecondFactor uses up the code of a login once, unless the user was deactivated meanwhile.
+	UseSecondFactor(ctx context.Context, userID uuid.UUID, factor model.SecondFactor) error
+
+	CreateInvitation(ctx context.Context, invitation *model.Invitation) error
+	RetrieveInvitationByToken(ctx context.Context, tokenDigest string) (*model.Invitation, error)
+	// AcceptInvitation creates the user of the invitation, once.
+	AcceptInvitation(ctx context.Context, invitation *model.Invitation, user *model.User) error
+	DeleteInvitation(ctx context.Context, id uuid.UUID) error
+
+	// CreatePasswordReset replaces the pending password resets of the user.
+	CreatePasswordReset(ctx context.Context, reset *model.PasswordReset) error
+	RetrievePasswordReset(ctx context.Context, tokenDigest string) (*model.PasswordReset, error)
+	// UsePasswordReset updates the password of the user of the reset, once.
+	UsePasswordReset(ctx context.Context, reset *model.PasswordReset, user *model.User) error
+
+	CreateSession(ctx context.Context, session *model.Session) error
+	RetrieveSession(ctx context.Context, id uuid.UUID) (*model.Session, error)
+	// RetrieveSessionByToken finds the session of a current or rotated refresh token.
+	RetrieveSessionByToken(ctx context.Context, tokenDigest string) (*model.Session, error)
+	ListActiveSessions(ctx context.Context, userID uuid.UUID) ([]*model.Session, error)
+	// RotateSession saves the refreshed session, unless its previous token
+	// was already exchanged.
+	RotateSession(ctx context.Context, session *model.Session) error
+	RevokeSession(ctx context.Context, session *model.Session) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID, now time.Time) error
+}
+
+// LoginAttemptRepository counts the failed logins of an account or an address.
+// Attempts are counted as failures before the credentials are checked, so
+// concurrent logins all see the ones preceding them, and released once valid.
+type LoginAttemptRepository interface {
+	// ReserveLoginAttempt counts an attempt made at now as a failure and returns the attempts
+	// preceding it, the count is forgotten after retention without failure.
+	ReserveLoginAttempt(
+		ctx context.Context,
+		key string,
+		now time.Time,
+		retention time.Duration,
+	) (model.LoginAttempts, error)
+	// ReleaseLoginAttempt uncounts the attempt reserved at now, preceded by the previous attempts.
+	ReleaseLoginAttempt(ctx context.Context, key string, now time.Time, previous model.LoginAttempts) error
+	ResetLoginAttempts(ctx context.Context, key string) error
+}
+
+// AuditLogger appends the events to the audit log, which is never updated.
+type AuditLogger interface {
+	Record(ctx context.Context, event model.AuditEvent) error
+}
+
+// SessionDenylist rejects the access tokens of revoked sessions until they expire.
+type SessionDenylist interface {
+	Deny(ctx context.Context, sessionIDs ...uuid.UUID) error
+}
+
+// WebhookRepository defines the interface for webhook subscriptions, their deliveries
+// and the inbound webhooks triggering workflows.
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) error
+	RetrieveWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *model.Webhook) error
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	ListEnabledWebhooks(ctx context.Context, projectID uuid.UUID) ([]*model.Webhook, error)
+
+	AddDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	RetrieveDelivery(ctx context.Context, id uuid.UUID) (*model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	// ClaimDueDeliveries returns pending deliveries whose next attempt is due,
+	// they are not claimed again before the lease expires.
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*model.WebhookDelivery, error)
+
+	CreateWebhookTrigger(ctx context.Context, trigger *model.WebhookTrigger) error
+	RetrieveWebhookTrigger(ctx context.Context, id uuid.UUID) (*model.WebhookTrigger, error)
+	UpdateWebhookTrigger(ctx context.Context, trigger *model.WebhookTrigger) error
+	DeleteWebhookTrigger(ctx context.Context, id uuid.UUID) error
+}
+
+// ScheduleRepository defines the interface for the cron schedules of workflows.
+type ScheduleRepository interface {
+	CreateSchedule(ctx context.Context, schedule *model.Schedule) error
+	RetrieveSchedule(ctx context.Context, id uuid.UUID) (*model.Schedule, error)
+	UpdateSchedule(ctx context.Context, schedule *model.Schedule) error
+	// RecordScheduleFire stores the fire unless the schedule was disabled,
+	// edited or fired since its next fire was previousFireAt.
+	RecordScheduleFire(ctx context.Context, schedule *model.Schedule, previousFireAt time.Time) (bool, error)
+	RecordScheduleError(ctx context.Context, id uuid.UUID, lastError string) error
+	// DisableSchedule disables the schedule unless it was edited or fired since read.
+	DisableSchedule(ctx context.Context, schedule *model.Schedule) error
+	DeleteSchedule(ctx context.Context, id uuid.UUID) error
+	// ListDueSchedules returns the enabled schedules whose next fire is at or before now.
+	ListDueSchedules(ctx context.Context, now time.Time, limit int) ([]*model.Schedule, error)
+}
+
+// BatchRepository defines the interface for the batches of workflow executions.
+type BatchRepository interface {
+	CreateBatch(ctx context.Context, batch *model.Batch) error
+	ListRunningBatchIDs(ctx context.Context) ([]uuid.UUID, error)
+	// ClaimBatchItems marks as running the pending items of a running batch that fit
+	// in its concurrency, it returns no batch when another replica is claiming it.
+	// The items claimed longer than the lease and still not queued are claimed
+	// again, the ones queued longer than the timeout are failed.
+	ClaimBatchItems(
+		ctx context.Context,
+		batchID uuid.UUID,
+		lease time.Duration,
+		timeout time.Duration,
+	) (*model.Batch, []*model.BatchItem, error)
+	// MarkBatchItemQueued records that the workflow of a claimed item is queued.
+	MarkBatchItemQueued(ctx context.Context, triggerID uuid.UUID) error
+	RetrieveBatchItem(ctx context.Context, triggerID uuid.UUID) (*model.BatchItem, error)
+	// FinishBatchItem records the outcome of a running item and counts it in the
+	// progress of its batch, items already finished are left untouched.
+	FinishBatchItem(ctx context.Context, item *model.BatchItem) error
+}
+
+// EvalRepository defines the interface for the datasets and their evaluation runs.
+type EvalRepository interface {
+	CreateDataset(ctx context.Context, dataset *model.Dataset, cases []model.DatasetCase) error
+	RetrieveDataset(ctx context.Context, id uuid.UUID) (*model.Dataset, error)
+	UpdateDataset(ctx context.Context, dataset *model.Dataset) error
+	DeleteDataset(ctx context.Context, id uuid.UUID) error
+	CountDatasetCases(ctx context.Context, datasetID uuid.UUID) (int, error)
+	AddDatasetCases(ctx context.Context, datasetID uuid.UUID, cases []model.DatasetCase) error
+	ListDatasetCases(ctx context.Context, datasetID uuid.UUID) ([]model.DatasetCase, error)
+
+	// CreateEvalRun stores the evaluation together with the batch executing it.
+	CreateEvalRun(ctx context.Context, run *model.EvalRun, batch *model.Batch) error
+	// ClaimScorableEvalRuns returns running evaluations whose batch is completed,
+	// they are not claimed again before the lease expires.
+	ClaimScorableEvalRuns(ctx context.Context, limit int, lease time.Duration) ([]*model.EvalRun, error)
+	ListEvalCases(ctx context.Context, runID uuid.UUID) ([]*model.EvalCase, error)
+	CompleteEvalRun(ctx context.Context, run *model.EvalRun, cases []*model.EvalCase) error
+}
+
+// RolloutRepository defines the interface for the traffic splits of workflows
+// and the executions recording the variant they ran.
+type RolloutRepository interface {
+	SaveTrafficSplit(ctx context.Context, split *model.TrafficSplit) error
+	RetrieveTrafficSplit(
+		ctx context.Context,
+		projectID uuid.UUID,
+		workflowID model.WorkflowID,
+	) (*model.TrafficSplit, error)
+	DeleteTrafficSplit(ctx context.Context, workflowID model.WorkflowID) error
+
+	CreateExecution(ctx context.Context, execution *model.Execution) error
+	RetrieveExecution(ctx context.Context, triggerID uuid.UUID) (*model.Execution, error)
+	// FinishExecution records the outcome of a running execution,
+	// executions already finished are left untouched.
+	FinishExecution(ctx context.Context, execution *model.Execution) error
+}
+
+// UsageRepository defines the interface for the token usage of executions.
+type UsageRepository interface {
+	// AddNodeUsage records the usage once per reporting event.
+	AddNodeUsage(ctx context.Context, usage *model.NodeUsage) error
+}
+
+// BudgetRepository defines the interface for the budgets of projects
+// and the spend counted against them.
+type BudgetRepository interface {
+	CreateBudget(ctx context.Context, budget *model.Budget) error
+	RetrieveBudget(ctx context.Context, id uuid.UUID) (*model.Budget, error)
+	UpdateBudget(ctx context.Context, budget *model.Budget) error
+	DeleteBudget(ctx context.Context, id uuid.UUID) error
+	ListBudgets(ctx context.Context, projectID uuid.UUID) ([]*model.Budget, error)
+	// MarkBudgetAlerted records the alert of the budget with the deliveries
+	// announcing it, false and nothing stored when a higher or equal threshold
+	// of the period was already recorded.
+	MarkBudgetAlerted(ctx context.Context, budget *model.Budget, deliveries []*model.WebhookDelivery) (bool, error)
+	// Spends returns the cost counted against each budget in its period at
+	// the time, keyed by budget ID.
+	Spends(ctx context.Context, budgets []*model.Budget, at time.Time) (map[uuid.UUID]float64, error)
+}
+
+// RateLimitRepository defines the interface for the trigger rate limits of projects.
+type RateLimitRepository interface {
+	// SaveRateLimit creates or replaces the limit of the project or workflow.
+	SaveRateLimit(ctx context.Context, limit *model.RateLimit) error
+	DeleteRateLimit(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) error
+	// RetrieveRateLimit returns the limit of the workflow, or else the one of its project.
+	RetrieveRateLimit(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) (*model.RateLimit, error)
+}
+
+// RateLimiter counts the triggers in token buckets shared by the replicas.
+type RateLimiter interface {
+	// Take takes a trigger from every bucket, or from none of them when one is empty.
+	Take(ctx context.Context, buckets []model.RateBucket) (model.RateDecision, error)
+}
+
+// AccessRepository defines the interface for the organizations and the roles
+// their members and the project members hold.
+type AccessRepository interface {
+	CreateOrganization(ctx context.Context, organization *model.Organization) error
+	RetrieveOrganization(ctx context.Context, id uuid.UUID) (*model.Organization, error)
+	SaveOrganizationMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, role model.Role) error
+	DeleteOrganizationMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) error
+
+	SaveProjectMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID, role model.Role) error
+	DeleteProjectMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) error
+	// ProjectRoles returns the roles the user holds on the project, as a project member,
+	// as a member of its organization and as its creator.
+	ProjectRoles(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) ([]model.Role, error)
+	ProjectHasWorkflow(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) (bool, error)
 }
@@ -10,11 +10,48 @@ type WorkflowEventMessage struct {
 	Sequence   uint64                  `json:"sequence,omitempty"`
 	Type       model.WorkflowEventType `json:"type"`
 	WorkflowID model.WorkflowID        `json:"workflowId"`
+	ProjectID  uuid.UUID               `json:"projectId"`
 	TriggerID  uuid.UUID               `json:"triggerId"`
 	SessionID  uuid.UUID               `json:"sessionId"`
+	EndUserID  string                  `json:"endUserId,omitempty"`
 	Data       map[string]any          `json:"data"`
 }
 
+const (
+	dataNodeIDKey      = "nodeId"
+	dataOutputFieldKey = "outputField"
+	dataIOTypeKey      = "ioType"
+	dataChunkKey       = "data"
+
+	textIOType = "text"
+)
+
+// NodeID returns the node emitting the event, empty for workflow events.
+func (e WorkflowEventMessage) NodeID() string {
+	id, _ := e.Data[dataNodeIDKey].(string)
+	return id
+}
+
+// ResultKey returns the output field of node results and agent notifications.
+func (e WorkflowEventMessage) ResultKey() string {
+	key, _ := e.Data[dataOutputFieldKey].(string)
+	return key
+}
+
+// TextDelta returns the text chunk carried by a streamed node result.
+func (e WorkflowEventMessage) TextDelta() (string, bool) {
+	if e.Type != model.WorkflowEventNodeResult {
+		return "", false
+	}
+
+	if ioType, _ := e.Data[dataIOTypeKey].(string); ioType != textIOType {
+		return "", false
+	}
+
+	chunk, ok := e.Data[dataChunkKey].(string)
+	return chunk, ok
+}
+
 func SetCorrelationID(msg *message.Message, correlationID string) {
 	msg.Metadata.Set(correlationIDMessageMetadataKey, correlationID)
 }
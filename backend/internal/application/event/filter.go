@@ -0,0 +1,61 @@
+package event
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+)
+
+// Filter selects the workflow events streamed to a client.
+// Zero values match every event. Nodes only filter node events
+// and result keys the events carrying an output field.
+type Filter struct {
+	ProjectID   uuid.UUID
+	SessionID   uuid.UUID
+	TriggerID   uuid.UUID
+	Types       []model.WorkflowEventType
+	WorkflowIDs []model.WorkflowID
+	NodeIDs     []string
+	ResultKeys  []string
+
+	// Listener is the end-user receiving the events, who only receives the
+	// events of their own triggers. Nil receives them all.
+	Listener *string
+}
+
+func (f Filter) Match(e WorkflowEventMessage) bool {
+	if f.ProjectID != uuid.Nil && e.ProjectID != f.ProjectID {
+		return false
+	}
+
+	if f.SessionID != uuid.Nil && e.SessionID != f.SessionID {
+		return false
+	}
+
+	if f.TriggerID != uuid.Nil && e.TriggerID != f.TriggerID {
+		return false
+	}
+
+	if f.Listener != nil && e.EndUserID != *f.Listener {
+		return false
+	}
+
+	if len(f.Types) > 0 && !slices.Contains(f.Types, e.Type) {
+		return false
+	}
+
+	if len(f.WorkflowIDs) > 0 && !slices.Contains(f.WorkflowIDs, e.WorkflowID) {
+		return false
+	}
+
+	if nodeID := e.NodeID(); nodeID != "" && len(f.NodeIDs) > 0 && !slices.Contains(f.NodeIDs, nodeID) {
+		return false
+	}
+
+	if key := e.ResultKey(); key != "" && len(f.ResultKeys) > 0 && !slices.Contains(f.ResultKeys, key) {
+		return false
+	}
+
+	return true
+}
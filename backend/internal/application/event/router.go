@@ -16,15 +16,26 @@ import (
 
 const (
 	// Downstream topics (API → runner)
-	DownstreamWorkflowRunTopic = "workflows:downstream:run" // queue of workflows to run by runners
+	DownstreamWorkflowRunTopic     = "workflows:downstream:run"     // queue of workflows to run by runners
+	DownstreamWorkflowControlTopic = "workflows:downstream:control" // cancellations and human inputs for running workflows
 
 	// Upstream topics (runner → API)
 	UpstreamWorkflowEventDispatchTopic = "workflows:upstream:events:dispatch" // dispatch workflow events
 
+	// Broadcast topics (API → every API replica)
+	BroadcastWorkflowEventsTopic = "workflows:broadcast:events" // stored workflow events fanned out to all replicas
+
 	// Internal topics
 	InternalEventsTopic = "workflows:internal:events" // merged workflow events for clients stream
 
+	// Consumer groups
+	storeEventsConsumerGroup      = "api:events:store"      // one replica stores each upstream event
+	dispatchWebhooksConsumerGroup = "api:webhooks:dispatch" // one replica queues the webhook deliveries of each event
+	trackBatchesConsumerGroup     = "api:batches:track"     // one replica records the batch item results of each event
+	trackExecutionsConsumerGroup  = "api:executions:track"  // one replica records the outcome of each execution
+
 	maxQueueLen         = 400
+	maxBroadcastLen     = 10000
 	outputChannelBuffer = 2500
 )
 
@@ -32,6 +43,21 @@ type EventStore interface {
 	StoreEvent(ctx context.Context, event *WorkflowEventMessage) ([]byte, error)
 }
 
+// WebhookDispatcher queues the deliveries of a stored event to the webhooks subscribed to it.
+type WebhookDispatcher interface {
+	DispatchEvent(ctx context.Context, event WorkflowEventMessage, payload []byte) error
+}
+
+// BatchTracker records the terminal events of the executions started by a batch.
+type BatchTracker interface {
+	TrackEvent(ctx context.Context, event WorkflowEventMessage) error
+}
+
+// ExecutionTracker records the events of the executions on their variant.
+type ExecutionTracker interface {
+	TrackEvent(ctx context.Context, event WorkflowEventMessage) error
+}
+
 type EventRouter struct {
 	router             *message.Router
 	InternalSubscriber message.Subscriber
@@ -41,9 +67,13 @@ type EventRouter struct {
 }
 
 type Config struct {
-	WorkflowsRedis *redis.Client
-	Logger         watermill.LoggerAdapter
-	EventStore     EventStore
+	WorkflowsRedis    *redis.Client
+	Logger            watermill.LoggerAdapter
+	EventStore        EventStore
+	WebhookDispatcher WebhookDispatcher
+	BatchTracker      BatchTracker
+	ExecutionTracker  ExecutionTracker
+	InstanceID        string
 }
 
 func CreateRouter(config Config) *EventRouter {
@@ -64,9 +94,33 @@ func CreateRouter(config Config) *EventRouter {
 		config.Logger,
 	)
 
-	subscriber, err := createSubscriber(config, "")
+	storeSubscriber, err := createSubscriber(config, storeEventsConsumerGroup)
+	if err != nil {
+		slog.Error("error creating redis stream store subscriber", "error", err)
+		os.Exit(1)
+	}
+
+	webhooksSubscriber, err := createSubscriber(config, dispatchWebhooksConsumerGroup)
+	if err != nil {
+		slog.Error("error creating redis stream webhooks subscriber", "error", err)
+		os.Exit(1)
+	}
+
+	batchesSubscriber, err := createSubscriber(config, trackBatchesConsumerGroup)
+	if err != nil {
+		slog.Error("error creating redis stream batches subscriber", "error", err)
+		os.Exit(1)
+	}
+
+	executionsSubscriber, err := createSubscriber(config, trackExecutionsConsumerGroup)
+	if err != nil {
+		slog.Error("error creating redis stream executions subscriber", "error", err)
+		os.Exit(1)
+	}
+
+	broadcastSubscriber, err := createSubscriber(config, "")
 	if err != nil {
-		slog.Error("error creating redis stream subscriber", "error", err)
+		slog.Error("error creating redis stream broadcast subscriber", "error", err)
 		os.Exit(1)
 	}
 
@@ -76,16 +130,24 @@ func CreateRouter(config Config) *EventRouter {
 		os.Exit(1)
 	}
 
+	broadcastPublisher, err := createPublisher(config, maxBroadcastLen)
+	if err != nil {
+		slog.Error("error creating redis stream broadcast publisher", "error", err)
+		os.Exit(1)
+	}
+
+	// events are consumed by a single replica through a consumer group,
+	// so sequence numbers are incremented exactly once per event.
 	router.AddHandler(
-		"runner:to:sse",
+		"runner:to:store",
 		UpstreamWorkflowEventDispatchTopic,
-		subscriber,
-		InternalEventsTopic,
-		internalPubSub,
+		storeSubscriber,
+		BroadcastWorkflowEventsTopic,
+		broadcastPublisher,
 		func(msg *message.Message) (messages []*message.Message, err error) {
 			defer func() {
 				if err != nil {
-					config.Logger.Error("error while dispatching SSE event message", err, nil)
+					config.Logger.Error("error while storing workflow event message", err, nil)
 				}
 			}()
 
@@ -99,8 +161,73 @@ func CreateRouter(config Config) *EventRouter {
 				return nil, err
 			}
 
-			msg = message.NewMessage(msg.UUID, e)
-			return []*message.Message{msg}, nil
+			stored := message.NewMessage(msg.UUID, e)
+			stored.Metadata = msg.Metadata
+			return []*message.Message{stored}, nil
+		},
+	)
+
+	// stored events are read in fan-out mode, so every replica
+	// can stream them to the SSE clients connected to it.
+	router.AddHandler(
+		"broadcast:to:sse",
+		BroadcastWorkflowEventsTopic,
+		broadcastSubscriber,
+		InternalEventsTopic,
+		internalPubSub,
+		func(msg *message.Message) ([]*message.Message, error) {
+			return []*message.Message{message.NewMessage(msg.UUID, msg.Payload)}, nil
+		},
+	)
+
+	// stored events carry their sequence, webhooks receive them as clients do.
+	router.AddNoPublisherHandler(
+		"broadcast:to:webhooks",
+		BroadcastWorkflowEventsTopic,
+		webhooksSubscriber,
+		func(msg *message.Message) error {
+			var event WorkflowEventMessage
+			if err := json.Unmarshal(msg.Payload, &event); err != nil {
+				// malformed events would be redelivered forever
+				config.Logger.Error("error unmarshalling workflow event message", err, nil)
+				return nil
+			}
+
+			return config.WebhookDispatcher.DispatchEvent(msg.Context(), event, msg.Payload)
+		},
+	)
+
+	router.AddNoPublisherHandler(
+		"broadcast:to:batches",
+		BroadcastWorkflowEventsTopic,
+		batchesSubscriber,
+		func(msg *message.Message) error {
+			var event WorkflowEventMessage
+			if err := json.Unmarshal(msg.Payload, &event); err != nil {
+				config.Logger.Error("error unmarshalling workflow event message", err, nil)
+				return nil
+			}
+
+			if !event.Type.IsTerminal() {
+				return nil
+			}
+
+			return config.BatchTracker.TrackEvent(msg.Context(), event)
+		},
+	)
+
+	router.AddNoPublisherHandler(
+		"broadcast:to:executions",
+		BroadcastWorkflowEventsTopic,
+		executionsSubscriber,
+		func(msg *message.Message) error {
+			var event WorkflowEventMessage
+			if err := json.Unmarshal(msg.Payload, &event); err != nil {
+				config.Logger.Error("error unmarshalling workflow event message", err, nil)
+				return nil
+			}
+
+			return config.ExecutionTracker.TrackEvent(msg.Context(), event)
 		},
 	)
 
@@ -133,6 +260,7 @@ func createSubscriber(config Config, consumerGroup string) (message.Subscriber,
 		redisstream.SubscriberConfig{
 			Client:        config.WorkflowsRedis,
 			Unmarshaller:  redisstream.DefaultMarshallerUnmarshaller{},
+			Consumer:      config.InstanceID,
 			ConsumerGroup: consumerGroup,
 		},
 		config.Logger,
@@ -0,0 +1,102 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type CompareEvalRunsQuery struct {
+	ProjectID uuid.UUID
+	BaseID    uuid.UUID
+	HeadID    uuid.UUID
+}
+
+type CompareEvalRunsHandler struct {
+	evalReader EvalReader
+}
+
+func NewCompareEvalRunsHandler(evalReader EvalReader) CompareEvalRunsHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return CompareEvalRunsHandler{
+		evalReader: evalReader,
+	}
+}
+
+// Handle compares two completed runs of the same dataset, which may evaluate
+// different revisions or different workflows.
+func (h CompareEvalRunsHandler) Handle(ctx context.Context, query CompareEvalRunsQuery) (EvalComparison, error) {
+	base, err := readProjectEvalRun(ctx, h.evalReader, query.ProjectID, query.BaseID)
+	if err != nil {
+		return EvalComparison{}, err
+	}
+
+	head, err := readProjectEvalRun(ctx, h.evalReader, query.ProjectID, query.HeadID)
+	if err != nil {
+		return EvalComparison{}, err
+	}
+
+	if base.Status != string(model.EvalRunCompleted) || head.Status != string(model.EvalRunCompleted) {
+		return EvalComparison{}, errs.ConstraintError{Condition: "a running eval run"}
+	}
+
+	if base.DatasetID == nil || head.DatasetID == nil || *base.DatasetID != *head.DatasetID {
+		return EvalComparison{}, errs.InvalidError{Field: "head", Reason: "runs must evaluate the same dataset"}
+	}
+
+	baseCases, err := h.evalReader.ReadEvalCases(ctx, base.ID)
+	if err != nil {
+		return EvalComparison{}, errs.InternalError{Err: err}
+	}
+
+	headCases, err := h.evalReader.ReadEvalCases(ctx, head.ID)
+	if err != nil {
+		return EvalComparison{}, errs.InternalError{Err: err}
+	}
+
+	return compareEvalRuns(base, head, baseCases, headCases), nil
+}
+
+func compareEvalRuns(base EvalRun, head EvalRun, baseCases []EvalCase, headCases []EvalCase) EvalComparison {
+	comparison := EvalComparison{
+		Base:          base,
+		Head:          head,
+		PassRateDelta: head.Metrics.PassRate - base.Metrics.PassRate,
+		Scorers:       make(map[string]ScorerComparison),
+		Regressions:   []int{},
+		Improvements:  []int{},
+	}
+
+	for name, h := range head.Metrics.Scorers {
+		b, ok := base.Metrics.Scorers[name]
+		if !ok {
+			continue
+		}
+
+		comparison.Scorers[name] = ScorerComparison{
+			BaseMean: b.Mean,
+			HeadMean: h.Mean,
+			Delta:    h.Mean - b.Mean,
+		}
+	}
+
+	// cases appended to the dataset after the base run are not compared
+	for i := 0; i < len(baseCases) && i < len(headCases); i++ {
+		switch {
+		case baseCases[i].Passed && !headCases[i].Passed:
+			comparison.Regressions = append(comparison.Regressions, headCases[i].Index)
+		case !baseCases[i].Passed && headCases[i].Passed:
+			comparison.Improvements = append(comparison.Improvements, headCases[i].Index)
+		}
+	}
+
+	return comparison
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// maxAuditExportEvents bounds the size of an export, narrower ranges
+// export the rest of the log.
+const maxAuditExportEvents = 10000
+
+type ExportAuditEventsQuery struct {
+	Filter AuditEventFilter
+}
+
+type ExportAuditEventsHandler struct {
+	auditReader AuditReader
+}
+
+func NewExportAuditEventsHandler(auditReader AuditReader) ExportAuditEventsHandler {
+	if auditReader == nil {
+		slog.Error("auditReader is nil")
+		os.Exit(1)
+	}
+
+	return ExportAuditEventsHandler{
+		auditReader: auditReader,
+	}
+}
+
+// Handle returns the events of the filter, most recent first, up to
+// maxAuditExportEvents. The export is rejected when more events match.
+func (h ExportAuditEventsHandler) Handle(ctx context.Context, query ExportAuditEventsQuery) ([]AuditEvent, error) {
+	if err := validateAuditRange(query.Filter); err != nil {
+		return nil, err
+	}
+
+	events, err := h.auditReader.ListAuditEvents(ctx, query.Filter, maxAuditExportEvents+1)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	if len(events) > maxAuditExportEvents {
+		return nil, errs.InvalidError{
+			Field:  "from",
+			Reason: "too many events to export, narrow the range",
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetBatchQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	BatchID    uuid.UUID
+}
+
+type GetBatchHandler struct {
+	batchReader BatchReader
+}
+
+func NewGetBatchHandler(batchReader BatchReader) GetBatchHandler {
+	if batchReader == nil {
+		slog.Error("batchReader is nil")
+		os.Exit(1)
+	}
+
+	return GetBatchHandler{
+		batchReader: batchReader,
+	}
+}
+
+func (h GetBatchHandler) Handle(ctx context.Context, query GetBatchQuery) (Batch, error) {
+	return readWorkflowBatch(ctx, h.batchReader, query.ProjectID, query.WorkflowID, query.BatchID)
+}
+
+// readWorkflowBatch returns the batch only when it belongs to the workflow.
+func readWorkflowBatch(
+	ctx context.Context,
+	batchReader BatchReader,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	batchID uuid.UUID,
+) (Batch, error) {
+	batch, err := batchReader.ReadBatch(ctx, projectID, batchID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return Batch{}, errs.NotFoundError{Resource: "batch", ID: batchID, Err: err}
+		}
+		return Batch{}, errs.InternalError{Err: err}
+	}
+
+	if batch.WorkflowID != workflowID.String() {
+		return Batch{}, errs.NotFoundError{Resource: "batch", ID: batchID}
+	}
+
+	return batch, nil
+}
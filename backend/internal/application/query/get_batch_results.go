@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetBatchResultsQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	BatchID    uuid.UUID
+}
+
+type GetBatchResultsHandler struct {
+	batchReader BatchReader
+}
+
+func NewGetBatchResultsHandler(batchReader BatchReader) GetBatchResultsHandler {
+	if batchReader == nil {
+		slog.Error("batchReader is nil")
+		os.Exit(1)
+	}
+
+	return GetBatchResultsHandler{
+		batchReader: batchReader,
+	}
+}
+
+// Handle returns the items of the batch in input order, once all of them are finished.
+func (h GetBatchResultsHandler) Handle(ctx context.Context, query GetBatchResultsQuery) ([]BatchItem, error) {
+	batch, err := readWorkflowBatch(ctx, h.batchReader, query.ProjectID, query.WorkflowID, query.BatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch.Status != string(model.BatchCompleted) {
+		return nil, errs.ConstraintError{Condition: "a running batch"}
+	}
+
+	items, err := h.batchReader.ListBatchItems(ctx, query.BatchID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return items, nil
+}
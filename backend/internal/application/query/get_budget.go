@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetBudgetQuery struct {
+	ProjectID uuid.UUID
+	BudgetID  uuid.UUID
+}
+
+type GetBudgetHandler struct {
+	budgetReader BudgetReader
+}
+
+func NewGetBudgetHandler(budgetReader BudgetReader) GetBudgetHandler {
+	if budgetReader == nil {
+		slog.Error("budgetReader is nil")
+		os.Exit(1)
+	}
+
+	return GetBudgetHandler{
+		budgetReader: budgetReader,
+	}
+}
+
+func (h GetBudgetHandler) Handle(ctx context.Context, query GetBudgetQuery) (Budget, error) {
+	budget, err := h.budgetReader.ReadBudget(ctx, query.ProjectID, query.BudgetID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return Budget{}, errs.NotFoundError{Resource: "budget", ID: query.BudgetID, Err: err}
+		}
+		return Budget{}, errs.InternalError{Err: err}
+	}
+
+	return budget, nil
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetDatasetQuery struct {
+	ProjectID uuid.UUID
+	DatasetID uuid.UUID
+}
+
+type GetDatasetHandler struct {
+	evalReader EvalReader
+}
+
+func NewGetDatasetHandler(evalReader EvalReader) GetDatasetHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return GetDatasetHandler{
+		evalReader: evalReader,
+	}
+}
+
+func (h GetDatasetHandler) Handle(ctx context.Context, query GetDatasetQuery) (Dataset, error) {
+	return readProjectDataset(ctx, h.evalReader, query.ProjectID, query.DatasetID)
+}
+
+func readProjectDataset(
+	ctx context.Context,
+	evalReader EvalReader,
+	projectID uuid.UUID,
+	datasetID uuid.UUID,
+) (Dataset, error) {
+	dataset, err := evalReader.ReadDataset(ctx, projectID, datasetID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return Dataset{}, errs.NotFoundError{Resource: "dataset", ID: datasetID, Err: err}
+		}
+		return Dataset{}, errs.InternalError{Err: err}
+	}
+
+	return dataset, nil
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetEvalRunQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	EvalRunID  uuid.UUID
+}
+
+type GetEvalRunHandler struct {
+	evalReader EvalReader
+}
+
+func NewGetEvalRunHandler(evalReader EvalReader) GetEvalRunHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return GetEvalRunHandler{
+		evalReader: evalReader,
+	}
+}
+
+func (h GetEvalRunHandler) Handle(ctx context.Context, query GetEvalRunQuery) (EvalRun, error) {
+	run, err := readProjectEvalRun(ctx, h.evalReader, query.ProjectID, query.EvalRunID)
+	if err != nil {
+		return EvalRun{}, err
+	}
+
+	if run.WorkflowID != query.WorkflowID.String() {
+		return EvalRun{}, errs.NotFoundError{Resource: "eval run", ID: query.EvalRunID}
+	}
+
+	return run, nil
+}
+
+func readProjectEvalRun(
+	ctx context.Context,
+	evalReader EvalReader,
+	projectID uuid.UUID,
+	runID uuid.UUID,
+) (EvalRun, error) {
+	run, err := evalReader.ReadEvalRun(ctx, projectID, runID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return EvalRun{}, errs.NotFoundError{Resource: "eval run", ID: runID, Err: err}
+		}
+		return EvalRun{}, errs.InternalError{Err: err}
+	}
+
+	return run, nil
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetExecutionUsageQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	TriggerID  uuid.UUID
+}
+
+type GetExecutionUsageHandler struct {
+	usageReader UsageReader
+}
+
+func NewGetExecutionUsageHandler(usageReader UsageReader) GetExecutionUsageHandler {
+	if usageReader == nil {
+		slog.Error("usageReader is nil")
+		os.Exit(1)
+	}
+
+	return GetExecutionUsageHandler{
+		usageReader: usageReader,
+	}
+}
+
+// Handle returns the usage of an execution by node and model,
+// an execution without LLM calls has no nodes.
+func (h GetExecutionUsageHandler) Handle(ctx context.Context, query GetExecutionUsageQuery) (ExecutionUsage, error) {
+	nodes, err := h.usageReader.ReadExecutionUsage(ctx, query.ProjectID, query.WorkflowID, query.TriggerID)
+	if err != nil {
+		return ExecutionUsage{}, errs.InternalError{Err: err}
+	}
+
+	usage := ExecutionUsage{
+		TriggerID: query.TriggerID,
+		Nodes:     nodes,
+		Total: UsageTotals{
+			Executions:   0,
+			Calls:        0,
+			InputTokens:  0,
+			OutputTokens: 0,
+			CostUSD:      0,
+			Unpriced:     0,
+		},
+	}
+	for _, node := range nodes {
+		usage.Total.Executions = 1
+		usage.Total.Calls += node.Calls
+		usage.Total.InputTokens += node.InputTokens
+		usage.Total.OutputTokens += node.OutputTokens
+		usage.Total.CostUSD += node.CostUSD
+		usage.Total.Unpriced += node.Unpriced
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const defaultUsageWindow = 30 * 24 * time.Hour
+
+type GetProjectUsageQuery struct {
+	ProjectID uuid.UUID
+	// From defaults to 30 days before To, To defaults to now.
+	From *time.Time
+	To   *time.Time
+}
+
+type GetProjectUsageHandler struct {
+	usageReader UsageReader
+}
+
+func NewGetProjectUsageHandler(usageReader UsageReader) GetProjectUsageHandler {
+	if usageReader == nil {
+		slog.Error("usageReader is nil")
+		os.Exit(1)
+	}
+
+	return GetProjectUsageHandler{
+		usageReader: usageReader,
+	}
+}
+
+// Handle aggregates the usage of the project over the range, in total,
+// by model and by day.
+func (h GetProjectUsageHandler) Handle(ctx context.Context, query GetProjectUsageQuery) (ProjectUsage, error) {
+	from, to, err := usageRange(query.From, query.To)
+	if err != nil {
+		return ProjectUsage{}, err
+	}
+
+	total, err := h.usageReader.ReadUsageTotals(ctx, query.ProjectID, from, to)
+	if err != nil {
+		return ProjectUsage{}, errs.InternalError{Err: err}
+	}
+
+	models, err := h.usageReader.ListModelUsage(ctx, query.ProjectID, from, to)
+	if err != nil {
+		return ProjectUsage{}, errs.InternalError{Err: err}
+	}
+
+	daily, err := h.usageReader.ListDailyUsage(ctx, query.ProjectID, from, to)
+	if err != nil {
+		return ProjectUsage{}, errs.InternalError{Err: err}
+	}
+
+	return ProjectUsage{
+		From:   from,
+		To:     to,
+		Total:  total,
+		Models: models,
+		Daily:  daily,
+	}, nil
+}
+
+// usageRange resolves the time range of a usage query, From is inclusive
+// and To exclusive.
+func usageRange(from, to *time.Time) (time.Time, time.Time, error) {
+	end := time.Now()
+	if to != nil {
+		end = *to
+	}
+
+	start := end.Add(-defaultUsageWindow)
+	if from != nil {
+		start = *from
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errs.InvalidError{Field: "from", Reason: "from must be before to"}
+	}
+	return start, end, nil
+}
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetScheduleQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	ScheduleID uuid.UUID
+}
+
+type GetScheduleHandler struct {
+	scheduleReader ScheduleReader
+}
+
+func NewGetScheduleHandler(scheduleReader ScheduleReader) GetScheduleHandler {
+	if scheduleReader == nil {
+		slog.Error("scheduleReader is nil")
+		os.Exit(1)
+	}
+
+	return GetScheduleHandler{
+		scheduleReader: scheduleReader,
+	}
+}
+
+func (h GetScheduleHandler) Handle(ctx context.Context, query GetScheduleQuery) (Schedule, error) {
+	schedule, err := h.scheduleReader.ReadSchedule(ctx, query.ProjectID, query.ScheduleID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return Schedule{}, errs.NotFoundError{Resource: "schedule", ID: query.ScheduleID, Err: err}
+		}
+		return Schedule{}, errs.InternalError{Err: err}
+	}
+
+	if schedule.WorkflowID != query.WorkflowID.String() {
+		return Schedule{}, errs.NotFoundError{Resource: "schedule", ID: query.ScheduleID}
+	}
+
+	return schedule, nil
+}
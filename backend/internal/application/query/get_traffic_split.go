@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetTrafficSplitQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type GetTrafficSplitHandler struct {
+	rolloutReader RolloutReader
+}
+
+func NewGetTrafficSplitHandler(rolloutReader RolloutReader) GetTrafficSplitHandler {
+	if rolloutReader == nil {
+		slog.Error("rolloutReader is nil")
+		os.Exit(1)
+	}
+
+	return GetTrafficSplitHandler{
+		rolloutReader: rolloutReader,
+	}
+}
+
+func (h GetTrafficSplitHandler) Handle(ctx context.Context, query GetTrafficSplitQuery) (TrafficSplit, error) {
+	split, err := h.rolloutReader.ReadTrafficSplit(ctx, query.ProjectID, query.WorkflowID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return TrafficSplit{}, errs.NotFoundError{Resource: "traffic split", ID: query.WorkflowID, Err: err}
+		}
+		return TrafficSplit{}, errs.InternalError{Err: err}
+	}
+
+	return split, nil
+}
@@ -15,16 +15,26 @@ type GetTriggerExecutionQuery struct {
 
 type GetTriggerExecutionHandler struct {
 	executionReader ExecutionReader
+	rolloutReader   RolloutReader
 }
 
-func NewGetTriggerExecutionHandler(executionReader ExecutionReader) GetTriggerExecutionHandler {
+func NewGetTriggerExecutionHandler(
+	executionReader ExecutionReader,
+	rolloutReader RolloutReader,
+) GetTriggerExecutionHandler {
 	if executionReader == nil {
 		slog.Error("executionReader is nil")
 		os.Exit(1)
 	}
 
+	if rolloutReader == nil {
+		slog.Error("rolloutReader is nil")
+		os.Exit(1)
+	}
+
 	return GetTriggerExecutionHandler{
 		executionReader: executionReader,
+		rolloutReader:   rolloutReader,
 	}
 }
 
@@ -34,5 +44,9 @@ func (h GetTriggerExecutionHandler) Handle(ctx context.Context, query GetTrigger
 		return Execution{}, err
 	}
 
-	return execution, nil
+	executions := []Execution{execution}
+	if err = withVariants(ctx, h.rolloutReader, executions); err != nil {
+		return Execution{}, err
+	}
+	return executions[0], nil
 }
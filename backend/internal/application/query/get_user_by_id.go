@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetUserByIDQuery struct {
+	ID uuid.UUID
+}
+
+type GetUserByIDHandler struct {
+	userReader UserReader
+}
+
+func NewGetUserByIDHandler(userReader UserReader) GetUserByIDHandler {
+	if userReader == nil {
+		slog.Error("userReader is nil")
+		os.Exit(1)
+	}
+
+	return GetUserByIDHandler{
+		userReader: userReader,
+	}
+}
+
+func (h GetUserByIDHandler) Handle(ctx context.Context, query GetUserByIDQuery) (User, error) {
+	user, err := h.userReader.ReadUserByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return User{}, errs.NotFoundError{Resource: "user", ID: query.ID, Err: err}
+		}
+		return User{}, errs.InternalError{Err: err}
+	}
+
+	return user, nil
+}
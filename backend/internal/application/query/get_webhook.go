@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetWebhookQuery struct {
+	ProjectID uuid.UUID
+	WebhookID uuid.UUID
+}
+
+type GetWebhookHandler struct {
+	webhookReader WebhookReader
+}
+
+func NewGetWebhookHandler(webhookReader WebhookReader) GetWebhookHandler {
+	if webhookReader == nil {
+		slog.Error("webhookReader is nil")
+		os.Exit(1)
+	}
+
+	return GetWebhookHandler{
+		webhookReader: webhookReader,
+	}
+}
+
+func (h GetWebhookHandler) Handle(ctx context.Context, query GetWebhookQuery) (Webhook, error) {
+	webhook, err := h.webhookReader.ReadWebhook(ctx, query.ProjectID, query.WebhookID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return Webhook{}, errs.NotFoundError{Resource: "webhook", ID: query.WebhookID, Err: err}
+		}
+		return Webhook{}, errs.InternalError{Err: err}
+	}
+
+	return webhook, nil
+}
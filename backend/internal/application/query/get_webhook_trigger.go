@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetWebhookTriggerQuery struct {
+	ProjectID        uuid.UUID
+	WorkflowID       model.WorkflowID
+	WebhookTriggerID uuid.UUID
+}
+
+type GetWebhookTriggerHandler struct {
+	webhookReader WebhookReader
+}
+
+func NewGetWebhookTriggerHandler(webhookReader WebhookReader) GetWebhookTriggerHandler {
+	if webhookReader == nil {
+		slog.Error("webhookReader is nil")
+		os.Exit(1)
+	}
+
+	return GetWebhookTriggerHandler{
+		webhookReader: webhookReader,
+	}
+}
+
+func (h GetWebhookTriggerHandler) Handle(ctx context.Context, query GetWebhookTriggerQuery) (WebhookTrigger, error) {
+	trigger, err := h.webhookReader.ReadWebhookTrigger(ctx, query.ProjectID, query.WebhookTriggerID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return WebhookTrigger{}, errs.NotFoundError{Resource: "webhook trigger", ID: query.WebhookTriggerID, Err: err}
+		}
+		return WebhookTrigger{}, errs.InternalError{Err: err}
+	}
+
+	if trigger.WorkflowID != query.WorkflowID.String() {
+		return WebhookTrigger{}, errs.NotFoundError{Resource: "webhook trigger", ID: query.WebhookTriggerID}
+	}
+
+	return trigger, nil
+}
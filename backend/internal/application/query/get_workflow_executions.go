@@ -4,6 +4,9 @@ import (
 	"context"
 	"log/slog"
 	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
 )
 
 type GetWorkflowExecutionsQuery struct {
@@ -12,16 +15,26 @@ type GetWorkflowExecutionsQuery struct {
 
 type GetWorkflowExecutionsHandler struct {
 	executionReader ExecutionReader
+	rolloutReader   RolloutReader
 }
 
-func NewGetWorkflowExecutionsHandler(executionReader ExecutionReader) GetWorkflowExecutionsHandler {
+func NewGetWorkflowExecutionsHandler(
+	executionReader ExecutionReader,
+	rolloutReader RolloutReader,
+) GetWorkflowExecutionsHandler {
 	if executionReader == nil {
 		slog.Error("executionReader is nil")
 		os.Exit(1)
 	}
 
+	if rolloutReader == nil {
+		slog.Error("rolloutReader is nil")
+		os.Exit(1)
+	}
+
 	return GetWorkflowExecutionsHandler{
 		executionReader: executionReader,
+		rolloutReader:   rolloutReader,
 	}
 }
 
@@ -34,5 +47,40 @@ func (h GetWorkflowExecutionsHandler) Handle(
 		return nil, err
 	}
 
+	if err = withVariants(ctx, h.rolloutReader, executions); err != nil {
+		return nil, err
+	}
 	return executions, nil
 }
+
+// withVariants sets the variant that ran each execution.
+func withVariants(ctx context.Context, rolloutReader RolloutReader, executions []Execution) error {
+	triggerIDs := make([]uuid.UUID, 0, len(executions))
+	for _, execution := range executions {
+		if id, err := uuid.Parse(execution.TriggerID); err == nil {
+			triggerIDs = append(triggerIDs, id)
+		}
+	}
+
+	if len(triggerIDs) == 0 {
+		return nil
+	}
+
+	variants, err := rolloutReader.ReadExecutionVariants(ctx, triggerIDs)
+	if err != nil {
+		return errs.InternalError{Err: err}
+	}
+
+	for i, execution := range executions {
+		id, err := uuid.Parse(execution.TriggerID)
+		if err != nil {
+			continue
+		}
+
+		if variant, ok := variants[id]; ok {
+			executions[i].Variant = &variant
+			executions[i].EndUserID = variant.EndUserID
+		}
+	}
+	return nil
+}
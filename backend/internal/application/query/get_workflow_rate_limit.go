@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type GetWorkflowRateLimitQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type GetWorkflowRateLimitHandler struct {
+	rateLimitReader RateLimitReader
+}
+
+func NewGetWorkflowRateLimitHandler(rateLimitReader RateLimitReader) GetWorkflowRateLimitHandler {
+	if rateLimitReader == nil {
+		slog.Error("rateLimitReader is nil")
+		os.Exit(1)
+	}
+
+	return GetWorkflowRateLimitHandler{
+		rateLimitReader: rateLimitReader,
+	}
+}
+
+// Handle returns the rate limit applied to the triggers of the workflow,
+// the project limit when the workflow does not override it.
+func (h GetWorkflowRateLimitHandler) Handle(ctx context.Context, query GetWorkflowRateLimitQuery) (RateLimit, error) {
+	limit, err := h.rateLimitReader.ReadWorkflowRateLimit(ctx, query.ProjectID, query.WorkflowID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return RateLimit{}, errs.NotFoundError{Resource: "rate limit", ID: query.WorkflowID, Err: err}
+		}
+		return RateLimit{}, errs.InternalError{Err: err}
+	}
+
+	return limit, nil
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	defaultAuditEventsLimit = 50
+	maxAuditEventsLimit     = 100
+)
+
+type ListAuditEventsQuery struct {
+	Filter AuditEventFilter
+	Limit  int
+}
+
+type ListAuditEventsHandler struct {
+	auditReader AuditReader
+}
+
+func NewListAuditEventsHandler(auditReader AuditReader) ListAuditEventsHandler {
+	if auditReader == nil {
+		slog.Error("auditReader is nil")
+		os.Exit(1)
+	}
+
+	return ListAuditEventsHandler{
+		auditReader: auditReader,
+	}
+}
+
+// Handle returns a page of the audit log, most recent first. The next page
+// is listed before the last event of the page.
+func (h ListAuditEventsHandler) Handle(ctx context.Context, query ListAuditEventsQuery) ([]AuditEvent, error) {
+	if err := validateAuditRange(query.Filter); err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultAuditEventsLimit
+	}
+	limit = min(limit, maxAuditEventsLimit)
+
+	events, err := h.auditReader.ListAuditEvents(ctx, query.Filter, limit)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return events, nil
+}
+
+func validateAuditRange(filter AuditEventFilter) error {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return errs.InvalidError{Field: "from", Reason: "from must be before to"}
+	}
+	return nil
+}
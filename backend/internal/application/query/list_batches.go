@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListBatchesQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type ListBatchesHandler struct {
+	batchReader BatchReader
+}
+
+func NewListBatchesHandler(batchReader BatchReader) ListBatchesHandler {
+	if batchReader == nil {
+		slog.Error("batchReader is nil")
+		os.Exit(1)
+	}
+
+	return ListBatchesHandler{
+		batchReader: batchReader,
+	}
+}
+
+func (h ListBatchesHandler) Handle(ctx context.Context, query ListBatchesQuery) ([]Batch, error) {
+	batches, err := h.batchReader.ListBatches(ctx, query.ProjectID, query.WorkflowID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return batches, nil
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListBudgetsQuery struct {
+	ProjectID uuid.UUID
+}
+
+type ListBudgetsHandler struct {
+	budgetReader BudgetReader
+}
+
+func NewListBudgetsHandler(budgetReader BudgetReader) ListBudgetsHandler {
+	if budgetReader == nil {
+		slog.Error("budgetReader is nil")
+		os.Exit(1)
+	}
+
+	return ListBudgetsHandler{
+		budgetReader: budgetReader,
+	}
+}
+
+func (h ListBudgetsHandler) Handle(ctx context.Context, query ListBudgetsQuery) ([]Budget, error) {
+	budgets, err := h.budgetReader.ListProjectBudgets(ctx, query.ProjectID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListCredentialReferencesQuery struct {
+	ProjectID    uuid.UUID
+	CredentialID uuid.UUID
+}
+
+type ListCredentialReferencesHandler struct {
+	projectReader ProjectReader
+}
+
+func NewListCredentialReferencesHandler(projectReader ProjectReader) ListCredentialReferencesHandler {
+	if projectReader == nil {
+		slog.Error("projectReader is nil")
+		os.Exit(1)
+	}
+
+	return ListCredentialReferencesHandler{
+		projectReader: projectReader,
+	}
+}
+
+// Handle returns the workflow nodes using the credential. The credential
+// need not exist, to find the workflows still using a removed credential.
+func (h ListCredentialReferencesHandler) Handle(
+	ctx context.Context,
+	query ListCredentialReferencesQuery,
+) ([]CredentialReference, error) {
+	references, err := h.projectReader.ListCredentialReferences(ctx, query.ProjectID, query.CredentialID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return references, nil
+}
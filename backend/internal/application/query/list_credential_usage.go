@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListCredentialUsageQuery struct {
+	ProjectID uuid.UUID
+	From      *time.Time
+	To        *time.Time
+}
+
+type ListCredentialUsageHandler struct {
+	usageReader UsageReader
+}
+
+func NewListCredentialUsageHandler(usageReader UsageReader) ListCredentialUsageHandler {
+	if usageReader == nil {
+		slog.Error("usageReader is nil")
+		os.Exit(1)
+	}
+
+	return ListCredentialUsageHandler{
+		usageReader: usageReader,
+	}
+}
+
+// Handle aggregates the usage of the project over the range by credential, most expensive first.
+func (h ListCredentialUsageHandler) Handle(ctx context.Context, query ListCredentialUsageQuery) ([]CredentialUsage, error) {
+	from, to, err := usageRange(query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := h.usageReader.ListCredentialUsage(ctx, query.ProjectID, from, to)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return usage, nil
+}
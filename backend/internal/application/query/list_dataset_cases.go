@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListDatasetCasesQuery struct {
+	ProjectID uuid.UUID
+	DatasetID uuid.UUID
+}
+
+type ListDatasetCasesHandler struct {
+	evalReader EvalReader
+}
+
+func NewListDatasetCasesHandler(evalReader EvalReader) ListDatasetCasesHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return ListDatasetCasesHandler{
+		evalReader: evalReader,
+	}
+}
+
+func (h ListDatasetCasesHandler) Handle(ctx context.Context, query ListDatasetCasesQuery) ([]DatasetCase, error) {
+	if _, err := readProjectDataset(ctx, h.evalReader, query.ProjectID, query.DatasetID); err != nil {
+		return nil, err
+	}
+
+	cases, err := h.evalReader.ReadDatasetCases(ctx, query.DatasetID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return cases, nil
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListDatasetsQuery struct {
+	ProjectID uuid.UUID
+}
+
+type ListDatasetsHandler struct {
+	evalReader EvalReader
+}
+
+func NewListDatasetsHandler(evalReader EvalReader) ListDatasetsHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return ListDatasetsHandler{
+		evalReader: evalReader,
+	}
+}
+
+func (h ListDatasetsHandler) Handle(ctx context.Context, query ListDatasetsQuery) ([]Dataset, error) {
+	datasets, err := h.evalReader.ListDatasets(ctx, query.ProjectID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return datasets, nil
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListEvalCasesQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	EvalRunID  uuid.UUID
+}
+
+type ListEvalCasesHandler struct {
+	evalReader EvalReader
+}
+
+func NewListEvalCasesHandler(evalReader EvalReader) ListEvalCasesHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return ListEvalCasesHandler{
+		evalReader: evalReader,
+	}
+}
+
+// Handle returns the cases of the run in dataset order, scores are set once the run is completed.
+func (h ListEvalCasesHandler) Handle(ctx context.Context, query ListEvalCasesQuery) ([]EvalCase, error) {
+	run, err := readProjectEvalRun(ctx, h.evalReader, query.ProjectID, query.EvalRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.WorkflowID != query.WorkflowID.String() {
+		return nil, errs.NotFoundError{Resource: "eval run", ID: query.EvalRunID}
+	}
+
+	cases, err := h.evalReader.ReadEvalCases(ctx, run.ID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return cases, nil
+}
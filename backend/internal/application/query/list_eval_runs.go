@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListEvalRunsQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type ListEvalRunsHandler struct {
+	evalReader EvalReader
+}
+
+func NewListEvalRunsHandler(evalReader EvalReader) ListEvalRunsHandler {
+	if evalReader == nil {
+		slog.Error("evalReader is nil")
+		os.Exit(1)
+	}
+
+	return ListEvalRunsHandler{
+		evalReader: evalReader,
+	}
+}
+
+func (h ListEvalRunsHandler) Handle(ctx context.Context, query ListEvalRunsQuery) ([]EvalRun, error) {
+	runs, err := h.evalReader.ListEvalRuns(ctx, query.ProjectID, query.WorkflowID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return runs, nil
+}
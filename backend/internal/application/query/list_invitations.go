@@ -0,0 +1,36 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListInvitationsQuery struct{}
+
+type ListInvitationsHandler struct {
+	userReader UserReader
+}
+
+func NewListInvitationsHandler(userReader UserReader) ListInvitationsHandler {
+	if userReader == nil {
+		slog.Error("userReader is nil")
+		os.Exit(1)
+	}
+
+	return ListInvitationsHandler{
+		userReader: userReader,
+	}
+}
+
+// Handle returns the invitations not accepted yet, expired ones included.
+func (h ListInvitationsHandler) Handle(ctx context.Context, _ ListInvitationsQuery) ([]Invitation, error) {
+	invitations, err := h.userReader.ListPendingInvitations(ctx)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return invitations, nil
+}
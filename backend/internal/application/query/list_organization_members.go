@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListOrganizationMembersQuery struct {
+	OrganizationID uuid.UUID
+}
+
+type ListOrganizationMembersHandler struct {
+	accessReader AccessReader
+}
+
+func NewListOrganizationMembersHandler(accessReader AccessReader) ListOrganizationMembersHandler {
+	if accessReader == nil {
+		slog.Error("accessReader is nil")
+		os.Exit(1)
+	}
+
+	return ListOrganizationMembersHandler{
+		accessReader: accessReader,
+	}
+}
+
+func (h ListOrganizationMembersHandler) Handle(ctx context.Context, q ListOrganizationMembersQuery) ([]Member, error) {
+	members, err := h.accessReader.ListOrganizationMembers(ctx, q.OrganizationID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return members, nil
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListOrganizationsQuery struct {
+	UserID uuid.UUID
+}
+
+type ListOrganizationsHandler struct {
+	accessReader AccessReader
+}
+
+func NewListOrganizationsHandler(accessReader AccessReader) ListOrganizationsHandler {
+	if accessReader == nil {
+		slog.Error("accessReader is nil")
+		os.Exit(1)
+	}
+
+	return ListOrganizationsHandler{
+		accessReader: accessReader,
+	}
+}
+
+// Handle lists the organizations the user is a member of.
+func (h ListOrganizationsHandler) Handle(ctx context.Context, q ListOrganizationsQuery) ([]Organization, error) {
+	organizations, err := h.accessReader.ListOrganizations(ctx, q.UserID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return organizations, nil
+}
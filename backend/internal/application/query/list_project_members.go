@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListProjectMembersQuery struct {
+	ProjectID uuid.UUID
+}
+
+type ListProjectMembersHandler struct {
+	accessReader AccessReader
+}
+
+func NewListProjectMembersHandler(accessReader AccessReader) ListProjectMembersHandler {
+	if accessReader == nil {
+		slog.Error("accessReader is nil")
+		os.Exit(1)
+	}
+
+	return ListProjectMembersHandler{
+		accessReader: accessReader,
+	}
+}
+
+// Handle lists the users the project is shared with, besides its creator
+// and the members of its organization.
+func (h ListProjectMembersHandler) Handle(ctx context.Context, q ListProjectMembersQuery) ([]Member, error) {
+	members, err := h.accessReader.ListProjectMembers(ctx, q.ProjectID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return members, nil
+}
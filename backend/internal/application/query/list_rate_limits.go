@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListRateLimitsQuery struct {
+	ProjectID uuid.UUID
+}
+
+type ListRateLimitsHandler struct {
+	rateLimitReader RateLimitReader
+}
+
+func NewListRateLimitsHandler(rateLimitReader RateLimitReader) ListRateLimitsHandler {
+	if rateLimitReader == nil {
+		slog.Error("rateLimitReader is nil")
+		os.Exit(1)
+	}
+
+	return ListRateLimitsHandler{
+		rateLimitReader: rateLimitReader,
+	}
+}
+
+func (h ListRateLimitsHandler) Handle(ctx context.Context, query ListRateLimitsQuery) ([]RateLimit, error) {
+	limits, err := h.rateLimitReader.ListProjectRateLimits(ctx, query.ProjectID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return limits, nil
+}
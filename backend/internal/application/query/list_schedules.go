@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListSchedulesQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type ListSchedulesHandler struct {
+	scheduleReader ScheduleReader
+}
+
+func NewListSchedulesHandler(scheduleReader ScheduleReader) ListSchedulesHandler {
+	if scheduleReader == nil {
+		slog.Error("scheduleReader is nil")
+		os.Exit(1)
+	}
+
+	return ListSchedulesHandler{
+		scheduleReader: scheduleReader,
+	}
+}
+
+func (h ListSchedulesHandler) Handle(
+	ctx context.Context,
+	query ListSchedulesQuery,
+) ([]Schedule, error) {
+	schedules, err := h.scheduleReader.ListSchedules(ctx, query.ProjectID, query.WorkflowID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return schedules, nil
+}
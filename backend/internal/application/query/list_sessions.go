@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListSessionsQuery struct {
+	UserID uuid.UUID
+}
+
+type ListSessionsHandler struct {
+	userReader UserReader
+}
+
+func NewListSessionsHandler(userReader UserReader) ListSessionsHandler {
+	if userReader == nil {
+		slog.Error("userReader is nil")
+		os.Exit(1)
+	}
+
+	return ListSessionsHandler{
+		userReader: userReader,
+	}
+}
+
+// Handle returns the sessions of the user not revoked nor expired, the most recently used first.
+func (h ListSessionsHandler) Handle(ctx context.Context, q ListSessionsQuery) ([]Session, error) {
+	sessions, err := h.userReader.ListUserSessions(ctx, q.UserID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,35 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListUsersQuery struct{}
+
+type ListUsersHandler struct {
+	userReader UserReader
+}
+
+func NewListUsersHandler(userReader UserReader) ListUsersHandler {
+	if userReader == nil {
+		slog.Error("userReader is nil")
+		os.Exit(1)
+	}
+
+	return ListUsersHandler{
+		userReader: userReader,
+	}
+}
+
+func (h ListUsersHandler) Handle(ctx context.Context, _ ListUsersQuery) ([]User, error) {
+	users, err := h.userReader.ListUsers(ctx)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return users, nil
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const defaultVariantStatsWindow = 7 * 24 * time.Hour
+
+type ListVariantStatsQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+	// Since defaults to the last 7 days.
+	Since *time.Time
+}
+
+type ListVariantStatsHandler struct {
+	rolloutReader RolloutReader
+}
+
+func NewListVariantStatsHandler(rolloutReader RolloutReader) ListVariantStatsHandler {
+	if rolloutReader == nil {
+		slog.Error("rolloutReader is nil")
+		os.Exit(1)
+	}
+
+	return ListVariantStatsHandler{
+		rolloutReader: rolloutReader,
+	}
+}
+
+// Handle aggregates the executions of the workflow by variant and revision.
+func (h ListVariantStatsHandler) Handle(ctx context.Context, query ListVariantStatsQuery) ([]VariantStats, error) {
+	since := time.Now().Add(-defaultVariantStatsWindow)
+	if query.Since != nil {
+		since = *query.Since
+	}
+
+	stats, err := h.rolloutReader.ListVariantStats(ctx, query.ProjectID, query.WorkflowID, since)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return stats, nil
+}
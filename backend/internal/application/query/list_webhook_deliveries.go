@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	reader "github.com/supallm/core/internal/adapters/errors"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	defaultDeliveriesLimit = 20
+	maxDeliveriesLimit     = 100
+)
+
+type ListWebhookDeliveriesQuery struct {
+	ProjectID uuid.UUID
+	WebhookID uuid.UUID
+	Limit     int
+}
+
+type ListWebhookDeliveriesHandler struct {
+	webhookReader WebhookReader
+}
+
+func NewListWebhookDeliveriesHandler(webhookReader WebhookReader) ListWebhookDeliveriesHandler {
+	if webhookReader == nil {
+		slog.Error("webhookReader is nil")
+		os.Exit(1)
+	}
+
+	return ListWebhookDeliveriesHandler{
+		webhookReader: webhookReader,
+	}
+}
+
+func (h ListWebhookDeliveriesHandler) Handle(
+	ctx context.Context,
+	query ListWebhookDeliveriesQuery,
+) ([]WebhookDelivery, error) {
+	// the webhook is read first so deliveries are only listed within their project
+	_, err := h.webhookReader.ReadWebhook(ctx, query.ProjectID, query.WebhookID)
+	if err != nil {
+		if errors.Is(err, reader.ErrNotFound) {
+			return nil, errs.NotFoundError{Resource: "webhook", ID: query.WebhookID, Err: err}
+		}
+		return nil, errs.InternalError{Err: err}
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultDeliveriesLimit
+	}
+	limit = min(limit, maxDeliveriesLimit)
+
+	deliveries, err := h.webhookReader.ListWebhookDeliveries(ctx, query.WebhookID, limit)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return deliveries, nil
+}
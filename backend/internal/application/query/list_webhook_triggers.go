@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListWebhookTriggersQuery struct {
+	ProjectID  uuid.UUID
+	WorkflowID model.WorkflowID
+}
+
+type ListWebhookTriggersHandler struct {
+	webhookReader WebhookReader
+}
+
+func NewListWebhookTriggersHandler(webhookReader WebhookReader) ListWebhookTriggersHandler {
+	if webhookReader == nil {
+		slog.Error("webhookReader is nil")
+		os.Exit(1)
+	}
+
+	return ListWebhookTriggersHandler{
+		webhookReader: webhookReader,
+	}
+}
+
+func (h ListWebhookTriggersHandler) Handle(
+	ctx context.Context,
+	query ListWebhookTriggersQuery,
+) ([]WebhookTrigger, error) {
+	triggers, err := h.webhookReader.ListWebhookTriggers(ctx, query.ProjectID, query.WorkflowID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return triggers, nil
+}
@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListWebhooksQuery struct {
+	ProjectID uuid.UUID
+}
+
+type ListWebhooksHandler struct {
+	webhookReader WebhookReader
+}
+
+func NewListWebhooksHandler(webhookReader WebhookReader) ListWebhooksHandler {
+	if webhookReader == nil {
+		slog.Error("webhookReader is nil")
+		os.Exit(1)
+	}
+
+	return ListWebhooksHandler{
+		webhookReader: webhookReader,
+	}
+}
+
+func (h ListWebhooksHandler) Handle(ctx context.Context, query ListWebhooksQuery) ([]Webhook, error) {
+	webhooks, err := h.webhookReader.ListWebhooks(ctx, query.ProjectID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+type ListWorkflowUsageQuery struct {
+	ProjectID uuid.UUID
+	From      *time.Time
+	To        *time.Time
+}
+
+type ListWorkflowUsageHandler struct {
+	usageReader UsageReader
+}
+
+func NewListWorkflowUsageHandler(usageReader UsageReader) ListWorkflowUsageHandler {
+	if usageReader == nil {
+		slog.Error("usageReader is nil")
+		os.Exit(1)
+	}
+
+	return ListWorkflowUsageHandler{
+		usageReader: usageReader,
+	}
+}
+
+// Handle aggregates the usage of the project over the range by workflow, most expensive first.
+func (h ListWorkflowUsageHandler) Handle(ctx context.Context, query ListWorkflowUsageQuery) ([]WorkflowUsage, error) {
+	from, to, err := usageRange(query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := h.usageReader.ListWorkflowUsage(ctx, query.ProjectID, from, to)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	return usage, nil
+}
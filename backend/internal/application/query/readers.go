@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/supallm/core/internal/application/domain/model"
@@ -12,12 +13,23 @@ type (
 	ProjectReader interface {
 		ReadProject(ctx context.Context, id uuid.UUID) (Project, error)
 		ReadCredential(ctx context.Context, projectID uuid.UUID, credentialID uuid.UUID) (Credential, error)
+		ListCredentialReferences(ctx context.Context, projectID uuid.UUID, credentialID uuid.UUID) ([]CredentialReference, error)
 		ReadWorkflow(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) (Workflow, error)
 		ListProjects(ctx context.Context, userID string) ([]Project, error)
 	}
 
 	UserReader interface {
 		ReadUser(ctx context.Context, email string) (User, error)
+		ReadUserByID(ctx context.Context, id uuid.UUID) (User, error)
+		ListUsers(ctx context.Context) ([]User, error)
+		ListPendingInvitations(ctx context.Context) ([]Invitation, error)
+		ListUserSessions(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	}
+
+	AccessReader interface {
+		ListOrganizations(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+		ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]Member, error)
+		ListProjectMembers(ctx context.Context, projectID uuid.UUID) ([]Member, error)
 	}
 
 	ExecutionReader interface {
@@ -25,6 +37,80 @@ type (
 		ReadTriggerExecution(ctx context.Context, workflowID string, triggerID uuid.UUID) (Execution, error)
 	}
 
+	WebhookReader interface {
+		ReadWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (Webhook, error)
+		ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]Webhook, error)
+		ListWebhookDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]WebhookDelivery, error)
+		ReadWebhookTrigger(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (WebhookTrigger, error)
+		ListWebhookTriggers(
+			ctx context.Context,
+			projectID uuid.UUID,
+			workflowID model.WorkflowID,
+		) ([]WebhookTrigger, error)
+	}
+
+	ScheduleReader interface {
+		ReadSchedule(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (Schedule, error)
+		ListSchedules(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) ([]Schedule, error)
+	}
+
+	BatchReader interface {
+		ReadBatch(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (Batch, error)
+		ListBatches(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) ([]Batch, error)
+		ListBatchItems(ctx context.Context, batchID uuid.UUID) ([]BatchItem, error)
+	}
+
+	EvalReader interface {
+		ReadDataset(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (Dataset, error)
+		ListDatasets(ctx context.Context, projectID uuid.UUID) ([]Dataset, error)
+		ReadDatasetCases(ctx context.Context, datasetID uuid.UUID) ([]DatasetCase, error)
+		ReadEvalRun(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (EvalRun, error)
+		ListEvalRuns(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) ([]EvalRun, error)
+		ReadEvalCases(ctx context.Context, runID uuid.UUID) ([]EvalCase, error)
+	}
+
+	RolloutReader interface {
+		ReadTrafficSplit(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) (TrafficSplit, error)
+		ReadExecutionVariants(ctx context.Context, triggerIDs []uuid.UUID) (map[uuid.UUID]ExecutionVariant, error)
+		ListVariantStats(
+			ctx context.Context,
+			projectID uuid.UUID,
+			workflowID model.WorkflowID,
+			since time.Time,
+		) ([]VariantStats, error)
+	}
+
+	UsageReader interface {
+		ReadExecutionUsage(
+			ctx context.Context,
+			projectID uuid.UUID,
+			workflowID model.WorkflowID,
+			triggerID uuid.UUID,
+		) ([]NodeUsage, error)
+		ReadUsageTotals(ctx context.Context, projectID uuid.UUID, from, to time.Time) (UsageTotals, error)
+		ListModelUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]ModelUsage, error)
+		ListDailyUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]DailyUsage, error)
+		ListWorkflowUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]WorkflowUsage, error)
+		ListCredentialUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]CredentialUsage, error)
+	}
+
+	BudgetReader interface {
+		ReadBudget(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (Budget, error)
+		ListProjectBudgets(ctx context.Context, projectID uuid.UUID) ([]Budget, error)
+	}
+
+	RateLimitReader interface {
+		ListProjectRateLimits(ctx context.Context, projectID uuid.UUID) ([]RateLimit, error)
+		// ReadWorkflowRateLimit returns the limit of the workflow, the
+		// limit of its project when the workflow has none.
+		ReadWorkflowRateLimit(ctx context.Context, projectID uuid.UUID, workflowID model.WorkflowID) (RateLimit, error)
+	}
+
+	AuditReader interface {
+		// ListAuditEvents returns the events matching the filter, most recent first.
+		ListAuditEvents(ctx context.Context, filter AuditEventFilter, limit int) ([]AuditEvent, error)
+	}
+
 	EventReader interface {
 		ReadWorkflowEvents(
 			ctx context.Context,
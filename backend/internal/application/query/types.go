@@ -1,6 +1,7 @@
 package query
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,14 +10,15 @@ import (
 )
 
 type Project struct {
-	ID           uuid.UUID
-	Name         string
-	AuthProvider AuthProvider
-	Credentials  []Credential
-	Workflows    []Workflow
-	APIKeys      []APIKey
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID             uuid.UUID
+	OrganizationID *uuid.UUID
+	Name           string
+	AuthProvider   AuthProvider
+	Credentials    []Credential
+	Workflows      []Workflow
+	APIKeys        []APIKey
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 type APIKey struct {
@@ -49,18 +51,160 @@ type Credential struct {
 	Name             string
 	Provider         string
 	ObfuscatedAPIKey string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	// Payload holds the fields of the credential, secrets obfuscated.
+	Payload map[string]any
+	// Status is the outcome of the last test of the credential.
+	Status            string
+	VerificationError string
+	LastVerifiedAt    *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// CredentialReference is a node of a workflow using a credential.
+type CredentialReference struct {
+	WorkflowID   model.WorkflowID
+	WorkflowName string
+	NodeID       string
+	NodeType     string
+}
+
+type Webhook struct {
+	ID          uuid.UUID
+	URL         string
+	EventTypes  []string
+	WorkflowIDs []string
+	Secret      secret.APIKey
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventType      string
+	TriggerID      uuid.UUID
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	ResponseBody   string
+	Error          string
+	NextAttemptAt  *time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+type WebhookTrigger struct {
+	ID              uuid.UUID
+	WorkflowID      string
+	Name            string
+	Verification    string
+	SignatureHeader string
+	Secret          secret.APIKey
+	InputMapping    map[string]string
+	RespondSync     bool
+	SyncTimeout     time.Duration
+	Enabled         bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type Schedule struct {
+	ID             uuid.UUID
+	WorkflowID     string
+	CronExpression string
+	Timezone       string
+	Inputs         map[string]any
+	Enabled        bool
+	NextFireAt     time.Time
+	LastFiredAt    *time.Time
+	LastTriggerID  *uuid.UUID
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 type User struct {
+	ID                 uuid.UUID
+	Email              string
+	Name               string
+	Admin              bool
+	MustChangePassword bool
+	DeactivatedAt      *time.Time
+	MFAEnabled         bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Session is an active login of a user.
+type Session struct {
+	ID         uuid.UUID
+	UserAgent  string
+	IPAddress  string
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
+
+// AuditEvent is an entry of the audit log.
+type AuditEvent struct {
+	ID         uuid.UUID
+	ProjectID  *uuid.UUID
+	ActorType  string
+	ActorID    *uuid.UUID
+	IPAddress  string
+	Action     string
+	Resource   string
+	ResourceID string
+	Before     map[string]any
+	After      map[string]any
+	Details    map[string]any
+	OccurredAt time.Time
+}
+
+// AuditEventFilter selects the events of a project, or the account events
+// when ProjectID is nil. Empty fields are not filtered on.
+type AuditEventFilter struct {
+	ProjectID *uuid.UUID
+	Action    string
+	Resource  string
+	ActorID   *uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	// Before lists the events older than the event, to page through the log.
+	Before *uuid.UUID
+}
+
+// Organization is an organization of the user, with their role in it.
+type Organization struct {
 	ID        uuid.UUID
-	Email     string
 	Name      string
+	Role      model.Role
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// Member is a user holding a role in an organization or a project.
+type Member struct {
+	UserID    uuid.UUID
+	Email     string
+	Name      string
+	Role      model.Role
+	CreatedAt time.Time
+}
+
+type Invitation struct {
+	ID        uuid.UUID
+	Email     string
+	Name      string
+	Admin     bool
+	InvitedBy uuid.UUID
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
 type Execution struct {
 	WorkflowID     string
 	SessionID      string
@@ -69,6 +213,17 @@ type Execution struct {
 	NodeExecutions map[string]NodeExecution
 	CompletedNodes []string
 	AllNodes       []string
+	// Variant is the workflow that ran the trigger, nil for executions
+	// started before variants were recorded.
+	Variant *ExecutionVariant
+	// EndUserID is the authenticated end-user who made the trigger.
+	EndUserID string
+}
+
+type ExecutionVariant struct {
+	WorkflowID string
+	Revision   string
+	EndUserID  string
 }
 
 type WorkflowInputs struct {
@@ -82,3 +237,196 @@ type NodeExecution struct {
 	Output        map[string]any
 	ExecutionTime int
 }
+
+type Batch struct {
+	ID          uuid.UUID
+	WorkflowID  string
+	Status      string
+	Concurrency int
+	Total       int
+	Succeeded   int
+	Failed      int
+	Running     int
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type BatchItem struct {
+	Index      int
+	TriggerID  uuid.UUID
+	Inputs     map[string]any
+	Status     string
+	Result     any
+	Error      string
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+type Dataset struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CaseCount   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type DatasetCase struct {
+	Index    int
+	Inputs   map[string]any
+	Expected any
+}
+
+type EvalRun struct {
+	ID          uuid.UUID
+	WorkflowID  string
+	DatasetID   *uuid.UUID
+	BatchID     uuid.UUID
+	Revision    string
+	Scorers     []model.ScorerConfig
+	Status      string
+	Metrics     *model.EvalMetrics
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type EvalCase struct {
+	Index      int
+	Inputs     map[string]any
+	Expected   any
+	Status     string
+	Result     any
+	Error      string
+	DurationMs int64
+	Scores     map[string]float64
+	Passed     bool
+}
+
+// EvalComparison lists the changes of a head run relative to a base run.
+type EvalComparison struct {
+	Base          EvalRun
+	Head          EvalRun
+	PassRateDelta float64
+	Scorers       map[string]ScorerComparison
+	// Regressions and Improvements hold the indexes of the cases whose outcome changed.
+	Regressions  []int
+	Improvements []int
+}
+
+type ScorerComparison struct {
+	BaseMean float64
+	HeadMean float64
+	Delta    float64
+}
+
+type TrafficSplit struct {
+	WorkflowID string
+	Variants   []model.TrafficSplitVariant
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// VariantStats aggregates the executions of a workflow run by one revision
+// of a variant, eval cases are counted when the execution belongs to a
+// completed evaluation run.
+type VariantStats struct {
+	WorkflowID     string
+	Revision       string
+	Executions     int
+	Succeeded      int
+	Failed         int
+	AvgLatencyMs   float64
+	P95LatencyMs   float64
+	EvalCases      int
+	EvalPassed     int
+	FirstStartedAt time.Time
+	LastStartedAt  time.Time
+}
+
+// UsageTotals aggregates token usage, Unpriced counts the calls of models
+// missing from the price table, their cost is not included.
+type UsageTotals struct {
+	Executions   int
+	Calls        int
+	InputTokens  int64
+	OutputTokens int64
+	CostUSD      float64
+	Unpriced     int
+}
+
+type NodeUsage struct {
+	NodeID       string
+	NodeType     string
+	Model        string
+	CredentialID *uuid.UUID
+	Calls        int
+	InputTokens  int64
+	OutputTokens int64
+	CostUSD      float64
+	Unpriced     int
+}
+
+type ExecutionUsage struct {
+	TriggerID uuid.UUID
+	Nodes     []NodeUsage
+	Total     UsageTotals
+}
+
+type ModelUsage struct {
+	Model string
+	Usage UsageTotals
+}
+
+type DailyUsage struct {
+	Day   time.Time
+	Usage UsageTotals
+}
+
+type WorkflowUsage struct {
+	WorkflowID string
+	Usage      UsageTotals
+}
+
+// CredentialUsage is nil for nodes without a credential.
+type CredentialUsage struct {
+	CredentialID *uuid.UUID
+	Usage        UsageTotals
+}
+
+type ProjectUsage struct {
+	From   time.Time
+	To     time.Time
+	Total  UsageTotals
+	Models []ModelUsage
+	Daily  []DailyUsage
+}
+
+// Budget is read with the spend of its current period.
+type Budget struct {
+	ID           uuid.UUID
+	Scope        string
+	WorkflowID   string
+	CredentialID *uuid.UUID
+	Period       string
+	LimitUSD     float64
+	Thresholds   []int
+	Hard         bool
+	PeriodStart  time.Time
+	SpentUSD     float64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// RateLimit is a trigger rate limit, WorkflowID is empty for the project limit.
+type RateLimit struct {
+	WorkflowID               string
+	RequestsPerMinute        int
+	APIKeyRequestsPerMinute  int
+	EndUserRequestsPerMinute int
+	Burst                    int
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/event"
+)
+
+const (
+	webhookPollInterval = time.Second
+	webhookBatchSize    = 20
+
+	// schedules fire at minute boundaries at most, a few seconds late at worst.
+	schedulerPollInterval = 5 * time.Second
+	scheduleBatchSize     = 50
+
+	// finished items free their slot on the next tick.
+	batchPollInterval = time.Second
+
+	evalPollInterval = 5 * time.Second
+	evalBatchSize    = 10
+
+	// schedulerLockKey is the advisory lock electing the replica firing the schedules.
+	schedulerLockKey int64 = 0x5355504c4c4d01
+)
+
+// webhookDispatcher feeds the events of the router to the webhook deliveries.
+type webhookDispatcher struct {
+	handler command.DispatchWebhookEventHandler
+}
+
+func (d webhookDispatcher) DispatchEvent(ctx context.Context, e event.WorkflowEventMessage, payload []byte) error {
+	return d.handler.Handle(ctx, command.DispatchWebhookEventCommand{
+		ProjectID:  e.ProjectID,
+		WorkflowID: e.WorkflowID,
+		TriggerID:  e.TriggerID,
+		EventType:  e.Type,
+		Payload:    payload,
+	})
+}
+
+// batchTracker feeds the terminal events of the router to the batch progress.
+type batchTracker struct {
+	handler command.RecordBatchItemResultHandler
+}
+
+func (t batchTracker) TrackEvent(ctx context.Context, e event.WorkflowEventMessage) error {
+	return t.handler.Handle(ctx, command.RecordBatchItemResultCommand{
+		TriggerID: e.TriggerID,
+		EventType: e.Type,
+		Data:      e.Data,
+	})
+}
+
+// executionTracker feeds the events of the router to the executions,
+// to their token usage and to the budgets of their project.
+type executionTracker struct {
+	handler command.RecordExecutionResultHandler
+	usage   command.RecordTokenUsageHandler
+	budgets command.CheckBudgetsHandler
+}
+
+func (t executionTracker) TrackEvent(ctx context.Context, e event.WorkflowEventMessage) error {
+	err := t.usage.Handle(ctx, command.RecordTokenUsageCommand{
+		ProjectID:  e.ProjectID,
+		WorkflowID: e.WorkflowID,
+		TriggerID:  e.TriggerID,
+		Sequence:   e.Sequence,
+		EventType:  e.Type,
+		Data:       e.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = t.handler.Handle(ctx, command.RecordExecutionResultCommand{
+		TriggerID: e.TriggerID,
+		EventType: e.Type,
+		Data:      e.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.budgets.Handle(ctx, command.CheckBudgetsCommand{
+		ProjectID:  e.ProjectID,
+		WorkflowID: e.WorkflowID,
+		TriggerID:  e.TriggerID,
+		EventType:  e.Type,
+	})
+}
+
+// runWebhookDeliveries sends the due webhook deliveries until the context is done.
+// Deliveries are claimed in the database, so every replica runs it.
+func (a *App) runWebhookDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// drain full batches before waiting for the next tick
+		for {
+			n, err := a.Commands.deliverWebhooks.Handle(ctx, command.DeliverWebhooksCommand{
+				BatchSize: webhookBatchSize,
+			})
+			if err != nil {
+				slog.Error("error delivering webhooks", "error", err)
+				break
+			}
+			if n < webhookBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// runScheduler fires the due schedules until the context is done. Every replica
+// runs it but only the leader fires, so a schedule is not fired twice.
+func (a *App) runScheduler(ctx context.Context) {
+	ticker := time.NewTicker(schedulerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !a.schedulerLeader.IsLeader(ctx) {
+			continue
+		}
+
+		for {
+			n, err := a.Commands.fireDueSchedules.Handle(ctx, command.FireDueSchedulesCommand{
+				Now:       time.Now(),
+				BatchSize: scheduleBatchSize,
+			})
+			if err != nil {
+				slog.Error("error firing schedules", "error", err)
+				break
+			}
+			if n < scheduleBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// runBatchDispatch queues the pending batch items until the context is done.
+// Items are claimed in the database, so every replica runs it.
+func (a *App) runBatchDispatch(ctx context.Context) {
+	ticker := time.NewTicker(batchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := a.Commands.dispatchBatches.Handle(ctx, command.DispatchBatchItemsCommand{}); err != nil {
+			slog.Error("error dispatching batch items", "error", err)
+		}
+	}
+}
+
+// runEvalScoring scores the evaluation runs whose executions are finished until
+// the context is done. Runs are claimed in the database, so every replica runs it.
+func (a *App) runEvalScoring(ctx context.Context) {
+	ticker := time.NewTicker(evalPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			n, err := a.Commands.scoreEvalRuns.Handle(ctx, command.ScoreEvalRunsCommand{
+				BatchSize: evalBatchSize,
+			})
+			if err != nil {
+				slog.Error("error scoring eval runs", "error", err)
+				break
+			}
+			if n < evalBatchSize {
+				break
+			}
+		}
+	}
+}
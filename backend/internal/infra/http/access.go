@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+)
+
+// authorize checks the caller may act on the project, and on the workflow when set.
+// Dashboard users act with their role on the project, the other callers with the
+// project secret key, which grants running its workflows and reading their executions.
+func (s *Server) authorize(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	permission model.Permission,
+) error {
+	_, err := s.projectRole(ctx, projectID, workflowID, permission)
+	return err
+}
+
+// projectRole authorizes the caller as authorize does and returns their role.
+func (s *Server) projectRole(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	permission model.Permission,
+) (model.Role, error) {
+	cmd := command.AuthorizeProjectAccessCommand{
+		ProjectID:  projectID,
+		UserID:     uuid.Nil,
+		SecretKey:  "",
+		WorkflowID: workflowID,
+		Permission: permission,
+	}
+
+	if s.server.IsDashboardOrigin(ctx) {
+		userID, err := s.currentUserID(ctx)
+		if err != nil {
+			return "", err
+		}
+		cmd.UserID = userID
+	} else {
+		secretKey, err := s.server.GetSecretKeyFromContext(ctx)
+		if err != nil {
+			return "", err
+		}
+		cmd.SecretKey = secretKey
+	}
+
+	return s.app.Commands.AuthorizeProjectAccess.Handle(ctx, cmd)
+}
+
+// authorizeDashboard checks the role of dashboard users on the end-user routes,
+// the other callers are authorized by the auth provider of the project.
+func (s *Server) authorizeDashboard(
+	ctx context.Context,
+	projectID uuid.UUID,
+	workflowID model.WorkflowID,
+	permission model.Permission,
+) error {
+	if !s.server.IsDashboardOrigin(ctx) {
+		return nil
+	}
+	return s.authorize(ctx, projectID, workflowID, permission)
+}
+
+// authorizeOrganization checks the role of the dashboard user in the organization.
+func (s *Server) authorizeOrganization(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	permission model.Permission,
+) error {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.app.Commands.AuthorizeOrganizationAccess.Handle(ctx, command.AuthorizeOrganizationAccessCommand{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Permission:     permission,
+	})
+}
@@ -0,0 +1,183 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+func (s *Server) ListProjectAuditEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	params gen.ListProjectAuditEventsParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	events, err := s.app.Queries.ListAuditEvents.Handle(r.Context(), query.ListAuditEventsQuery{
+		Filter: query.AuditEventFilter{
+			ProjectID: &projectID,
+			Action:    valueOrZero(params.Action),
+			Resource:  valueOrZero(params.Resource),
+			ActorID:   params.ActorId,
+			From:      params.From,
+			To:        params.To,
+			Before:    params.Before,
+		},
+		Limit: valueOrZero(params.Limit),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryAuditEventsToDTOs(events))
+}
+
+func (s *Server) ExportProjectAuditEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	params gen.ExportProjectAuditEventsParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	events, err := s.app.Queries.ExportAuditEvents.Handle(r.Context(), query.ExportAuditEventsQuery{
+		Filter: query.AuditEventFilter{
+			ProjectID: &projectID,
+			Action:    valueOrZero(params.Action),
+			Resource:  valueOrZero(params.Resource),
+			ActorID:   params.ActorId,
+			From:      params.From,
+			To:        params.To,
+			Before:    nil,
+		},
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	format := gen.ExportProjectAuditEventsParamsFormatJsonl
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+	switch format {
+	case gen.ExportProjectAuditEventsParamsFormatCsv:
+		body, err = auditEventsCSV(events)
+		contentType = csvContentType
+	case gen.ExportProjectAuditEventsParamsFormatJsonl:
+		body, err = auditEventsJSONL(events)
+		contentType = jsonlContentType
+	default:
+		err = errs.InvalidError{Field: "format", Reason: "format must be jsonl or csv"}
+	}
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-%s.%s"`, projectID, format))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func (s *Server) ListAuditEvents(w http.ResponseWriter, r *http.Request, params gen.ListAuditEventsParams) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	events, err := s.app.Queries.ListAuditEvents.Handle(r.Context(), query.ListAuditEventsQuery{
+		Filter: query.AuditEventFilter{
+			ProjectID: nil,
+			Action:    valueOrZero(params.Action),
+			Resource:  valueOrZero(params.Resource),
+			ActorID:   params.ActorId,
+			From:      params.From,
+			To:        params.To,
+			Before:    params.Before,
+		},
+		Limit: valueOrZero(params.Limit),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryAuditEventsToDTOs(events))
+}
+
+func auditEventsJSONL(events []query.AuditEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(queryAuditEventToDTO(event)); err != nil {
+			return nil, errs.InternalError{Err: err}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// auditEventsCSV writes the snapshots and the details of the events as JSON.
+func auditEventsCSV(events []query.AuditEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := []string{
+		"id", "occurred_at", "actor_type", "actor_id", "ip_address",
+		"action", "resource", "resource_id", "before", "after", "details",
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	for _, event := range events {
+		actorID := ""
+		if event.ActorID != nil {
+			actorID = event.ActorID.String()
+		}
+
+		record := []string{
+			event.ID.String(),
+			event.OccurredAt.UTC().Format(time.RFC3339Nano),
+			event.ActorType,
+			actorID,
+			event.IPAddress,
+			event.Action,
+			event.Resource,
+			event.ResourceID,
+			csvValue(event.Before),
+			csvValue(event.After),
+			csvValue(event.Details),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, errs.InternalError{Err: err}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+	return buf.Bytes(), nil
+}
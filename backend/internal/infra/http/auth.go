@@ -2,11 +2,16 @@ package http
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/supallm/core/internal/application/command"
 	"github.com/supallm/core/internal/application/query"
 	"github.com/supallm/core/internal/infra/http/gen"
+	"github.com/supallm/core/internal/pkg/auth"
 	"github.com/supallm/core/internal/pkg/errs"
 )
 
@@ -16,31 +21,134 @@ func (s *Server) Login(w http.ResponseWriter, r *http.Request) {
 		s.server.RespondErr(w, r, err)
 		return
 	}
+	s.login(w, r, req.Email, req.Password)
+}
+
+// login opens a session for the user and responds with its tokens,
+// or with the challenge to complete when they enabled two-factor authentication.
+func (s *Server) login(w http.ResponseWriter, r *http.Request, email string, password string) {
 	result, err := s.app.Commands.CreateJWT.Handle(r.Context(), command.CreateJWTCommand{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     email,
+		Password:  password,
+		UserAgent: r.UserAgent(),
+		IPAddress: s.server.ClientIP(r),
 	})
 	if err != nil {
-		s.server.RespondErr(w, r, err)
+		s.respondRetryableErr(w, r, err)
+		return
+	}
+
+	if result.Challenge != nil {
+		s.server.Respond(w, r, http.StatusAccepted, gen.MfaChallenge{
+			MfaToken:  result.Challenge.Token.String(),
+			ExpiresAt: result.Challenge.ExpiresAt,
+		})
 		return
 	}
 
 	user, err := s.app.Queries.GetUser.Handle(r.Context(), query.GetUserQuery{
-		Email: req.Email,
+		Email: email,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.respondSession(w, r, *result.Session, user)
+}
+
+func (s *Server) LoginMfa(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.MfaLoginRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	issued, err := s.app.Commands.CompleteMFALogin.Handle(r.Context(), command.CompleteMFALoginCommand{
+		ChallengeToken: auth.Token(req.MfaToken),
+		Code:           req.Code,
+		UserAgent:      r.UserAgent(),
+		IPAddress:      s.server.ClientIP(r),
+	})
+	if err != nil {
+		s.respondRetryableErr(w, r, err)
+		return
+	}
+
+	user, err := s.app.Queries.GetUserByID.Handle(r.Context(), query.GetUserByIDQuery{
+		ID: issued.UserID,
 	})
 	if err != nil {
 		s.server.RespondErr(w, r, err)
 		return
 	}
 
+	s.respondSession(w, r, issued, user)
+}
+
+func (s *Server) respondSession(w http.ResponseWriter, r *http.Request, issued command.IssuedSession, user query.User) {
 	s.server.Respond(w, r, http.StatusOK, gen.LoginResponse{
-		Token: result.String(),
-		User: gen.User{
-			Id:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-		},
+		Token:        string(issued.AccessToken),
+		RefreshToken: issued.RefreshToken.String(),
+		ExpiresAt:    issued.ExpiresAt,
+		User:         queryUserToDTO(user),
+	})
+}
+
+// respondRetryableErr tells the throttled and locked out requests when to retry.
+func (s *Server) respondRetryableErr(w http.ResponseWriter, r *http.Request, err error) {
+	if wait, ok := retryAfter(err); ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	}
+	s.server.RespondErr(w, r, err)
+}
+
+func (s *Server) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.RefreshTokenRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	issued, err := s.app.Commands.RefreshSession.Handle(r.Context(), command.RefreshSessionCommand{
+		RefreshToken: auth.OneTimeToken(req.RefreshToken),
+		IPAddress:    s.server.ClientIP(r),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, gen.SessionTokens{
+		Token:        string(issued.AccessToken),
+		RefreshToken: issued.RefreshToken.String(),
+		ExpiresAt:    issued.ExpiresAt,
+	})
+}
+
+func (s *Server) Logout(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	sessionID, err := uuid.Parse(s.server.GetUser(r.Context()).SessionID)
+	if err != nil {
+		s.server.RespondErr(w, r, errs.UnauthorizedError{Err: err})
+		return
+	}
+
+	err = s.app.Commands.RevokeSession.Handle(r.Context(), command.RevokeSessionCommand{
+		UserID:    userID,
+		SessionID: sessionID,
 	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
 }
 
 func (s *Server) GetMe(w http.ResponseWriter, r *http.Request) {
@@ -51,3 +159,18 @@ func (s *Server) GetMe(w http.ResponseWriter, r *http.Request) {
 	}
 	s.server.Respond(w, r, http.StatusOK, user)
 }
+
+// retryAfter returns when a throttled or locked out request can be retried.
+func retryAfter(err error) (time.Duration, bool) {
+	var limited errs.RateLimitedError
+	if errors.As(err, &limited) {
+		return limited.RetryAfter, true
+	}
+
+	var locked errs.LockedError
+	if errors.As(err, &locked) {
+		return locked.RetryAfter, true
+	}
+
+	return 0, false
+}
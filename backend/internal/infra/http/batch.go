@@ -0,0 +1,352 @@
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	maxBatchBodySize = 32 << 20
+
+	jsonContentType      = "application/json"
+	jsonlContentType     = "application/x-ndjson"
+	csvContentType       = "text/csv"
+	multipartContentType = "multipart/form-data"
+
+	batchFileField = "file"
+)
+
+// batchResultLine is a line of the JSONL results file.
+type batchResultLine struct {
+	Index     int            `json:"index"`
+	TriggerID uuid.UUID      `json:"triggerId"`
+	Status    string         `json:"status"`
+	Inputs    map[string]any `json:"inputs"`
+	Result    any            `json:"result,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+func (s *Server) CreateBatch(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	params gen.CreateBatchParams,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionRun); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxBatchBodySize)
+
+	inputs, concurrency, err := s.parseBatchInputs(r)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if concurrency == nil {
+		concurrency = params.Concurrency
+	}
+
+	id := uuid.New()
+	err = s.app.Commands.AddBatch.Handle(r.Context(), command.AddBatchCommand{
+		ID:          id,
+		ProjectID:   projectID,
+		WorkflowID:  model.WorkflowID(workflowID),
+		Inputs:      inputs,
+		Concurrency: valueOrZero(concurrency),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) ListBatches(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	batches, err := s.app.Queries.ListBatches.Handle(r.Context(), query.ListBatchesQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryBatchesToDTOs(projectID, batches))
+}
+
+func (s *Server) GetBatch(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	batchID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	batch, err := s.app.Queries.GetBatch.Handle(r.Context(), query.GetBatchQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		BatchID:    batchID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryBatchToDTO(projectID, batch))
+}
+
+func (s *Server) DownloadBatchResults(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	batchID gen.UUID,
+	params gen.DownloadBatchResultsParams,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	items, err := s.app.Queries.GetBatchResults.Handle(r.Context(), query.GetBatchResultsQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		BatchID:    batchID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	format := gen.DownloadBatchResultsParamsFormatJsonl
+	if params.Format != nil {
+		format = *params.Format
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+	switch format {
+	case gen.DownloadBatchResultsParamsFormatCsv:
+		body, err = batchResultsCSV(items)
+		contentType = csvContentType
+	case gen.DownloadBatchResultsParamsFormatJsonl:
+		body, err = batchResultsJSONL(items)
+		contentType = jsonlContentType
+	default:
+		err = errs.InvalidError{Field: "format", Reason: "format must be jsonl or csv"}
+	}
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="batch-%s.%s"`, batchID, format))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// parseBatchInputs reads the input sets of a JSON body, of a raw JSONL or CSV
+// body, or of the file field of a multipart form. Only JSON bodies carry the concurrency.
+func (s *Server) parseBatchInputs(r *http.Request) ([]map[string]any, *int, error) {
+	mediaType := jsonContentType
+	if header := r.Header.Get("Content-Type"); header != "" {
+		var err error
+		mediaType, _, err = mime.ParseMediaType(header)
+		if err != nil {
+			return nil, nil, errs.InvalidError{Field: "Content-Type", Reason: "invalid content type", Err: err}
+		}
+	}
+
+	switch mediaType {
+	case jsonContentType:
+		req := new(gen.CreateBatchRequest)
+		if err := s.server.ParseBody(r, req); err != nil {
+			return nil, nil, err
+		}
+		return req.Inputs, req.Concurrency, nil
+	case jsonlContentType:
+		inputs, err := parseJSONLInputs(r.Body)
+		return inputs, nil, err
+	case csvContentType:
+		inputs, err := parseCSVInputs(r.Body)
+		return inputs, nil, err
+	case multipartContentType:
+		file, header, err := r.FormFile(batchFileField)
+		if err != nil {
+			return nil, nil, errs.ReqMissingError{Field: batchFileField}
+		}
+		defer file.Close()
+
+		isCSV := header.Header.Get("Content-Type") == csvContentType ||
+			strings.EqualFold(filepath.Ext(header.Filename), ".csv")
+		if isCSV {
+			inputs, err := parseCSVInputs(file)
+			return inputs, nil, err
+		}
+		inputs, err := parseJSONLInputs(file)
+		return inputs, nil, err
+	default:
+		return nil, nil, errs.InvalidError{Field: "Content-Type", Reason: "unsupported content type " + mediaType}
+	}
+}
+
+// parseJSONLInputs reads one JSON object per line, blank lines are skipped.
+func parseJSONLInputs(reader io.Reader) ([]map[string]any, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxBatchBodySize)
+
+	inputs := []map[string]any{}
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var in map[string]any
+		if err := json.Unmarshal(text, &in); err != nil {
+			return nil, errs.InvalidError{
+				Field:  "body",
+				Reason: "line " + strconv.Itoa(line) + " is not a JSON object",
+				Err:    err,
+			}
+		}
+		inputs = append(inputs, in)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errs.InvalidError{Field: "body", Reason: "unable to read body", Err: err}
+	}
+	return inputs, nil
+}
+
+// parseCSVInputs reads a header row naming the inputs, then one input set per row.
+// Values are passed to the workflow as strings.
+func parseCSVInputs(reader io.Reader) ([]map[string]any, error) {
+	records, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, errs.InvalidError{Field: "body", Reason: "invalid CSV", Err: err}
+	}
+
+	if len(records) == 0 {
+		return []map[string]any{}, nil
+	}
+
+	header := records[0]
+	inputs := make([]map[string]any, 0, len(records)-1)
+	for _, record := range records[1:] {
+		in := make(map[string]any, len(header))
+		for i, name := range header {
+			in[name] = record[i]
+		}
+		inputs = append(inputs, in)
+	}
+	return inputs, nil
+}
+
+func batchResultsJSONL(items []query.BatchItem) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, item := range items {
+		err := encoder.Encode(batchResultLine{
+			Index:     item.Index,
+			TriggerID: item.TriggerID,
+			Status:    item.Status,
+			Inputs:    item.Inputs,
+			Result:    item.Result,
+			Error:     item.Error,
+		})
+		if err != nil {
+			return nil, errs.InternalError{Err: err}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// batchResultsCSV writes a column per input name, the result is written as JSON
+// unless it is a string.
+func batchResultsCSV(items []query.BatchItem) ([]byte, error) {
+	names := []string{}
+	for _, item := range items {
+		for name := range item.Inputs {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := append([]string{"index", "trigger_id", "status"}, names...)
+	if err := writer.Write(append(header, "result", "error")); err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+
+	for _, item := range items {
+		record := []string{strconv.Itoa(item.Index), item.TriggerID.String(), item.Status}
+		for _, name := range names {
+			record = append(record, csvValue(item.Inputs[name]))
+		}
+
+		if err := writer.Write(append(record, csvValue(item.Result), item.Error)); err != nil {
+			return nil, errs.InternalError{Err: err}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+	return buf.Bytes(), nil
+}
+
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) CreateBudget(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	req := new(gen.CreateBudgetRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.AddBudget.Handle(r.Context(), command.AddBudgetCommand{
+		ID:           id,
+		ProjectID:    projectID,
+		Scope:        model.BudgetScope(req.Scope),
+		WorkflowID:   model.WorkflowID(valueOrZero(req.WorkflowId)),
+		CredentialID: req.CredentialId,
+		Period:       model.BudgetPeriod(req.Period),
+		LimitUSD:     req.LimitUsd,
+		Thresholds:   valueOrZero(req.Thresholds),
+		Hard:         valueOrZero(req.Hard),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) ListBudgets(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	budgets, err := s.app.Queries.ListBudgets.Handle(r.Context(), query.ListBudgetsQuery{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryBudgetsToDTOs(budgets))
+}
+
+func (s *Server) GetBudget(w http.ResponseWriter, r *http.Request, projectID gen.UUID, budgetID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	budget, err := s.app.Queries.GetBudget.Handle(r.Context(), query.GetBudgetQuery{
+		ProjectID: projectID,
+		BudgetID:  budgetID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryBudgetToDTO(budget))
+}
+
+func (s *Server) UpdateBudget(w http.ResponseWriter, r *http.Request, projectID gen.UUID, budgetID gen.UUID) {
+	req := new(gen.UpdateBudgetRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.UpdateBudget.Handle(r.Context(), command.UpdateBudgetCommand{
+		ID:         budgetID,
+		ProjectID:  projectID,
+		Period:     model.BudgetPeriod(req.Period),
+		LimitUSD:   req.LimitUsd,
+		Thresholds: valueOrZero(req.Thresholds),
+		Hard:       valueOrZero(req.Hard),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, idResponse{
+		ID: budgetID.String(),
+	})
+}
+
+func (s *Server) DeleteBudget(w http.ResponseWriter, r *http.Request, projectID gen.UUID, budgetID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveBudget.Handle(r.Context(), command.RemoveBudgetCommand{
+		ID:        budgetID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/query"
 	"github.com/supallm/core/internal/infra/http/gen"
-	"github.com/supallm/core/internal/pkg/secret"
 )
 
 func (s *Server) CreateCredential(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
@@ -18,13 +17,18 @@ func (s *Server) CreateCredential(w http.ResponseWriter, r *http.Request, projec
 		return
 	}
 
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	id := uuid.New()
 	err := s.app.Commands.AddCredential.Handle(r.Context(), command.AddCredentialCommand{
 		ID:           id,
 		ProjectID:    projectID,
 		Name:         req.Name,
 		ProviderType: model.ProviderType(req.Provider),
-		APIKey:       secret.APIKey(req.ApiKey),
+		Payload:      credentialPayload(req.Payload, req.ApiKey),
 	})
 	if err != nil {
 		s.server.RespondErr(w, r, err)
@@ -37,6 +41,11 @@ func (s *Server) CreateCredential(w http.ResponseWriter, r *http.Request, projec
 }
 
 func (s *Server) GetCredential(w http.ResponseWriter, r *http.Request, projectID gen.UUID, credentialID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	credential, err := s.app.Queries.GetCredential.Handle(r.Context(), query.GetCredentialQuery{
 		ProjectID:    projectID,
 		CredentialID: credentialID,
@@ -56,16 +65,16 @@ func (s *Server) UpdateCredential(w http.ResponseWriter, r *http.Request, projec
 		return
 	}
 
-	apiKey := ""
-	if req.ApiKey != nil {
-		apiKey = *req.ApiKey
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
 	err := s.app.Commands.UpdateCredential.Handle(r.Context(), command.UpdateCredentialCommand{
 		ID:        credentialID,
 		ProjectID: projectID,
-		Name:      req.Name,
-		APIKey:    secret.APIKey(apiKey),
+		Name:      valueOrZero(req.Name),
+		Changes:   credentialPayload(req.Payload, req.ApiKey),
 	})
 	if err != nil {
 		s.server.RespondErr(w, r, err)
@@ -75,9 +84,22 @@ func (s *Server) UpdateCredential(w http.ResponseWriter, r *http.Request, projec
 	s.server.RespondWithContentLocation(w, r, http.StatusOK, "/projects/%s/credentials/%s", projectID, credentialID)
 }
 
-func (s *Server) DeleteCredential(w http.ResponseWriter, r *http.Request, _ gen.UUID, credentialID gen.UUID) {
+func (s *Server) DeleteCredential(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	credentialID gen.UUID,
+	params gen.DeleteCredentialParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	err := s.app.Commands.RemoveCredential.Handle(r.Context(), command.RemoveCredentialCommand{
+		ProjectID:       projectID,
 		LLMCredentialID: credentialID,
+		Force:           valueOrZero(params.Force),
 	})
 	if err != nil {
 		s.server.RespondErr(w, r, err)
@@ -86,7 +108,39 @@ func (s *Server) DeleteCredential(w http.ResponseWriter, r *http.Request, _ gen.
 	s.server.Respond(w, r, http.StatusNoContent, nil)
 }
 
+func (s *Server) TestCredential(w http.ResponseWriter, r *http.Request, projectID gen.UUID, credentialID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.TestCredential.Handle(r.Context(), command.TestCredentialCommand{
+		ProjectID:    projectID,
+		CredentialID: credentialID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	credential, err := s.app.Queries.GetCredential.Handle(r.Context(), query.GetCredentialQuery{
+		ProjectID:    projectID,
+		CredentialID: credentialID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryCredentialToDTO(credential))
+}
+
 func (s *Server) ListCredentials(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	credentials, err := s.app.Queries.ListCredentials.Handle(r.Context(), query.ListCredentialsQuery{
 		ProjectID: projectID,
 	})
@@ -96,3 +150,71 @@ func (s *Server) ListCredentials(w http.ResponseWriter, r *http.Request, project
 	}
 	s.server.Respond(w, r, http.StatusOK, queryCredentialsToDTOs(credentials))
 }
+
+// credentialPayload merges the apiKey shorthand of the requests into their payload.
+func credentialPayload(payload *map[string]any, apiKey *string) map[string]any {
+	fields := map[string]any{}
+	if payload != nil {
+		for name, value := range *payload {
+			fields[name] = value
+		}
+	}
+	if apiKey != nil {
+		fields[model.CredentialAPIKeyField] = *apiKey
+	}
+	return fields
+}
+
+func (s *Server) ListCredentialReferences(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	credentialID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	references, err := s.app.Queries.ListCredentialReferences.Handle(r.Context(), query.ListCredentialReferencesQuery{
+		ProjectID:    projectID,
+		CredentialID: credentialID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryCredentialReferencesToDTOs(references))
+}
+
+func (s *Server) ReplaceCredential(w http.ResponseWriter, r *http.Request, projectID gen.UUID, credentialID gen.UUID) {
+	req := new(gen.ReplaceCredentialRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	var workflowIDs []model.WorkflowID
+	for _, id := range valueOrZero(req.WorkflowIds) {
+		workflowIDs = append(workflowIDs, model.WorkflowID(id))
+	}
+
+	replaced, err := s.app.Commands.ReplaceCredential.Handle(r.Context(), command.ReplaceCredentialCommand{
+		ProjectID:     projectID,
+		CredentialID:  credentialID,
+		ReplacementID: req.ReplacementId,
+		WorkflowIDs:   workflowIDs,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, modelCredentialReferencesToDTOs(replaced))
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// authenticateEndUser verifies an end-user token with the auth provider of
+// the project of the request.
+func (s *Server) authenticateEndUser(r *http.Request, token string) (string, error) {
+	projectID, err := s.server.ParseUUID(r, "projectId")
+	if err != nil {
+		return "", err
+	}
+
+	endUser, err := s.app.Commands.AuthenticateEndUser.Handle(r.Context(), command.AuthenticateEndUserCommand{
+		ProjectID: projectID,
+		Token:     token,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return endUser.ID, nil
+}
+
+// eventListener returns the end-user listening to the events of the project,
+// nil for the dashboard and the holders of the project secret key who
+// receive the events of every end-user.
+func (s *Server) eventListener(ctx context.Context, projectID uuid.UUID) *string {
+	if s.server.IsDashboardOrigin(ctx) {
+		return nil
+	}
+
+	if secretKey, err := s.server.GetSecretKeyFromContext(ctx); err == nil {
+		err = s.app.Commands.AuthorizeEventSubscription.Handle(ctx, command.AuthorizeEventSubscriptionCommand{
+			ProjectID: projectID,
+			SecretKey: secretKey,
+		})
+		if err == nil {
+			return nil
+		}
+	}
+
+	endUserID, _ := s.server.AuthenticatedEndUser(ctx)
+	return &endUserID
+}
+
+// streamListener returns the listener of the events of every execution of the
+// project, anonymous listeners are rejected as these executions are not theirs.
+func (s *Server) streamListener(ctx context.Context, projectID uuid.UUID) (*string, error) {
+	listener := s.eventListener(ctx, projectID)
+	if listener != nil && *listener == "" {
+		return nil, errs.UnauthorizedError{Err: errors.New("an end-user token or the secret key is required")}
+	}
+
+	return listener, nil
+}
+
+// authorizeTriggerListening rejects listeners other than the end-user
+// who made the trigger.
+func (s *Server) authorizeTriggerListening(
+	ctx context.Context,
+	projectID uuid.UUID,
+	triggerID uuid.UUID,
+	listener *string,
+) error {
+	if listener == nil {
+		return nil
+	}
+
+	if triggerID == uuid.Nil {
+		return errs.ReqMissingError{Field: "triggerId"}
+	}
+
+	return s.app.Commands.AuthorizeTriggerListening.Handle(ctx, command.AuthorizeTriggerListeningCommand{
+		ProjectID: projectID,
+		TriggerID: triggerID,
+		EndUserID: *listener,
+	})
+}
@@ -0,0 +1,295 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) CreateDataset(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	req := new(gen.CreateDatasetRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	var cases []model.DatasetCase
+	if req.Cases != nil {
+		cases = dtoToDatasetCases(*req.Cases)
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.AddDataset.Handle(r.Context(), command.AddDatasetCommand{
+		ID:          id,
+		ProjectID:   projectID,
+		Name:        req.Name,
+		Description: valueOrZero(req.Description),
+		Cases:       cases,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) ListDatasets(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	datasets, err := s.app.Queries.ListDatasets.Handle(r.Context(), query.ListDatasetsQuery{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryDatasetsToDTOs(datasets))
+}
+
+func (s *Server) GetDataset(w http.ResponseWriter, r *http.Request, projectID gen.UUID, datasetID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	dataset, err := s.app.Queries.GetDataset.Handle(r.Context(), query.GetDatasetQuery{
+		ProjectID: projectID,
+		DatasetID: datasetID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryDatasetToDTO(dataset))
+}
+
+func (s *Server) UpdateDataset(w http.ResponseWriter, r *http.Request, projectID gen.UUID, datasetID gen.UUID) {
+	req := new(gen.UpdateDatasetRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.UpdateDataset.Handle(r.Context(), command.UpdateDatasetCommand{
+		ID:          datasetID,
+		ProjectID:   projectID,
+		Name:        req.Name,
+		Description: valueOrZero(req.Description),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, idResponse{
+		ID: datasetID.String(),
+	})
+}
+
+func (s *Server) DeleteDataset(w http.ResponseWriter, r *http.Request, projectID gen.UUID, datasetID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveDataset.Handle(r.Context(), command.RemoveDatasetCommand{
+		ID:        datasetID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListDatasetCases(w http.ResponseWriter, r *http.Request, projectID gen.UUID, datasetID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	cases, err := s.app.Queries.ListDatasetCases.Handle(r.Context(), query.ListDatasetCasesQuery{
+		ProjectID: projectID,
+		DatasetID: datasetID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryDatasetCasesToDTOs(cases))
+}
+
+func (s *Server) AddDatasetCases(w http.ResponseWriter, r *http.Request, projectID gen.UUID, datasetID gen.UUID) {
+	req := new(gen.AddDatasetCasesRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.AddDatasetCases.Handle(r.Context(), command.AddDatasetCasesCommand{
+		DatasetID: datasetID,
+		ProjectID: projectID,
+		Cases:     dtoToDatasetCases(req.Cases),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, idResponse{
+		ID: datasetID.String(),
+	})
+}
+
+func (s *Server) CreateEvalRun(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	req := new(gen.CreateEvalRunRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionRun); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.AddEvalRun.Handle(r.Context(), command.AddEvalRunCommand{
+		ID:          id,
+		ProjectID:   projectID,
+		WorkflowID:  model.WorkflowID(workflowID),
+		DatasetID:   req.DatasetId,
+		Scorers:     dtoToScorerConfigs(req.Scorers),
+		Concurrency: valueOrZero(req.Concurrency),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) ListEvalRuns(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	runs, err := s.app.Queries.ListEvalRuns.Handle(r.Context(), query.ListEvalRunsQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryEvalRunsToDTOs(runs))
+}
+
+func (s *Server) GetEvalRun(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	evalRunID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	run, err := s.app.Queries.GetEvalRun.Handle(r.Context(), query.GetEvalRunQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		EvalRunID:  evalRunID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryEvalRunToDTO(run))
+}
+
+func (s *Server) ListEvalCases(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	evalRunID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	cases, err := s.app.Queries.ListEvalCases.Handle(r.Context(), query.ListEvalCasesQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		EvalRunID:  evalRunID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryEvalCasesToDTOs(cases))
+}
+
+func (s *Server) CompareEvalRuns(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	params gen.CompareEvalRunsParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	comparison, err := s.app.Queries.CompareEvalRuns.Handle(r.Context(), query.CompareEvalRunsQuery{
+		ProjectID: projectID,
+		BaseID:    params.Base,
+		HeadID:    params.Head,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryEvalComparisonToDTO(comparison))
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"net/http"
 
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/query"
 	"github.com/supallm/core/internal/infra/http/gen"
 )
@@ -13,6 +14,11 @@ func (s *Server) ListWorkflowExecutions(
 	projectID gen.UUID,
 	workflowID string,
 ) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	executions, err := s.app.Queries.GetWorkflowExecutions.Handle(r.Context(), query.GetWorkflowExecutionsQuery{
 		WorkflowID: workflowID,
 	})
@@ -31,6 +37,11 @@ func (s *Server) GetWorkflowExecution(
 	workflowID string,
 	triggerID gen.UUID,
 ) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	execution, err := s.app.Queries.GetTriggerExecution.Handle(r.Context(), query.GetTriggerExecutionQuery{
 		WorkflowID: workflowID,
 		TriggerID:  triggerID,
@@ -7,26 +7,129 @@ import (
 	"time"
 
 	uuid "github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AuditEventActorType.
+const (
+	AuditEventActorTypeAnonymous AuditEventActorType = "anonymous"
+	AuditEventActorTypeApiKey    AuditEventActorType = "api_key"
+	AuditEventActorTypeSystem    AuditEventActorType = "system"
+	AuditEventActorTypeUser      AuditEventActorType = "user"
+)
+
 // Defines values for AuthProviderProvider.
 const (
+	AuthProviderProviderAuth0    AuthProviderProvider = "auth0"
 	AuthProviderProviderClerk    AuthProviderProvider = "clerk"
 	AuthProviderProviderFirebase AuthProviderProvider = "firebase"
+	AuthProviderProviderOidc     AuthProviderProvider = "oidc"
 	AuthProviderProviderSupabase AuthProviderProvider = "supabase"
 )
 
+// Defines values for BatchStatus.
+const (
+	BatchStatusCompleted BatchStatus = "completed"
+	BatchStatusRunning   BatchStatus = "running"
+)
+
+// Defines values for BudgetPeriod.
+const (
+	Daily   BudgetPeriod = "daily"
+	Monthly BudgetPeriod = "monthly"
+)
+
+// Defines values for BudgetScope.
+const (
+	BudgetScopeCredential BudgetScope = "credential"
+	BudgetScopeProject    BudgetScope = "project"
+	BudgetScopeWorkflow   BudgetScope = "workflow"
+)
+
+// Defines values for CredentialStatus.
+const (
+	CredentialStatusFailed     CredentialStatus = "failed"
+	CredentialStatusUnverified CredentialStatus = "unverified"
+	CredentialStatusVerified   CredentialStatus = "verified"
+)
+
+// Defines values for EvalRunStatus.
+const (
+	EvalRunStatusCompleted EvalRunStatus = "completed"
+	EvalRunStatusRunning   EvalRunStatus = "running"
+)
+
+// Defines values for Role.
+const (
+	Editor Role = "editor"
+	Owner  Role = "owner"
+	Runner Role = "runner"
+	Viewer Role = "viewer"
+)
+
+// Defines values for ScorerType.
+const (
+	Contains   ScorerType = "contains"
+	Exact      ScorerType = "exact"
+	JsonSchema ScorerType = "json_schema"
+	Numeric    ScorerType = "numeric"
+	Regex      ScorerType = "regex"
+)
+
 // Defines values for UpdateAuthRequestProvider.
 const (
+	UpdateAuthRequestProviderAuth0    UpdateAuthRequestProvider = "auth0"
 	UpdateAuthRequestProviderClerk    UpdateAuthRequestProvider = "clerk"
 	UpdateAuthRequestProviderFirebase UpdateAuthRequestProvider = "firebase"
+	UpdateAuthRequestProviderOidc     UpdateAuthRequestProvider = "oidc"
 	UpdateAuthRequestProviderSupabase UpdateAuthRequestProvider = "supabase"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookVerification.
+const (
+	Github     WebhookVerification = "github"
+	HmacSha256 WebhookVerification = "hmac_sha256"
+	None       WebhookVerification = "none"
+	Stripe     WebhookVerification = "stripe"
+	Token      WebhookVerification = "token"
+)
+
+// Defines values for ExportProjectAuditEventsParamsFormat.
+const (
+	ExportProjectAuditEventsParamsFormatCsv   ExportProjectAuditEventsParamsFormat = "csv"
+	ExportProjectAuditEventsParamsFormatJsonl ExportProjectAuditEventsParamsFormat = "jsonl"
+)
+
+// Defines values for DownloadBatchResultsParamsFormat.
+const (
+	DownloadBatchResultsParamsFormatCsv   DownloadBatchResultsParamsFormat = "csv"
+	DownloadBatchResultsParamsFormatJsonl DownloadBatchResultsParamsFormat = "jsonl"
+)
+
+// AcceptInvitationRequest defines model for AcceptInvitationRequest.
+type AcceptInvitationRequest struct {
+	// Name Defaults to the name of the invitation
+	Name     *string `json:"name,omitempty"`
+	Password string  `json:"password"`
+	Token    string  `json:"token"`
+}
+
+// AddDatasetCasesRequest defines model for AddDatasetCasesRequest.
+type AddDatasetCasesRequest struct {
+	Cases []DatasetCaseInput `json:"cases"`
+}
+
 // ApiKey defines model for ApiKey.
 type ApiKey struct {
 	CreatedAt time.Time `json:"createdAt"`
@@ -34,6 +137,29 @@ type ApiKey struct {
 	Key       string    `json:"key"`
 }
 
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Action    string              `json:"action"`
+	ActorId   *UUID               `json:"actorId,omitempty"`
+	ActorType AuditEventActorType `json:"actorType"`
+
+	// After Changed fields after the action, secrets redacted
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before Changed fields before the action, secrets redacted
+	Before     *map[string]interface{} `json:"before,omitempty"`
+	Details    *map[string]interface{} `json:"details,omitempty"`
+	Id         UUID                    `json:"id"`
+	IpAddress  *string                 `json:"ipAddress,omitempty"`
+	OccurredAt time.Time               `json:"occurredAt"`
+	ProjectId  *UUID                   `json:"projectId,omitempty"`
+	Resource   string                  `json:"resource"`
+	ResourceId string                  `json:"resourceId"`
+}
+
+// AuditEventActorType defines model for AuditEvent.ActorType.
+type AuditEventActorType string
+
 // AuthProvider defines model for AuthProvider.
 type AuthProvider struct {
 	Config   map[string]interface{} `json:"config"`
@@ -43,16 +169,163 @@ type AuthProvider struct {
 // AuthProviderProvider defines model for AuthProvider.Provider.
 type AuthProviderProvider string
 
+// Batch defines model for Batch.
+type Batch struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	Concurrency int        `json:"concurrency"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Failed      int        `json:"failed"`
+	Id          UUID       `json:"id"`
+	Pending     int        `json:"pending"`
+
+	// ResultsUrl Set once the batch is completed
+	ResultsUrl *string     `json:"resultsUrl,omitempty"`
+	Running    int         `json:"running"`
+	Status     BatchStatus `json:"status"`
+	Succeeded  int         `json:"succeeded"`
+	Total      int         `json:"total"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+	WorkflowId string      `json:"workflowId"`
+}
+
+// BatchStatus defines model for BatchStatus.
+type BatchStatus string
+
+// Budget defines model for Budget.
+type Budget struct {
+	CreatedAt    time.Time `json:"createdAt"`
+	CredentialId *UUID     `json:"credentialId,omitempty"`
+
+	// Hard Rejects the triggers the budget applies to once exhausted
+	Hard        bool         `json:"hard"`
+	Id          UUID         `json:"id"`
+	LimitUsd    float64      `json:"limitUsd"`
+	Period      BudgetPeriod `json:"period"`
+	PeriodStart time.Time    `json:"periodStart"`
+	Scope       BudgetScope  `json:"scope"`
+
+	// SpentUsd Spend since the start of the current period
+	SpentUsd float64 `json:"spentUsd"`
+
+	// Thresholds Percentages of the limit alerted once per period
+	Thresholds []int     `json:"thresholds"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	WorkflowId *string   `json:"workflowId,omitempty"`
+}
+
+// BudgetPeriod defines model for BudgetPeriod.
+type BudgetPeriod string
+
+// BudgetScope defines model for BudgetScope.
+type BudgetScope string
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// CreateBatchRequest defines model for CreateBatchRequest.
+type CreateBatchRequest struct {
+	// Concurrency Executions running at the same time, defaults to 5, at most 50
+	Concurrency *int                     `json:"concurrency,omitempty"`
+	Inputs      []map[string]interface{} `json:"inputs"`
+}
+
+// CreateBudgetRequest defines model for CreateBudgetRequest.
+type CreateBudgetRequest struct {
+	CredentialId *UUID        `json:"credentialId,omitempty"`
+	Hard         *bool        `json:"hard,omitempty"`
+	LimitUsd     float64      `json:"limitUsd"`
+	Period       BudgetPeriod `json:"period"`
+	Scope        BudgetScope  `json:"scope"`
+	Thresholds   *[]int       `json:"thresholds,omitempty"`
+
+	// WorkflowId Required for a workflow budget
+	WorkflowId *string `json:"workflowId,omitempty"`
+}
+
 // CreateCredentialRequest defines model for CreateCredentialRequest.
 type CreateCredentialRequest struct {
-	ApiKey   string       `json:"apiKey"`
-	Name     string       `json:"name"`
-	Provider ProviderType `json:"provider"`
+	// ApiKey Shorthand for the apiKey field of the payload
+	ApiKey *string `json:"apiKey,omitempty"`
+	Name   string  `json:"name"`
+
+	// Payload Fields of the credential, validated against the schema of the
+	// provider, such as {"apiKey": "..."} or, for postgres,
+	// {"host": "...", "port": 5432, "user": "...", "password": "..."}.
+	Payload  *map[string]interface{} `json:"payload,omitempty"`
+	Provider ProviderType            `json:"provider"`
+}
+
+// CreateDatasetRequest defines model for CreateDatasetRequest.
+type CreateDatasetRequest struct {
+	Cases       *[]DatasetCaseInput `json:"cases,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Name        string              `json:"name"`
+}
+
+// CreateEvalRunRequest defines model for CreateEvalRunRequest.
+type CreateEvalRunRequest struct {
+	// Concurrency Executions running at the same time, defaults to 5, at most 50
+	Concurrency *int     `json:"concurrency,omitempty"`
+	DatasetId   UUID     `json:"datasetId"`
+	Scorers     []Scorer `json:"scorers"`
+}
+
+// CreateOrganizationRequest defines model for CreateOrganizationRequest.
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
 }
 
 // CreateProjectRequest defines model for CreateProjectRequest.
 type CreateProjectRequest struct {
-	Name string `json:"name"`
+	Name           string `json:"name"`
+	OrganizationId *UUID  `json:"organizationId,omitempty"`
+}
+
+// CreateScheduleRequest defines model for CreateScheduleRequest.
+type CreateScheduleRequest struct {
+	// Cron Five-field cron expression or descriptor such as @daily
+	Cron    string                  `json:"cron"`
+	Enabled *bool                   `json:"enabled,omitempty"`
+	Inputs  *map[string]interface{} `json:"inputs,omitempty"`
+
+	// Timezone IANA timezone the expression is evaluated in, defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// CreateUserRequest defines model for CreateUserRequest.
+type CreateUserRequest struct {
+	Admin *bool  `json:"admin,omitempty"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+
+	// Password Temporary password, to be changed on the first login
+	Password string `json:"password"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	EventTypes  *[]string `json:"eventTypes,omitempty"`
+	Url         string    `json:"url"`
+	WorkflowIds *[]string `json:"workflowIds,omitempty"`
+}
+
+// CreateWebhookTriggerRequest defines model for CreateWebhookTriggerRequest.
+type CreateWebhookTriggerRequest struct {
+	// InputMapping Entrypoint inputs mapped to body, raw, body.<path>, headers.<name> or query.<name>
+	InputMapping *map[string]string `json:"inputMapping,omitempty"`
+	Name         string             `json:"name"`
+	RespondSync  *bool              `json:"respondSync,omitempty"`
+
+	// Secret Shared secret, generated when omitted
+	Secret *string `json:"secret,omitempty"`
+
+	// SignatureHeader Header carrying the token or signature, defaults depend on the verification
+	SignatureHeader    *string             `json:"signatureHeader,omitempty"`
+	SyncTimeoutSeconds *int                `json:"syncTimeoutSeconds,omitempty"`
+	Verification       WebhookVerification `json:"verification"`
 }
 
 // CreateWorkflowRequest defines model for CreateWorkflowRequest.
@@ -63,25 +336,182 @@ type CreateWorkflowRequest struct {
 
 // Credential defines model for Credential.
 type Credential struct {
-	ApiKey    string       `json:"apiKey"`
-	CreatedAt time.Time    `json:"createdAt"`
-	Id        UUID         `json:"id"`
-	Name      string       `json:"name"`
-	Provider  ProviderType `json:"provider"`
-	UpdatedAt time.Time    `json:"updatedAt"`
+	ApiKey         string     `json:"apiKey"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	Id             UUID       `json:"id"`
+	LastVerifiedAt *time.Time `json:"lastVerifiedAt,omitempty"`
+	Name           string     `json:"name"`
+
+	// Payload Fields of the credential, secrets obfuscated
+	Payload  map[string]interface{} `json:"payload"`
+	Provider ProviderType           `json:"provider"`
+
+	// Status Outcome of the last test of the credential
+	Status            CredentialStatus `json:"status"`
+	UpdatedAt         time.Time        `json:"updatedAt"`
+	VerificationError *string          `json:"verificationError,omitempty"`
+}
+
+// CredentialStatus Outcome of the last test of the credential
+type CredentialStatus string
+
+// CredentialReference defines model for CredentialReference.
+type CredentialReference struct {
+	NodeId       string `json:"nodeId"`
+	NodeType     string `json:"nodeType"`
+	WorkflowId   string `json:"workflowId"`
+	WorkflowName string `json:"workflowName"`
+}
+
+// CredentialUsage defines model for CredentialUsage.
+type CredentialUsage struct {
+	CredentialId *UUID       `json:"credentialId,omitempty"`
+	Usage        UsageTotals `json:"usage"`
 }
 
+// DailyUsage defines model for DailyUsage.
+type DailyUsage struct {
+	Day   time.Time   `json:"day"`
+	Usage UsageTotals `json:"usage"`
+}
+
+// Dataset defines model for Dataset.
+type Dataset struct {
+	CaseCount   int       `json:"caseCount"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Description string    `json:"description"`
+	Id          UUID      `json:"id"`
+	Name        string    `json:"name"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// DatasetCase defines model for DatasetCase.
+type DatasetCase struct {
+	Expected *interface{}           `json:"expected,omitempty"`
+	Index    int                    `json:"index"`
+	Inputs   map[string]interface{} `json:"inputs"`
+}
+
+// DatasetCaseInput defines model for DatasetCaseInput.
+type DatasetCaseInput struct {
+	// Expected Output expected from the inputs, any JSON value
+	Expected *interface{}           `json:"expected,omitempty"`
+	Inputs   map[string]interface{} `json:"inputs"`
+}
+
+// EvalCase defines model for EvalCase.
+type EvalCase struct {
+	DurationMs int64                  `json:"durationMs"`
+	Error      *string                `json:"error,omitempty"`
+	Expected   *interface{}           `json:"expected,omitempty"`
+	Index      int                    `json:"index"`
+	Inputs     map[string]interface{} `json:"inputs"`
+	Passed     bool                   `json:"passed"`
+	Result     *interface{}           `json:"result,omitempty"`
+	Scores     map[string]float64     `json:"scores"`
+	Status     string                 `json:"status"`
+}
+
+// EvalComparison defines model for EvalComparison.
+type EvalComparison struct {
+	Base          EvalRun `json:"base"`
+	Head          EvalRun `json:"head"`
+	Improvements  []int   `json:"improvements"`
+	PassRateDelta float64 `json:"passRateDelta"`
+
+	// Regressions Indexes of the cases passing in the base run and failing in the head run
+	Regressions []int                       `json:"regressions"`
+	Scorers     map[string]ScorerComparison `json:"scorers"`
+}
+
+// EvalMetrics defines model for EvalMetrics.
+type EvalMetrics struct {
+	AvgDurationMs float64                  `json:"avgDurationMs"`
+	Cases         int                      `json:"cases"`
+	Failed        int                      `json:"failed"`
+	PassRate      float64                  `json:"passRate"`
+	Passed        int                      `json:"passed"`
+	Scorers       map[string]ScorerMetrics `json:"scorers"`
+	Succeeded     int                      `json:"succeeded"`
+}
+
+// EvalRun defines model for EvalRun.
+type EvalRun struct {
+	BatchId     UUID         `json:"batchId"`
+	CompletedAt *time.Time   `json:"completedAt,omitempty"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	DatasetId   *UUID        `json:"datasetId,omitempty"`
+	Id          UUID         `json:"id"`
+	Metrics     *EvalMetrics `json:"metrics,omitempty"`
+
+	// Revision Digest of the evaluated nodes and edges of the workflow
+	Revision   string        `json:"revision"`
+	Scorers    []Scorer      `json:"scorers"`
+	Status     EvalRunStatus `json:"status"`
+	UpdatedAt  time.Time     `json:"updatedAt"`
+	WorkflowId string        `json:"workflowId"`
+}
+
+// EvalRunStatus defines model for EvalRunStatus.
+type EvalRunStatus string
+
 // Execution defines model for Execution.
 type Execution struct {
-	AllNodes       []string                 `json:"allNodes"`
-	CompletedNodes []string                 `json:"completedNodes"`
+	AllNodes       []string `json:"allNodes"`
+	CompletedNodes []string `json:"completedNodes"`
+
+	// EndUserId End-user authenticated by the auth provider of the project when the workflow was triggered
+	EndUserId      *string                  `json:"endUserId,omitempty"`
 	NodeExecutions map[string]NodeExecution `json:"nodeExecutions"`
 	SessionId      string                   `json:"sessionId"`
 	TriggerId      string                   `json:"triggerId"`
+	Variant        *ExecutionVariant        `json:"variant,omitempty"`
 	WorkflowId     string                   `json:"workflowId"`
 	WorkflowInputs WorkflowInputs           `json:"workflowInputs"`
 }
 
+// ExecutionUsage defines model for ExecutionUsage.
+type ExecutionUsage struct {
+	Nodes     []NodeUsage `json:"nodes"`
+	Total     UsageTotals `json:"total"`
+	TriggerId UUID        `json:"triggerId"`
+}
+
+// ExecutionVariant defines model for ExecutionVariant.
+type ExecutionVariant struct {
+	Revision string `json:"revision"`
+
+	// WorkflowId Workflow whose definition ran the trigger
+	WorkflowId string `json:"workflowId"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	Admin     bool      `json:"admin"`
+	CreatedAt time.Time `json:"createdAt"`
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Id        UUID      `json:"id"`
+	InvitedBy UUID      `json:"invitedBy"`
+	Name      string    `json:"name"`
+}
+
+// InviteUserRequest defines model for InviteUserRequest.
+type InviteUserRequest struct {
+	Admin *bool   `json:"admin,omitempty"`
+	Email string  `json:"email"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// IssuedToken defines model for IssuedToken.
+type IssuedToken struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+	Id        *UUID     `json:"id,omitempty"`
+
+	// Token Returned once, only its digest is stored
+	Token string `json:"token"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Email    string `json:"email"`
@@ -90,10 +520,53 @@ type LoginRequest struct {
 
 // LoginResponse defines model for LoginResponse.
 type LoginResponse struct {
+	// ExpiresAt Expiration of the access token
+	ExpiresAt    time.Time `json:"expiresAt"`
+	RefreshToken string    `json:"refreshToken"`
+
+	// Token Short-lived access token, refreshed with the refresh token
 	Token string `json:"token"`
 	User  User   `json:"user"`
 }
 
+// Member defines model for Member.
+type Member struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+
+	// Role Viewers read the workflows and executions, runners also trigger them, editors also edit the workflows, credentials and settings, owners also manage the members and delete the project.
+	Role   Role `json:"role"`
+	UserId UUID `json:"userId"`
+}
+
+// MfaChallenge defines model for MfaChallenge.
+type MfaChallenge struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// MfaToken Completes the login with loginMfa
+	MfaToken string `json:"mfaToken"`
+}
+
+// MfaCodeRequest defines model for MfaCodeRequest.
+type MfaCodeRequest struct {
+	// Code A code of the authenticator app, or a recovery code except to confirm the enrollment
+	Code string `json:"code"`
+}
+
+// MfaLoginRequest defines model for MfaLoginRequest.
+type MfaLoginRequest struct {
+	// Code A code of the authenticator app or a recovery code
+	Code     string `json:"code"`
+	MfaToken string `json:"mfaToken"`
+}
+
+// ModelUsage defines model for ModelUsage.
+type ModelUsage struct {
+	Model string      `json:"model"`
+	Usage UsageTotals `json:"usage"`
+}
+
 // NodeExecution defines model for NodeExecution.
 type NodeExecution struct {
 	ExecutionTime int                    `json:"executionTime"`
@@ -103,21 +576,223 @@ type NodeExecution struct {
 	Success       bool                   `json:"success"`
 }
 
+// NodeUsage defines model for NodeUsage.
+type NodeUsage struct {
+	Calls         int     `json:"calls"`
+	CostUsd       float64 `json:"costUsd"`
+	CredentialId  *UUID   `json:"credentialId,omitempty"`
+	InputTokens   int64   `json:"inputTokens"`
+	Model         string  `json:"model"`
+	NodeId        string  `json:"nodeId"`
+	NodeType      string  `json:"nodeType"`
+	OutputTokens  int64   `json:"outputTokens"`
+	UnpricedCalls int     `json:"unpricedCalls"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        UUID      `json:"id"`
+	Name      string    `json:"name"`
+
+	// Role Viewers read the workflows and executions, runners also trigger them, editors also edit the workflows, credentials and settings, owners also manage the members and delete the project.
+	Role      Role      `json:"role"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // Project defines model for Project.
 type Project struct {
-	ApiKey       ApiKey       `json:"apiKey"`
-	AuthProvider AuthProvider `json:"authProvider"`
-	CreatedAt    time.Time    `json:"createdAt"`
-	Credentials  []Credential `json:"credentials"`
-	Id           UUID         `json:"id"`
-	Name         string       `json:"name"`
-	UpdatedAt    time.Time    `json:"updatedAt"`
-	Workflows    []Workflow   `json:"workflows"`
+	ApiKey         ApiKey       `json:"apiKey"`
+	AuthProvider   AuthProvider `json:"authProvider"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	Credentials    []Credential `json:"credentials"`
+	Id             UUID         `json:"id"`
+	Name           string       `json:"name"`
+	OrganizationId *UUID        `json:"organizationId,omitempty"`
+	UpdatedAt      time.Time    `json:"updatedAt"`
+	Workflows      []Workflow   `json:"workflows"`
+}
+
+// ProjectUsage defines model for ProjectUsage.
+type ProjectUsage struct {
+	Daily  []DailyUsage `json:"daily"`
+	From   time.Time    `json:"from"`
+	Models []ModelUsage `json:"models"`
+	To     time.Time    `json:"to"`
+	Total  UsageTotals  `json:"total"`
 }
 
 // ProviderType defines model for ProviderType.
 type ProviderType = string
 
+// RateLimit defines model for RateLimit.
+type RateLimit struct {
+	// ApiKeyRequestsPerMinute Triggers per minute of each API key, 0 when unlimited
+	ApiKeyRequestsPerMinute int `json:"apiKeyRequestsPerMinute"`
+
+	// Burst Triggers accepted at once, defaults to the limit per minute
+	Burst     int       `json:"burst"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EndUserRequestsPerMinute Triggers per minute of each end-user, 0 when unlimited
+	EndUserRequestsPerMinute int `json:"endUserRequestsPerMinute"`
+
+	// RequestsPerMinute Triggers per minute of all the callers, 0 when unlimited
+	RequestsPerMinute int       `json:"requestsPerMinute"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	WorkflowId        *string   `json:"workflowId,omitempty"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	// Codes Returned once, each one stands in for a code once
+	Codes []string `json:"codes"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// ReplaceCredentialRequest defines model for ReplaceCredentialRequest.
+type ReplaceCredentialRequest struct {
+	ReplacementId UUID `json:"replacementId"`
+
+	// WorkflowIds Workflows to update, all of them when empty
+	WorkflowIds *[]string `json:"workflowIds,omitempty"`
+}
+
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// Role Viewers read the workflows and executions, runners also trigger them, editors also edit the workflows, credentials and settings, owners also manage the members and delete the project.
+type Role string
+
+// SaveMemberRequest defines model for SaveMemberRequest.
+type SaveMemberRequest struct {
+	// Role Viewers read the workflows and executions, runners also trigger them, editors also edit the workflows, credentials and settings, owners also manage the members and delete the project.
+	Role Role `json:"role"`
+}
+
+// SaveRateLimitRequest defines model for SaveRateLimitRequest.
+type SaveRateLimitRequest struct {
+	ApiKeyRequestsPerMinute *int `json:"apiKeyRequestsPerMinute,omitempty"`
+	Burst                   *int `json:"burst,omitempty"`
+
+	// EndUserRequestsPerMinute End-users are identified by their token, by the X-End-User-Id header of the requests made with the secret key, or else by their address
+	EndUserRequestsPerMinute *int `json:"endUserRequestsPerMinute,omitempty"`
+	RequestsPerMinute        *int `json:"requestsPerMinute,omitempty"`
+}
+
+// SaveTrafficSplitRequest defines model for SaveTrafficSplitRequest.
+type SaveTrafficSplitRequest struct {
+	Enabled  *bool                 `json:"enabled,omitempty"`
+	Variants []TrafficSplitVariant `json:"variants"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	CreatedAt     time.Time              `json:"createdAt"`
+	Cron          string                 `json:"cron"`
+	Enabled       bool                   `json:"enabled"`
+	Id            UUID                   `json:"id"`
+	Inputs        map[string]interface{} `json:"inputs"`
+	LastError     string                 `json:"lastError"`
+	LastFiredAt   *time.Time             `json:"lastFiredAt,omitempty"`
+	LastTriggerId *UUID                  `json:"lastTriggerId,omitempty"`
+	NextFireAt    time.Time              `json:"nextFireAt"`
+	Timezone      string                 `json:"timezone"`
+	UpdatedAt     time.Time              `json:"updatedAt"`
+	WorkflowId    string                 `json:"workflowId"`
+}
+
+// Scorer defines model for Scorer.
+type Scorer struct {
+	// Field Dot separated path of the scored value in the result, the whole result when empty
+	Field      *string `json:"field,omitempty"`
+	IgnoreCase *bool   `json:"ignoreCase,omitempty"`
+	Name       string  `json:"name"`
+
+	// Pattern Pattern of the regex scorer, the expected output when empty
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Schema Schema of the json_schema scorer, the expected output when empty
+	Schema *map[string]interface{} `json:"schema,omitempty"`
+
+	// Threshold Score a case needs to pass, defaults to 1
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// Tolerance Absolute tolerance of the numeric scorer
+	Tolerance *float64   `json:"tolerance,omitempty"`
+	Type      ScorerType `json:"type"`
+}
+
+// ScorerComparison defines model for ScorerComparison.
+type ScorerComparison struct {
+	BaseMean float64 `json:"baseMean"`
+	Delta    float64 `json:"delta"`
+	HeadMean float64 `json:"headMean"`
+}
+
+// ScorerMetrics defines model for ScorerMetrics.
+type ScorerMetrics struct {
+	Mean     float64 `json:"mean"`
+	PassRate float64 `json:"passRate"`
+	Scored   int     `json:"scored"`
+}
+
+// ScorerType defines model for ScorerType.
+type ScorerType string
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether it is the session of the request
+	Current    bool      `json:"current"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Id         UUID      `json:"id"`
+	IpAddress  string    `json:"ipAddress"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	UserAgent  string    `json:"userAgent"`
+}
+
+// SessionTokens defines model for SessionTokens.
+type SessionTokens struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// RefreshToken Replaces the refresh token exchanged
+	RefreshToken string `json:"refreshToken"`
+	Token        string `json:"token"`
+}
+
+// TotpEnrollment defines model for TotpEnrollment.
+type TotpEnrollment struct {
+	// Secret Base32 secret, for the apps that cannot scan the URI
+	Secret string `json:"secret"`
+
+	// Uri otpauth URI to render as a QR code
+	Uri string `json:"uri"`
+}
+
+// TrafficSplit defines model for TrafficSplit.
+type TrafficSplit struct {
+	CreatedAt  time.Time             `json:"createdAt"`
+	Enabled    bool                  `json:"enabled"`
+	UpdatedAt  time.Time             `json:"updatedAt"`
+	Variants   []TrafficSplitVariant `json:"variants"`
+	WorkflowId string                `json:"workflowId"`
+}
+
+// TrafficSplitVariant defines model for TrafficSplitVariant.
+type TrafficSplitVariant struct {
+	Weight     int    `json:"weight"`
+	WorkflowId string `json:"workflowId"`
+}
+
 // TriggerWorkflowRequest defines model for TriggerWorkflowRequest.
 type TriggerWorkflowRequest struct {
 	Inputs    map[string]interface{} `json:"inputs"`
@@ -130,6 +805,7 @@ type UUID = uuid.UUID
 
 // UpdateAuthRequest defines model for UpdateAuthRequest.
 type UpdateAuthRequest struct {
+	// Config supabase: url, key, optional jwt_secret for projects signing with the legacy JWT secret and jwks_url. clerk: publishable_key, secret_key and optional jwks_url. oidc: issuer, optional audience, jwks_url or keys (JSON Web Keys) and optional claims mapping user_id and email to claim names, dotted for nested claims. auth0: domain, optional audience and claims. firebase: project_id and optional claims.
 	Config   map[string]interface{}    `json:"config"`
 	Provider UpdateAuthRequestProvider `json:"provider"`
 }
@@ -137,10 +813,31 @@ type UpdateAuthRequest struct {
 // UpdateAuthRequestProvider defines model for UpdateAuthRequest.Provider.
 type UpdateAuthRequestProvider string
 
+// UpdateBudgetRequest defines model for UpdateBudgetRequest.
+type UpdateBudgetRequest struct {
+	Hard       *bool        `json:"hard,omitempty"`
+	LimitUsd   float64      `json:"limitUsd"`
+	Period     BudgetPeriod `json:"period"`
+	Thresholds *[]int       `json:"thresholds,omitempty"`
+}
+
 // UpdateCredentialRequest defines model for UpdateCredentialRequest.
 type UpdateCredentialRequest struct {
+	// ApiKey Shorthand for the apiKey field of the payload
 	ApiKey *string `json:"apiKey,omitempty"`
-	Name   string  `json:"name"`
+
+	// Name Kept when left out
+	Name *string `json:"name,omitempty"`
+
+	// Payload Changes to the fields of the credential. Fields left out are kept,
+	// so secrets need not be entered again, fields set to null are removed.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// UpdateDatasetRequest defines model for UpdateDatasetRequest.
+type UpdateDatasetRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
 }
 
 // UpdateProjectRequest defines model for UpdateProjectRequest.
@@ -148,19 +845,141 @@ type UpdateProjectRequest struct {
 	Name string `json:"name"`
 }
 
+// UpdateScheduleRequest defines model for UpdateScheduleRequest.
+type UpdateScheduleRequest struct {
+	Cron     string                  `json:"cron"`
+	Enabled  bool                    `json:"enabled"`
+	Inputs   *map[string]interface{} `json:"inputs,omitempty"`
+	Timezone *string                 `json:"timezone,omitempty"`
+}
+
+// UpdateWebhookRequest defines model for UpdateWebhookRequest.
+type UpdateWebhookRequest struct {
+	Enabled     bool      `json:"enabled"`
+	EventTypes  *[]string `json:"eventTypes,omitempty"`
+	Url         string    `json:"url"`
+	WorkflowIds *[]string `json:"workflowIds,omitempty"`
+}
+
+// UpdateWebhookTriggerRequest defines model for UpdateWebhookTriggerRequest.
+type UpdateWebhookTriggerRequest struct {
+	Enabled      bool               `json:"enabled"`
+	InputMapping *map[string]string `json:"inputMapping,omitempty"`
+	Name         string             `json:"name"`
+	RespondSync  *bool              `json:"respondSync,omitempty"`
+
+	// Secret New shared secret, the current one is kept when omitted
+	Secret             *string             `json:"secret,omitempty"`
+	SignatureHeader    *string             `json:"signatureHeader,omitempty"`
+	SyncTimeoutSeconds *int                `json:"syncTimeoutSeconds,omitempty"`
+	Verification       WebhookVerification `json:"verification"`
+}
+
 // UpdateWorkflowRequest defines model for UpdateWorkflowRequest.
 type UpdateWorkflowRequest struct {
 	BuilderFlow map[string]interface{} `json:"builderFlow"`
 	Name        string                 `json:"name"`
 }
 
+// UsageTotals defines model for UsageTotals.
+type UsageTotals struct {
+	// Calls LLM calls reporting usage
+	Calls        int     `json:"calls"`
+	CostUsd      float64 `json:"costUsd"`
+	Executions   int     `json:"executions"`
+	InputTokens  int64   `json:"inputTokens"`
+	OutputTokens int64   `json:"outputTokens"`
+
+	// UnpricedCalls Calls of models missing from the price table, not included in costUsd
+	UnpricedCalls int `json:"unpricedCalls"`
+}
+
 // User defines model for User.
 type User struct {
-	Email string `json:"email"`
-	Id    UUID   `json:"id"`
-	Name  string `json:"name"`
+	Admin         bool       `json:"admin"`
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty"`
+	Email         string     `json:"email"`
+	Id            UUID       `json:"id"`
+
+	// MfaEnabled The user logs in with a second factor
+	MfaEnabled bool `json:"mfaEnabled"`
+
+	// MustChangePassword The user is limited to changing their password
+	MustChangePassword bool   `json:"mustChangePassword"`
+	Name               string `json:"name"`
+}
+
+// VariantStats defines model for VariantStats.
+type VariantStats struct {
+	AvgLatencyMs float64 `json:"avgLatencyMs"`
+
+	// EvalCases Cases of completed evaluation runs executed by the variant
+	EvalCases      int       `json:"evalCases"`
+	EvalPassRate   float64   `json:"evalPassRate"`
+	Executions     int       `json:"executions"`
+	Failed         int       `json:"failed"`
+	FirstStartedAt time.Time `json:"firstStartedAt"`
+	LastStartedAt  time.Time `json:"lastStartedAt"`
+	P95LatencyMs   float64   `json:"p95LatencyMs"`
+	Revision       string    `json:"revision"`
+	Succeeded      int       `json:"succeeded"`
+	WorkflowId     string    `json:"workflowId"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Enabled     bool      `json:"enabled"`
+	EventTypes  []string  `json:"eventTypes"`
+	Id          UUID      `json:"id"`
+	Secret      string    `json:"secret"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Url         string    `json:"url"`
+	WorkflowIds []string  `json:"workflowIds"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       int                    `json:"attempts"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	DeliveredAt    *time.Time             `json:"deliveredAt,omitempty"`
+	Error          string                 `json:"error"`
+	EventType      string                 `json:"eventType"`
+	Id             UUID                   `json:"id"`
+	NextAttemptAt  *time.Time             `json:"nextAttemptAt,omitempty"`
+	Payload        map[string]interface{} `json:"payload"`
+	ResponseBody   string                 `json:"responseBody"`
+	ResponseStatus int                    `json:"responseStatus"`
+	Status         WebhookDeliveryStatus  `json:"status"`
+	TriggerId      UUID                   `json:"triggerId"`
+	WebhookId      UUID                   `json:"webhookId"`
 }
 
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookTrigger defines model for WebhookTrigger.
+type WebhookTrigger struct {
+	CreatedAt          time.Time         `json:"createdAt"`
+	Enabled            bool              `json:"enabled"`
+	Id                 UUID              `json:"id"`
+	InputMapping       map[string]string `json:"inputMapping"`
+	Name               string            `json:"name"`
+	RespondSync        bool              `json:"respondSync"`
+	Secret             string            `json:"secret"`
+	SignatureHeader    string            `json:"signatureHeader"`
+	SyncTimeoutSeconds int               `json:"syncTimeoutSeconds"`
+	UpdatedAt          time.Time         `json:"updatedAt"`
+
+	// Url Path receiving the inbound requests, relative to the API base URL
+	Url          string              `json:"url"`
+	Verification WebhookVerification `json:"verification"`
+	WorkflowId   string              `json:"workflowId"`
+}
+
+// WebhookVerification defines model for WebhookVerification.
+type WebhookVerification string
+
 // Workflow defines model for Workflow.
 type Workflow struct {
 	BuilderFlow map[string]interface{} `json:"builderFlow"`
@@ -175,9 +994,153 @@ type WorkflowInputs struct {
 	Prompt string `json:"prompt"`
 }
 
+// WorkflowUsage defines model for WorkflowUsage.
+type WorkflowUsage struct {
+	Usage      UsageTotals `json:"usage"`
+	WorkflowId string      `json:"workflowId"`
+}
+
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	Action   *string    `form:"action,omitempty" json:"action,omitempty"`
+	Resource *string    `form:"resource,omitempty" json:"resource,omitempty"`
+	ActorId  *UUID      `form:"actorId,omitempty" json:"actorId,omitempty"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Before ID of the last event of the previous page
+	Before *UUID `form:"before,omitempty" json:"before,omitempty"`
+	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListProjectAuditEventsParams defines parameters for ListProjectAuditEvents.
+type ListProjectAuditEventsParams struct {
+	Action   *string    `form:"action,omitempty" json:"action,omitempty"`
+	Resource *string    `form:"resource,omitempty" json:"resource,omitempty"`
+	ActorId  *UUID      `form:"actorId,omitempty" json:"actorId,omitempty"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Before ID of the last event of the previous page
+	Before *UUID `form:"before,omitempty" json:"before,omitempty"`
+	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ExportProjectAuditEventsParams defines parameters for ExportProjectAuditEvents.
+type ExportProjectAuditEventsParams struct {
+	Action   *string                               `form:"action,omitempty" json:"action,omitempty"`
+	Resource *string                               `form:"resource,omitempty" json:"resource,omitempty"`
+	ActorId  *UUID                                 `form:"actorId,omitempty" json:"actorId,omitempty"`
+	From     *time.Time                            `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time                            `form:"to,omitempty" json:"to,omitempty"`
+	Format   *ExportProjectAuditEventsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportProjectAuditEventsParamsFormat defines parameters for ExportProjectAuditEvents.
+type ExportProjectAuditEventsParamsFormat string
+
+// DeleteCredentialParams defines parameters for DeleteCredential.
+type DeleteCredentialParams struct {
+	// Force Delete the credential even when workflows use it
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// CompareEvalRunsParams defines parameters for CompareEvalRuns.
+type CompareEvalRunsParams struct {
+	Base UUID `form:"base" json:"base"`
+	Head UUID `form:"head" json:"head"`
+}
+
+// GetProjectUsageParams defines parameters for GetProjectUsage.
+type GetProjectUsageParams struct {
+	// From Start of the range, defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range, exclusive, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListCredentialUsageParams defines parameters for ListCredentialUsage.
+type ListCredentialUsageParams struct {
+	// From Start of the range, defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range, exclusive, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListWorkflowUsageParams defines parameters for ListWorkflowUsage.
+type ListWorkflowUsageParams struct {
+	// From Start of the range, defaults to 30 days before to
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the range, exclusive, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateBatchMultipartBody defines parameters for CreateBatch.
+type CreateBatchMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// CreateBatchParams defines parameters for CreateBatch.
+type CreateBatchParams struct {
+	// Concurrency Concurrency of file uploads, JSON bodies carry it in the body
+	Concurrency *int `form:"concurrency,omitempty" json:"concurrency,omitempty"`
+}
+
+// DownloadBatchResultsParams defines parameters for DownloadBatchResults.
+type DownloadBatchResultsParams struct {
+	Format *DownloadBatchResultsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// DownloadBatchResultsParamsFormat defines parameters for DownloadBatchResults.
+type DownloadBatchResultsParamsFormat string
+
+// ListVariantStatsParams defines parameters for ListVariantStats.
+type ListVariantStatsParams struct {
+	// Since Start of the compared executions, defaults to 7 days ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// InviteUserJSONRequestBody defines body for InviteUser for application/json ContentType.
+type InviteUserJSONRequestBody = InviteUserRequest
+
+// AcceptInvitationJSONRequestBody defines body for AcceptInvitation for application/json ContentType.
+type AcceptInvitationJSONRequestBody = AcceptInvitationRequest
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// LoginMfaJSONRequestBody defines body for LoginMfa for application/json ContentType.
+type LoginMfaJSONRequestBody = MfaLoginRequest
+
+// RegenerateRecoveryCodesJSONRequestBody defines body for RegenerateRecoveryCodes for application/json ContentType.
+type RegenerateRecoveryCodesJSONRequestBody = MfaCodeRequest
+
+// ConfirmTotpJSONRequestBody defines body for ConfirmTotp for application/json ContentType.
+type ConfirmTotpJSONRequestBody = MfaCodeRequest
+
+// DisableTotpJSONRequestBody defines body for DisableTotp for application/json ContentType.
+type DisableTotpJSONRequestBody = MfaCodeRequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = CreateOrganizationRequest
+
+// SaveOrganizationMemberJSONRequestBody defines body for SaveOrganizationMember for application/json ContentType.
+type SaveOrganizationMemberJSONRequestBody = SaveMemberRequest
+
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody = CreateProjectRequest
 
@@ -187,17 +1150,80 @@ type UpdateProjectJSONRequestBody = UpdateProjectRequest
 // UpdateAuthJSONRequestBody defines body for UpdateAuth for application/json ContentType.
 type UpdateAuthJSONRequestBody = UpdateAuthRequest
 
+// CreateBudgetJSONRequestBody defines body for CreateBudget for application/json ContentType.
+type CreateBudgetJSONRequestBody = CreateBudgetRequest
+
+// UpdateBudgetJSONRequestBody defines body for UpdateBudget for application/json ContentType.
+type UpdateBudgetJSONRequestBody = UpdateBudgetRequest
+
 // CreateCredentialJSONRequestBody defines body for CreateCredential for application/json ContentType.
 type CreateCredentialJSONRequestBody = CreateCredentialRequest
 
 // UpdateCredentialJSONRequestBody defines body for UpdateCredential for application/json ContentType.
 type UpdateCredentialJSONRequestBody = UpdateCredentialRequest
 
+// ReplaceCredentialJSONRequestBody defines body for ReplaceCredential for application/json ContentType.
+type ReplaceCredentialJSONRequestBody = ReplaceCredentialRequest
+
+// CreateDatasetJSONRequestBody defines body for CreateDataset for application/json ContentType.
+type CreateDatasetJSONRequestBody = CreateDatasetRequest
+
+// UpdateDatasetJSONRequestBody defines body for UpdateDataset for application/json ContentType.
+type UpdateDatasetJSONRequestBody = UpdateDatasetRequest
+
+// AddDatasetCasesJSONRequestBody defines body for AddDatasetCases for application/json ContentType.
+type AddDatasetCasesJSONRequestBody = AddDatasetCasesRequest
+
+// SaveProjectMemberJSONRequestBody defines body for SaveProjectMember for application/json ContentType.
+type SaveProjectMemberJSONRequestBody = SaveMemberRequest
+
+// SaveProjectRateLimitJSONRequestBody defines body for SaveProjectRateLimit for application/json ContentType.
+type SaveProjectRateLimitJSONRequestBody = SaveRateLimitRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = UpdateWebhookRequest
+
 // CreateWorkflowJSONRequestBody defines body for CreateWorkflow for application/json ContentType.
 type CreateWorkflowJSONRequestBody = CreateWorkflowRequest
 
 // UpdateWorkflowJSONRequestBody defines body for UpdateWorkflow for application/json ContentType.
 type UpdateWorkflowJSONRequestBody = UpdateWorkflowRequest
 
+// CreateBatchJSONRequestBody defines body for CreateBatch for application/json ContentType.
+type CreateBatchJSONRequestBody = CreateBatchRequest
+
+// CreateBatchMultipartRequestBody defines body for CreateBatch for multipart/form-data ContentType.
+type CreateBatchMultipartRequestBody CreateBatchMultipartBody
+
+// CreateEvalRunJSONRequestBody defines body for CreateEvalRun for application/json ContentType.
+type CreateEvalRunJSONRequestBody = CreateEvalRunRequest
+
+// SaveWorkflowRateLimitJSONRequestBody defines body for SaveWorkflowRateLimit for application/json ContentType.
+type SaveWorkflowRateLimitJSONRequestBody = SaveRateLimitRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = CreateScheduleRequest
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = UpdateScheduleRequest
+
+// SaveTrafficSplitJSONRequestBody defines body for SaveTrafficSplit for application/json ContentType.
+type SaveTrafficSplitJSONRequestBody = SaveTrafficSplitRequest
+
 // TriggerWorkflowJSONRequestBody defines body for TriggerWorkflow for application/json ContentType.
 type TriggerWorkflowJSONRequestBody = TriggerWorkflowRequest
+
+// CreateWebhookTriggerJSONRequestBody defines body for CreateWebhookTrigger for application/json ContentType.
+type CreateWebhookTriggerJSONRequestBody = CreateWebhookTriggerRequest
+
+// UpdateWebhookTriggerJSONRequestBody defines body for UpdateWebhookTrigger for application/json ContentType.
+type UpdateWebhookTriggerJSONRequestBody = UpdateWebhookTriggerRequest
+
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshTokenRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = CreateUserRequest
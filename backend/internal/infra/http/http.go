@@ -3,10 +3,12 @@ package http
 import (
 	"context"
 	"log/slog"
+	"net/http"
 	"os"
 
 	"github.com/ThreeDotsLabs/watermill"
-	watermillHTTP "github.com/ThreeDotsLabs/watermill-http/v2/pkg/http"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/supallm/core/internal/application"
 	"github.com/supallm/core/internal/application/event"
 	"github.com/supallm/core/internal/infra/http/gen"
@@ -16,6 +18,7 @@ import (
 type Server struct {
 	server *server.Server
 	app    *application.App
+	events message.Subscriber
 }
 
 func AddHandlers(mux *server.Server, app *application.App) {
@@ -25,39 +28,52 @@ func AddHandlers(mux *server.Server, app *application.App) {
 	}
 
 	logger := watermill.NewSlogLogger(nil)
-	sseRouter, err := watermillHTTP.NewSSERouter(
-		watermillHTTP.SSERouterConfig{
-			UpstreamSubscriber: s.app.EventsSubscriber,
-			ErrorHandler:       watermillHTTP.DefaultErrorHandler,
-			Marshaler:          workflowSSEMarshaller{},
-		},
-		logger,
-	)
+	fanOut, err := gochannel.NewFanOut(s.app.EventsSubscriber, logger)
 	if err != nil {
-		slog.Error("error creating sse router", "error", err)
+		slog.Error("error creating events fan-out", "error", err)
 		os.Exit(1)
 	}
 
-	//nolint:all
-	sseHandler := sseRouter.AddHandler(event.InternalEventsTopic, &workflowSSEAdapter{
-		listWorkflowEvents: s.app.Queries.ListenWorkflowEvents,
-	})
-	s.server.Router.Get(
+	fanOut.AddSubscription(event.InternalEventsTopic)
+	s.events = fanOut
+	s.server.SetUserLoader(s.loadUser)
+	endUsers := s.server.Router.With(s.server.EndUserAuth(s.authenticateEndUser))
+	endUsers.Get(
 		"/projects/{projectId}/workflows/{workflowId}/listen/{triggerId}",
-		s.listenWorkflowEvents(sseHandler),
+		s.listenWorkflowEvents,
 	)
+	endUsers.Get(
+		"/projects/{projectId}/workflows/{workflowId}/ws",
+		s.workflowWebSocket,
+	)
+	endUsers.Get("/projects/{projectId}/events", s.listenProjectEvents)
+	endUsers.Get("/projects/{projectId}/sessions/{sessionId}/events", s.listenSessionEvents)
+	s.server.Router.Post("/hooks/{webhookTriggerId}", s.fireWebhookTrigger)
 
 	go func() {
-		err = sseRouter.Run(context.Background())
-		slog.Debug("running sse router")
+		err = fanOut.Run(context.Background())
+		slog.Debug("running events fan-out")
 		if err != nil {
-			slog.Error("error running sse router", "error", err)
+			slog.Error("error running events fan-out", "error", err)
 			os.Exit(1)
 		}
 	}()
 
-	<-sseRouter.Running()
-	slog.Debug("sse router is ready")
+	<-fanOut.Running()
+	slog.Debug("events fan-out is ready")
+
+	// Triggers authenticate their end-user before the generated routes take them.
+	wrapper := gen.ServerInterfaceWrapper{
+		Handler:            s,
+		HandlerMiddlewares: nil,
+		ErrorHandlerFunc: func(w http.ResponseWriter, _ *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+	}
+	endUsers.Post(
+		"/projects/{projectId}/workflows/{workflowId}/trigger",
+		wrapper.TriggerWorkflow,
+	)
 
 	h := gen.HandlerWithOptions(s, gen.ChiServerOptions{
 		BaseURL: "",
@@ -67,23 +83,3 @@ func AddHandlers(mux *server.Server, app *application.App) {
 	})
 	mux.Router.Mount("/", h)
 }
-
-// func (s *Server) isAuthorize(
-// 	ctx context.Context,
-// 	projectID uuid.UUID,
-// 	fn func(ctx context.Context, cmd command.AuthorizeEventSubscriptionCommand) error,
-// ) error {
-// 	if s.server.IsDashboardOrigin(ctx) {
-// 		return nil
-// 	}
-
-// 	secretKey, err := s.server.GetSecretKeyFromContext(ctx)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	return fn(ctx, command.AuthorizeEventSubscriptionCommand{
-// 		ProjectID: projectID,
-// 		SecretKey: secretKey,
-// 	})
-// }
@@ -1,8 +1,15 @@
 package http
 
 import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/query"
 	"github.com/supallm/core/internal/infra/http/gen"
+	"github.com/supallm/core/internal/pkg/auth"
 )
 
 type idResponse struct {
@@ -11,12 +18,16 @@ type idResponse struct {
 
 func queryCredentialToDTO(credential query.Credential) gen.Credential {
 	return gen.Credential{
-		Id:        credential.ID,
-		Name:      credential.Name,
-		ApiKey:    credential.ObfuscatedAPIKey,
-		Provider:  credential.Provider,
-		CreatedAt: credential.CreatedAt,
-		UpdatedAt: credential.UpdatedAt,
+		Id:                credential.ID,
+		Name:              credential.Name,
+		ApiKey:            credential.ObfuscatedAPIKey,
+		Payload:           credential.Payload,
+		Provider:          credential.Provider,
+		Status:            gen.CredentialStatus(credential.Status),
+		VerificationError: nilIfZero(credential.VerificationError),
+		LastVerifiedAt:    credential.LastVerifiedAt,
+		CreatedAt:         credential.CreatedAt,
+		UpdatedAt:         credential.UpdatedAt,
 	}
 }
 
@@ -36,6 +47,32 @@ func queryCredentialsToDTOs(credentials []query.Credential) []gen.Credential {
 	return dtos
 }
 
+func queryCredentialReferencesToDTOs(references []query.CredentialReference) []gen.CredentialReference {
+	dtos := make([]gen.CredentialReference, len(references))
+	for i, reference := range references {
+		dtos[i] = gen.CredentialReference{
+			WorkflowId:   reference.WorkflowID.String(),
+			WorkflowName: reference.WorkflowName,
+			NodeId:       reference.NodeID,
+			NodeType:     reference.NodeType,
+		}
+	}
+	return dtos
+}
+
+func modelCredentialReferencesToDTOs(references []model.CredentialReference) []gen.CredentialReference {
+	dtos := make([]gen.CredentialReference, len(references))
+	for i, reference := range references {
+		dtos[i] = gen.CredentialReference{
+			WorkflowId:   reference.WorkflowID.String(),
+			WorkflowName: reference.WorkflowName,
+			NodeId:       reference.NodeID,
+			NodeType:     reference.NodeType,
+		}
+	}
+	return dtos
+}
+
 func queryWorkflowToDTO(workflow query.Workflow) gen.Workflow {
 	return gen.Workflow{
 		Id:          workflow.ID.String(),
@@ -76,8 +113,9 @@ func queryProjectToDTO(project query.Project) gen.Project {
 	}
 
 	return gen.Project{
-		Id:   project.ID,
-		Name: project.Name,
+		Id:             project.ID,
+		OrganizationId: project.OrganizationID,
+		Name:           project.Name,
 		AuthProvider: gen.AuthProvider{
 			Provider: gen.AuthProviderProvider(project.AuthProvider.Provider),
 			Config:   project.AuthProvider.Config,
@@ -109,6 +147,19 @@ func queryExecutionToDTO(execution query.Execution) gen.Execution {
 			Prompt: execution.WorkflowInputs.Prompt,
 		},
 		NodeExecutions: queryNodeExecutionsToDTOs(execution.NodeExecutions),
+		Variant:        queryExecutionVariantToDTO(execution.Variant),
+		EndUserId:      nilIfZero(execution.EndUserID),
+	}
+}
+
+func queryExecutionVariantToDTO(variant *query.ExecutionVariant) *gen.ExecutionVariant {
+	if variant == nil {
+		return nil
+	}
+
+	return &gen.ExecutionVariant{
+		WorkflowId: variant.WorkflowID,
+		Revision:   variant.Revision,
 	}
 }
 
@@ -147,3 +198,626 @@ func queryExecutionsToDTOs(executions []query.Execution) []gen.Execution {
 	}
 	return dtos
 }
+
+func queryWebhookToDTO(webhook query.Webhook) gen.Webhook {
+	return gen.Webhook{
+		Id:          webhook.ID,
+		Url:         webhook.URL,
+		EventTypes:  webhook.EventTypes,
+		WorkflowIds: webhook.WorkflowIDs,
+		Secret:      webhook.Secret.String(),
+		Enabled:     webhook.Enabled,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+func queryWebhooksToDTOs(webhooks []query.Webhook) []gen.Webhook {
+	dtos := make([]gen.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		dtos[i] = queryWebhookToDTO(webhook)
+	}
+	return dtos
+}
+
+func queryWebhookDeliveryToDTO(delivery query.WebhookDelivery) gen.WebhookDelivery {
+	payload := map[string]any{}
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		slog.Error("error unmarshalling webhook delivery payload", "error", err)
+	}
+
+	return gen.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.WebhookID,
+		EventType:      delivery.EventType,
+		TriggerId:      delivery.TriggerID,
+		Payload:        payload,
+		Status:         gen.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func queryWebhookDeliveriesToDTOs(deliveries []query.WebhookDelivery) []gen.WebhookDelivery {
+	dtos := make([]gen.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		dtos[i] = queryWebhookDeliveryToDTO(delivery)
+	}
+	return dtos
+}
+
+func queryWebhookTriggerToDTO(trigger query.WebhookTrigger) gen.WebhookTrigger {
+	inputMapping := trigger.InputMapping
+	if inputMapping == nil {
+		inputMapping = map[string]string{}
+	}
+
+	return gen.WebhookTrigger{
+		Id:                 trigger.ID,
+		WorkflowId:         trigger.WorkflowID,
+		Name:               trigger.Name,
+		Url:                fmt.Sprintf("/hooks/%s", trigger.ID),
+		Verification:       gen.WebhookVerification(trigger.Verification),
+		SignatureHeader:    trigger.SignatureHeader,
+		Secret:             trigger.Secret.String(),
+		InputMapping:       inputMapping,
+		RespondSync:        trigger.RespondSync,
+		SyncTimeoutSeconds: int(trigger.SyncTimeout.Seconds()),
+		Enabled:            trigger.Enabled,
+		CreatedAt:          trigger.CreatedAt,
+		UpdatedAt:          trigger.UpdatedAt,
+	}
+}
+
+func queryWebhookTriggersToDTOs(triggers []query.WebhookTrigger) []gen.WebhookTrigger {
+	dtos := make([]gen.WebhookTrigger, len(triggers))
+	for i, trigger := range triggers {
+		dtos[i] = queryWebhookTriggerToDTO(trigger)
+	}
+	return dtos
+}
+
+func queryScheduleToDTO(schedule query.Schedule) gen.Schedule {
+	inputs := schedule.Inputs
+	if inputs == nil {
+		inputs = map[string]any{}
+	}
+
+	return gen.Schedule{
+		Id:            schedule.ID,
+		WorkflowId:    schedule.WorkflowID,
+		Cron:          schedule.CronExpression,
+		Timezone:      schedule.Timezone,
+		Inputs:        inputs,
+		Enabled:       schedule.Enabled,
+		NextFireAt:    schedule.NextFireAt,
+		LastFiredAt:   schedule.LastFiredAt,
+		LastTriggerId: schedule.LastTriggerID,
+		LastError:     schedule.LastError,
+		CreatedAt:     schedule.CreatedAt,
+		UpdatedAt:     schedule.UpdatedAt,
+	}
+}
+
+func querySchedulesToDTOs(schedules []query.Schedule) []gen.Schedule {
+	dtos := make([]gen.Schedule, len(schedules))
+	for i, schedule := range schedules {
+		dtos[i] = queryScheduleToDTO(schedule)
+	}
+	return dtos
+}
+
+func queryBatchToDTO(projectID uuid.UUID, batch query.Batch) gen.Batch {
+	var resultsURL *string
+	if batch.Status == string(model.BatchCompleted) {
+		url := fmt.Sprintf("/projects/%s/workflows/%s/batches/%s/results", projectID, batch.WorkflowID, batch.ID)
+		resultsURL = &url
+	}
+
+	return gen.Batch{
+		Id:          batch.ID,
+		WorkflowId:  batch.WorkflowID,
+		Status:      gen.BatchStatus(batch.Status),
+		Concurrency: batch.Concurrency,
+		Total:       batch.Total,
+		Pending:     batch.Total - batch.Succeeded - batch.Failed - batch.Running,
+		Running:     batch.Running,
+		Succeeded:   batch.Succeeded,
+		Failed:      batch.Failed,
+		ResultsUrl:  resultsURL,
+		CompletedAt: batch.CompletedAt,
+		CreatedAt:   batch.CreatedAt,
+		UpdatedAt:   batch.UpdatedAt,
+	}
+}
+
+func queryBatchesToDTOs(projectID uuid.UUID, batches []query.Batch) []gen.Batch {
+	dtos := make([]gen.Batch, len(batches))
+	for i, batch := range batches {
+		dtos[i] = queryBatchToDTO(projectID, batch)
+	}
+	return dtos
+}
+
+func dtoToDatasetCases(cases []gen.DatasetCaseInput) []model.DatasetCase {
+	domainCases := make([]model.DatasetCase, len(cases))
+	for i, c := range cases {
+		domainCases[i] = model.DatasetCase{
+			Inputs:   c.Inputs,
+			Expected: valueOrZero(c.Expected),
+		}
+	}
+	return domainCases
+}
+
+func dtoToScorerConfigs(scorers []gen.Scorer) []model.ScorerConfig {
+	configs := make([]model.ScorerConfig, len(scorers))
+	for i, scorer := range scorers {
+		configs[i] = model.ScorerConfig{
+			Name:       scorer.Name,
+			Type:       model.ScorerType(scorer.Type),
+			Field:      valueOrZero(scorer.Field),
+			Pattern:    valueOrZero(scorer.Pattern),
+			Schema:     valueOrZero(scorer.Schema),
+			Tolerance:  valueOrZero(scorer.Tolerance),
+			IgnoreCase: valueOrZero(scorer.IgnoreCase),
+			Threshold:  valueOrZero(scorer.Threshold),
+		}
+	}
+	return configs
+}
+
+func scorerConfigsToDTOs(configs []model.ScorerConfig) []gen.Scorer {
+	dtos := make([]gen.Scorer, len(configs))
+	for i, config := range configs {
+		dtos[i] = gen.Scorer{
+			Name:       config.Name,
+			Type:       gen.ScorerType(config.Type),
+			Field:      nilIfZero(config.Field),
+			Pattern:    nilIfZero(config.Pattern),
+			Schema:     nil,
+			Tolerance:  nilIfZero(config.Tolerance),
+			IgnoreCase: nilIfZero(config.IgnoreCase),
+			Threshold:  nilIfZero(config.Threshold),
+		}
+		if config.Schema != nil {
+			dtos[i].Schema = &config.Schema
+		}
+	}
+	return dtos
+}
+
+func queryDatasetToDTO(dataset query.Dataset) gen.Dataset {
+	return gen.Dataset{
+		Id:          dataset.ID,
+		Name:        dataset.Name,
+		Description: dataset.Description,
+		CaseCount:   dataset.CaseCount,
+		CreatedAt:   dataset.CreatedAt,
+		UpdatedAt:   dataset.UpdatedAt,
+	}
+}
+
+func queryDatasetsToDTOs(datasets []query.Dataset) []gen.Dataset {
+	dtos := make([]gen.Dataset, len(datasets))
+	for i, dataset := range datasets {
+		dtos[i] = queryDatasetToDTO(dataset)
+	}
+	return dtos
+}
+
+func queryDatasetCasesToDTOs(cases []query.DatasetCase) []gen.DatasetCase {
+	dtos := make([]gen.DatasetCase, len(cases))
+	for i, c := range cases {
+		dtos[i] = gen.DatasetCase{
+			Index:    c.Index,
+			Inputs:   c.Inputs,
+			Expected: optionalValue(c.Expected),
+		}
+	}
+	return dtos
+}
+
+func evalMetricsToDTO(metrics *model.EvalMetrics) *gen.EvalMetrics {
+	if metrics == nil {
+		return nil
+	}
+
+	scorers := make(map[string]gen.ScorerMetrics, len(metrics.Scorers))
+	for name, m := range metrics.Scorers {
+		scorers[name] = gen.ScorerMetrics{
+			Scored:   m.Scored,
+			Mean:     m.Mean,
+			PassRate: m.PassRate,
+		}
+	}
+
+	return &gen.EvalMetrics{
+		Cases:         metrics.Cases,
+		Succeeded:     metrics.Succeeded,
+		Failed:        metrics.Failed,
+		Passed:        metrics.Passed,
+		PassRate:      metrics.PassRate,
+		AvgDurationMs: metrics.AvgDurationMs,
+		Scorers:       scorers,
+	}
+}
+
+func queryEvalRunToDTO(run query.EvalRun) gen.EvalRun {
+	return gen.EvalRun{
+		Id:          run.ID,
+		WorkflowId:  run.WorkflowID,
+		DatasetId:   run.DatasetID,
+		BatchId:     run.BatchID,
+		Revision:    run.Revision,
+		Scorers:     scorerConfigsToDTOs(run.Scorers),
+		Status:      gen.EvalRunStatus(run.Status),
+		Metrics:     evalMetricsToDTO(run.Metrics),
+		CompletedAt: run.CompletedAt,
+		CreatedAt:   run.CreatedAt,
+		UpdatedAt:   run.UpdatedAt,
+	}
+}
+
+func queryEvalRunsToDTOs(runs []query.EvalRun) []gen.EvalRun {
+	dtos := make([]gen.EvalRun, len(runs))
+	for i, run := range runs {
+		dtos[i] = queryEvalRunToDTO(run)
+	}
+	return dtos
+}
+
+func queryEvalCasesToDTOs(cases []query.EvalCase) []gen.EvalCase {
+	dtos := make([]gen.EvalCase, len(cases))
+	for i, c := range cases {
+		dtos[i] = gen.EvalCase{
+			Index:      c.Index,
+			Inputs:     c.Inputs,
+			Expected:   optionalValue(c.Expected),
+			Status:     c.Status,
+			Result:     optionalValue(c.Result),
+			Error:      nilIfZero(c.Error),
+			DurationMs: c.DurationMs,
+			Scores:     c.Scores,
+			Passed:     c.Passed,
+		}
+	}
+	return dtos
+}
+
+func queryEvalComparisonToDTO(comparison query.EvalComparison) gen.EvalComparison {
+	scorers := make(map[string]gen.ScorerComparison, len(comparison.Scorers))
+	for name, c := range comparison.Scorers {
+		scorers[name] = gen.ScorerComparison{
+			BaseMean: c.BaseMean,
+			HeadMean: c.HeadMean,
+			Delta:    c.Delta,
+		}
+	}
+
+	return gen.EvalComparison{
+		Base:          queryEvalRunToDTO(comparison.Base),
+		Head:          queryEvalRunToDTO(comparison.Head),
+		PassRateDelta: comparison.PassRateDelta,
+		Scorers:       scorers,
+		Regressions:   comparison.Regressions,
+		Improvements:  comparison.Improvements,
+	}
+}
+
+// optionalValue omits the JSON values left unset.
+func optionalValue(v any) *any {
+	if v == nil {
+		return nil
+	}
+	return &v
+}
+
+func dtoToTrafficSplitVariants(variants []gen.TrafficSplitVariant) []model.TrafficSplitVariant {
+	domainVariants := make([]model.TrafficSplitVariant, len(variants))
+	for i, variant := range variants {
+		domainVariants[i] = model.TrafficSplitVariant{
+			WorkflowID: model.WorkflowID(variant.WorkflowId),
+			Weight:     variant.Weight,
+		}
+	}
+	return domainVariants
+}
+
+func queryTrafficSplitToDTO(split query.TrafficSplit) gen.TrafficSplit {
+	variants := make([]gen.TrafficSplitVariant, len(split.Variants))
+	for i, variant := range split.Variants {
+		variants[i] = gen.TrafficSplitVariant{
+			WorkflowId: variant.WorkflowID.String(),
+			Weight:     variant.Weight,
+		}
+	}
+
+	return gen.TrafficSplit{
+		WorkflowId: split.WorkflowID,
+		Variants:   variants,
+		Enabled:    split.Enabled,
+		CreatedAt:  split.CreatedAt,
+		UpdatedAt:  split.UpdatedAt,
+	}
+}
+
+func queryVariantStatsToDTOs(stats []query.VariantStats) []gen.VariantStats {
+	dtos := make([]gen.VariantStats, len(stats))
+	for i, s := range stats {
+		var passRate float64
+		if s.EvalCases > 0 {
+			passRate = float64(s.EvalPassed) / float64(s.EvalCases)
+		}
+
+		dtos[i] = gen.VariantStats{
+			WorkflowId:     s.WorkflowID,
+			Revision:       s.Revision,
+			Executions:     s.Executions,
+			Succeeded:      s.Succeeded,
+			Failed:         s.Failed,
+			AvgLatencyMs:   s.AvgLatencyMs,
+			P95LatencyMs:   s.P95LatencyMs,
+			EvalCases:      s.EvalCases,
+			EvalPassRate:   passRate,
+			FirstStartedAt: s.FirstStartedAt,
+			LastStartedAt:  s.LastStartedAt,
+		}
+	}
+	return dtos
+}
+
+func queryUsageTotalsToDTO(totals query.UsageTotals) gen.UsageTotals {
+	return gen.UsageTotals{
+		Executions:    totals.Executions,
+		Calls:         totals.Calls,
+		InputTokens:   totals.InputTokens,
+		OutputTokens:  totals.OutputTokens,
+		CostUsd:       totals.CostUSD,
+		UnpricedCalls: totals.Unpriced,
+	}
+}
+
+func queryExecutionUsageToDTO(usage query.ExecutionUsage) gen.ExecutionUsage {
+	nodes := make([]gen.NodeUsage, len(usage.Nodes))
+	for i, node := range usage.Nodes {
+		nodes[i] = gen.NodeUsage{
+			NodeId:        node.NodeID,
+			NodeType:      node.NodeType,
+			Model:         node.Model,
+			CredentialId:  node.CredentialID,
+			Calls:         node.Calls,
+			InputTokens:   node.InputTokens,
+			OutputTokens:  node.OutputTokens,
+			CostUsd:       node.CostUSD,
+			UnpricedCalls: node.Unpriced,
+		}
+	}
+
+	return gen.ExecutionUsage{
+		TriggerId: usage.TriggerID,
+		Nodes:     nodes,
+		Total:     queryUsageTotalsToDTO(usage.Total),
+	}
+}
+
+func queryProjectUsageToDTO(usage query.ProjectUsage) gen.ProjectUsage {
+	models := make([]gen.ModelUsage, len(usage.Models))
+	for i, m := range usage.Models {
+		models[i] = gen.ModelUsage{
+			Model: m.Model,
+			Usage: queryUsageTotalsToDTO(m.Usage),
+		}
+	}
+
+	daily := make([]gen.DailyUsage, len(usage.Daily))
+	for i, d := range usage.Daily {
+		daily[i] = gen.DailyUsage{
+			Day:   d.Day,
+			Usage: queryUsageTotalsToDTO(d.Usage),
+		}
+	}
+
+	return gen.ProjectUsage{
+		From:   usage.From,
+		To:     usage.To,
+		Total:  queryUsageTotalsToDTO(usage.Total),
+		Models: models,
+		Daily:  daily,
+	}
+}
+
+func queryWorkflowUsageToDTOs(usage []query.WorkflowUsage) []gen.WorkflowUsage {
+	dtos := make([]gen.WorkflowUsage, len(usage))
+	for i, u := range usage {
+		dtos[i] = gen.WorkflowUsage{
+			WorkflowId: u.WorkflowID,
+			Usage:      queryUsageTotalsToDTO(u.Usage),
+		}
+	}
+	return dtos
+}
+
+func queryCredentialUsageToDTOs(usage []query.CredentialUsage) []gen.CredentialUsage {
+	dtos := make([]gen.CredentialUsage, len(usage))
+	for i, u := range usage {
+		dtos[i] = gen.CredentialUsage{
+			CredentialId: u.CredentialID,
+			Usage:        queryUsageTotalsToDTO(u.Usage),
+		}
+	}
+	return dtos
+}
+
+func queryBudgetToDTO(budget query.Budget) gen.Budget {
+	return gen.Budget{
+		Id:           budget.ID,
+		Scope:        gen.BudgetScope(budget.Scope),
+		WorkflowId:   nilIfZero(budget.WorkflowID),
+		CredentialId: budget.CredentialID,
+		Period:       gen.BudgetPeriod(budget.Period),
+		LimitUsd:     budget.LimitUSD,
+		Thresholds:   budget.Thresholds,
+		Hard:         budget.Hard,
+		PeriodStart:  budget.PeriodStart,
+		SpentUsd:     budget.SpentUSD,
+		CreatedAt:    budget.CreatedAt,
+		UpdatedAt:    budget.UpdatedAt,
+	}
+}
+
+func queryBudgetsToDTOs(budgets []query.Budget) []gen.Budget {
+	dtos := make([]gen.Budget, len(budgets))
+	for i, budget := range budgets {
+		dtos[i] = queryBudgetToDTO(budget)
+	}
+	return dtos
+}
+
+func queryRateLimitToDTO(limit query.RateLimit) gen.RateLimit {
+	return gen.RateLimit{
+		WorkflowId:               nilIfZero(limit.WorkflowID),
+		RequestsPerMinute:        limit.RequestsPerMinute,
+		ApiKeyRequestsPerMinute:  limit.APIKeyRequestsPerMinute,
+		EndUserRequestsPerMinute: limit.EndUserRequestsPerMinute,
+		Burst:                    limit.Burst,
+		CreatedAt:                limit.CreatedAt,
+		UpdatedAt:                limit.UpdatedAt,
+	}
+}
+
+func queryRateLimitsToDTOs(limits []query.RateLimit) []gen.RateLimit {
+	dtos := make([]gen.RateLimit, len(limits))
+	for i, limit := range limits {
+		dtos[i] = queryRateLimitToDTO(limit)
+	}
+	return dtos
+}
+
+func queryUserToDTO(user query.User) gen.User {
+	return gen.User{
+		Id:                 user.ID,
+		Email:              user.Email,
+		Name:               user.Name,
+		Admin:              user.Admin,
+		MustChangePassword: user.MustChangePassword,
+		DeactivatedAt:      user.DeactivatedAt,
+		MfaEnabled:         user.MFAEnabled,
+	}
+}
+
+func queryUsersToDTOs(users []query.User) []gen.User {
+	dtos := make([]gen.User, len(users))
+	for i, user := range users {
+		dtos[i] = queryUserToDTO(user)
+	}
+	return dtos
+}
+
+func queryInvitationsToDTOs(invitations []query.Invitation) []gen.Invitation {
+	dtos := make([]gen.Invitation, len(invitations))
+	for i, invitation := range invitations {
+		dtos[i] = gen.Invitation{
+			Id:        invitation.ID,
+			Email:     invitation.Email,
+			Name:      invitation.Name,
+			Admin:     invitation.Admin,
+			InvitedBy: invitation.InvitedBy,
+			ExpiresAt: invitation.ExpiresAt,
+			CreatedAt: invitation.CreatedAt,
+		}
+	}
+	return dtos
+}
+
+// querySessionsToDTOs flags the session of the request as current.
+func querySessionsToDTOs(sessions []query.Session, currentID string) []gen.Session {
+	dtos := make([]gen.Session, len(sessions))
+	for i, session := range sessions {
+		dtos[i] = gen.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			Current:    session.ID.String() == currentID,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			CreatedAt:  session.CreatedAt,
+		}
+	}
+	return dtos
+}
+
+func recoveryCodesToDTO(codes []auth.OneTimeToken) gen.RecoveryCodes {
+	dto := gen.RecoveryCodes{
+		Codes: make([]string, len(codes)),
+	}
+	for i, code := range codes {
+		dto.Codes[i] = code.String()
+	}
+	return dto
+}
+
+func queryOrganizationsToDTOs(organizations []query.Organization) []gen.Organization {
+	dtos := make([]gen.Organization, len(organizations))
+	for i, organization := range organizations {
+		dtos[i] = gen.Organization{
+			Id:        organization.ID,
+			Name:      organization.Name,
+			Role:      gen.Role(organization.Role),
+			CreatedAt: organization.CreatedAt,
+			UpdatedAt: organization.UpdatedAt,
+		}
+	}
+	return dtos
+}
+
+func queryMembersToDTOs(members []query.Member) []gen.Member {
+	dtos := make([]gen.Member, len(members))
+	for i, member := range members {
+		dtos[i] = gen.Member{
+			UserId:    member.UserID,
+			Email:     member.Email,
+			Name:      member.Name,
+			Role:      gen.Role(member.Role),
+			CreatedAt: member.CreatedAt,
+		}
+	}
+	return dtos
+}
+
+func queryAuditEventsToDTOs(events []query.AuditEvent) []gen.AuditEvent {
+	dtos := make([]gen.AuditEvent, len(events))
+	for i, event := range events {
+		dtos[i] = queryAuditEventToDTO(event)
+	}
+	return dtos
+}
+
+func queryAuditEventToDTO(event query.AuditEvent) gen.AuditEvent {
+	return gen.AuditEvent{
+		Id:         event.ID,
+		ProjectId:  event.ProjectID,
+		ActorType:  gen.AuditEventActorType(event.ActorType),
+		ActorId:    event.ActorID,
+		IpAddress:  nilIfZero(event.IPAddress),
+		Action:     event.Action,
+		Resource:   event.Resource,
+		ResourceId: event.ResourceID,
+		Before:     optionalFields(event.Before),
+		After:      optionalFields(event.After),
+		Details:    optionalFields(event.Details),
+		OccurredAt: event.OccurredAt,
+	}
+}
+
+func optionalFields(fields map[string]any) *map[string]any {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &fields
+}
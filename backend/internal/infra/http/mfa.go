@@ -0,0 +1,121 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) EnrollTotp(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	enrollment, err := s.app.Commands.EnrollTOTP.Handle(r.Context(), command.EnrollTOTPCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, gen.TotpEnrollment{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	})
+}
+
+func (s *Server) ConfirmTotp(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.MfaCodeRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	codes, err := s.app.Commands.ConfirmTOTP.Handle(r.Context(), command.ConfirmTOTPCommand{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, recoveryCodesToDTO(codes))
+}
+
+func (s *Server) DisableTotp(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.MfaCodeRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err = s.app.Commands.DisableTOTP.Handle(r.Context(), command.DisableTOTPCommand{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.MfaCodeRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	codes, err := s.app.Commands.RegenerateRecoveryCodes.Handle(r.Context(), command.RegenerateRecoveryCodesCommand{
+		UserID: userID,
+		Code:   req.Code,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, recoveryCodesToDTO(codes))
+}
+
+func (s *Server) ResetUserMfa(w http.ResponseWriter, r *http.Request, userID gen.UUID) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.ResetMFA.Handle(r.Context(), command.ResetMFACommand{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	organizations, err := s.app.Queries.ListOrganizations.Handle(r.Context(), query.ListOrganizationsQuery{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryOrganizationsToDTOs(organizations))
+}
+
+func (s *Server) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.CreateOrganizationRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	err = s.app.Commands.CreateOrganization.Handle(r.Context(), command.CreateOrganizationCommand{
+		ID:      id,
+		Name:    req.Name,
+		OwnerID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationID gen.UUID) {
+	if err := s.authorizeOrganization(r.Context(), organizationID, model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	members, err := s.app.Queries.ListOrganizationMembers.Handle(r.Context(), query.ListOrganizationMembersQuery{
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryMembersToDTOs(members))
+}
+
+func (s *Server) SaveOrganizationMember(
+	w http.ResponseWriter,
+	r *http.Request,
+	organizationID gen.UUID,
+	userID gen.UUID,
+) {
+	req := new(gen.SaveMemberRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorizeOrganization(r.Context(), organizationID, model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.SaveOrganizationMember.Handle(r.Context(), command.SaveOrganizationMemberCommand{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           model.Role(req.Role),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) DeleteOrganizationMember(
+	w http.ResponseWriter,
+	r *http.Request,
+	organizationID gen.UUID,
+	userID gen.UUID,
+) {
+	if err := s.authorizeOrganization(r.Context(), organizationID, model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveOrganizationMember.Handle(r.Context(), command.RemoveOrganizationMemberCommand{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
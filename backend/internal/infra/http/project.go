@@ -17,11 +17,18 @@ func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	projectID := uuid.New()
-	err := s.app.Commands.CreateProject.Handle(r.Context(), command.CreateProjectCommand{
-		ID:     projectID,
-		Name:   req.Name,
-		UserID: s.server.GetUser(r.Context()).ID,
+	err = s.app.Commands.CreateProject.Handle(r.Context(), command.CreateProjectCommand{
+		ID:             projectID,
+		Name:           req.Name,
+		UserID:         userID,
+		OrganizationID: req.OrganizationId,
 	})
 	if err != nil {
 		s.server.RespondErr(w, r, err)
@@ -34,6 +41,12 @@ func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) GetProject(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	role, err := s.projectRole(r.Context(), projectID, "", model.PermissionView)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	project, err := s.app.Queries.GetProject.Handle(r.Context(), query.GetProjectQuery{
 		ProjectID: projectID,
 	})
@@ -42,7 +55,7 @@ func (s *Server) GetProject(w http.ResponseWriter, r *http.Request, projectID ge
 		return
 	}
 
-	s.server.Respond(w, r, http.StatusOK, queryProjectToDTO(project))
+	s.server.Respond(w, r, http.StatusOK, queryProjectToDTO(redactProject(project, role)))
 }
 
 func (s *Server) UpdateProject(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
@@ -52,6 +65,11 @@ func (s *Server) UpdateProject(w http.ResponseWriter, r *http.Request, projectID
 		return
 	}
 
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	err := s.app.Commands.UpdateProjectName.Handle(r.Context(), command.UpdateProjectNameCommand{
 		ProjectID: projectID,
 		Name:      req.Name,
@@ -71,6 +89,11 @@ func (s *Server) UpdateAuth(w http.ResponseWriter, r *http.Request, projectID ge
 		return
 	}
 
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	err := s.app.Commands.UpdateAuthProvider.Handle(r.Context(), command.UpdateAuthProviderCommand{
 		ProjectID:    projectID,
 		ProviderType: model.AuthProviderType(req.Provider),
@@ -84,18 +107,112 @@ func (s *Server) UpdateAuth(w http.ResponseWriter, r *http.Request, projectID ge
 	s.server.RespondWithContentLocation(w, r, http.StatusNoContent, "/projects/%s/auth", projectID)
 }
 
-func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request, _ gen.UUID) {
+func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	s.server.Respond(w, r, http.StatusOK, nil)
 }
 
+// ListProjects lists the projects the user created, is a member of
+// or shares through an organization.
 func (s *Server) ListProjects(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	projects, err := s.app.Queries.ListProjects.Handle(r.Context(), query.ListProjectsQuery{
-		UserID: s.server.GetUser(r.Context()).ID,
+		UserID: userID.String(),
 	})
 	if err != nil {
 		s.server.RespondErr(w, r, err)
 		return
 	}
 
+	for i, project := range projects {
+		role, roleErr := s.projectRole(r.Context(), project.ID, "", model.PermissionView)
+		if roleErr != nil {
+			s.server.RespondErr(w, r, roleErr)
+			return
+		}
+		projects[i] = redactProject(project, role)
+	}
+
 	s.server.Respond(w, r, http.StatusOK, queryProjectsToDTOs(projects))
 }
+
+func (s *Server) ListProjectMembers(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	members, err := s.app.Queries.ListProjectMembers.Handle(r.Context(), query.ListProjectMembersQuery{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryMembersToDTOs(members))
+}
+
+func (s *Server) SaveProjectMember(w http.ResponseWriter, r *http.Request, projectID gen.UUID, userID gen.UUID) {
+	req := new(gen.SaveMemberRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.SaveProjectMember.Handle(r.Context(), command.SaveProjectMemberCommand{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      model.Role(req.Role),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) DeleteProjectMember(w http.ResponseWriter, r *http.Request, projectID gen.UUID, userID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionManage); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveProjectMember.Handle(r.Context(), command.RemoveProjectMemberCommand{
+		ProjectID: projectID,
+		UserID:    userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+// redactProject leaves out the credentials and the secret key of the project
+// for the roles not allowed to read them.
+func redactProject(project query.Project, role model.Role) query.Project {
+	if role.Can(model.PermissionReadCredentials) {
+		return project
+	}
+
+	project.Credentials = []query.Credential{}
+	project.APIKeys = []query.APIKey{}
+	return project
+}
@@ -0,0 +1,151 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) ListRateLimits(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	limits, err := s.app.Queries.ListRateLimits.Handle(r.Context(), query.ListRateLimitsQuery{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryRateLimitsToDTOs(limits))
+}
+
+func (s *Server) SaveProjectRateLimit(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.saveRateLimit(w, r, projectID, "")
+}
+
+func (s *Server) DeleteProjectRateLimit(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.deleteRateLimit(w, r, projectID, "")
+}
+
+func (s *Server) GetWorkflowRateLimit(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	limit, err := s.app.Queries.GetWorkflowRateLimit.Handle(r.Context(), query.GetWorkflowRateLimitQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryRateLimitToDTO(limit))
+}
+
+func (s *Server) SaveWorkflowRateLimit(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.saveRateLimit(w, r, projectID, model.WorkflowID(workflowID))
+}
+
+func (s *Server) DeleteWorkflowRateLimit(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.deleteRateLimit(w, r, projectID, model.WorkflowID(workflowID))
+}
+
+func (s *Server) saveRateLimit(w http.ResponseWriter, r *http.Request, projectID uuid.UUID, workflowID model.WorkflowID) {
+	req := new(gen.SaveRateLimitRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.SaveRateLimit.Handle(r.Context(), command.SaveRateLimitCommand{
+		ProjectID:                projectID,
+		WorkflowID:               workflowID,
+		RequestsPerMinute:        valueOrZero(req.RequestsPerMinute),
+		APIKeyRequestsPerMinute:  valueOrZero(req.ApiKeyRequestsPerMinute),
+		EndUserRequestsPerMinute: valueOrZero(req.EndUserRequestsPerMinute),
+		Burst:                    valueOrZero(req.Burst),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, nil)
+}
+
+func (s *Server) deleteRateLimit(w http.ResponseWriter, r *http.Request, projectID uuid.UUID, workflowID model.WorkflowID) {
+	err := s.app.Commands.RemoveRateLimit.Handle(r.Context(), command.RemoveRateLimitCommand{
+		ProjectID:  projectID,
+		WorkflowID: workflowID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+// triggerCaller identifies the caller of a trigger for the rate limits: the
+// end-user authenticated by their token, or declared by a server holding the
+// secret key of the project, or else the address of an anonymous caller.
+func (s *Server) triggerCaller(r *http.Request, projectID uuid.UUID) model.TriggerCaller {
+	ctx := r.Context()
+	endUserID, _ := s.server.AuthenticatedEndUser(ctx)
+	caller := model.TriggerCaller{
+		APIKey:    "",
+		EndUserID: endUserID,
+		IP:        s.server.ClientIP(r),
+		Client:    !s.server.IsDashboardOrigin(ctx),
+	}
+
+	secretKey, err := s.server.GetSecretKeyFromContext(ctx)
+	if err != nil {
+		return caller
+	}
+
+	err = s.app.Commands.AuthorizeEventSubscription.Handle(ctx, command.AuthorizeEventSubscriptionCommand{
+		ProjectID: projectID,
+		SecretKey: secretKey,
+	})
+	if err != nil {
+		return caller
+	}
+
+	caller.APIKey = string(secretKey)
+	if caller.EndUserID == "" {
+		caller.EndUserID = s.server.EndUserID(r)
+	}
+	return caller
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) CreateSchedule(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	req := new(gen.CreateScheduleRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.AddSchedule.Handle(r.Context(), command.AddScheduleCommand{
+		ID:             id,
+		ProjectID:      projectID,
+		WorkflowID:     model.WorkflowID(workflowID),
+		CronExpression: req.Cron,
+		Timezone:       valueOrZero(req.Timezone),
+		Inputs:         valueOrZero(req.Inputs),
+		Enabled:        enabled,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) GetSchedule(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	scheduleID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	schedule, err := s.app.Queries.GetSchedule.Handle(r.Context(), query.GetScheduleQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryScheduleToDTO(schedule))
+}
+
+func (s *Server) UpdateSchedule(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	scheduleID gen.UUID,
+) {
+	req := new(gen.UpdateScheduleRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.UpdateSchedule.Handle(r.Context(), command.UpdateScheduleCommand{
+		ID:             scheduleID,
+		ProjectID:      projectID,
+		WorkflowID:     model.WorkflowID(workflowID),
+		CronExpression: req.Cron,
+		Timezone:       valueOrZero(req.Timezone),
+		Inputs:         valueOrZero(req.Inputs),
+		Enabled:        req.Enabled,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.RespondWithContentLocation(
+		w, r, http.StatusOK,
+		"/projects/%s/workflows/%s/schedules/%s", projectID, workflowID, scheduleID,
+	)
+}
+
+func (s *Server) DeleteSchedule(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	scheduleID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveSchedule.Handle(r.Context(), command.RemoveScheduleCommand{
+		ID:         scheduleID,
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListSchedules(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	schedules, err := s.app.Queries.ListSchedules.Handle(r.Context(), query.ListSchedulesQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, querySchedulesToDTOs(schedules))
+}
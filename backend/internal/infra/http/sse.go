@@ -6,133 +6,388 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
-	"sync"
+	"strings"
+	"time"
 
-	watermillHTTP "github.com/ThreeDotsLabs/watermill-http/v2/pkg/http"
-	watermillMsg "github.com/ThreeDotsLabs/watermill/message"
-	"github.com/go-chi/chi/v5"
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
 	"github.com/supallm/core/internal/application/domain/model"
 	"github.com/supallm/core/internal/application/event"
 	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/pkg/errs"
+	"github.com/supallm/core/internal/pkg/server"
 )
 
-func (s *Server) listenWorkflowEvents(next http.Handler) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		// projectID, err := s.server.ParseUUID(r, "projectId")
-		// if err != nil {
-		// 	s.server.RespondErr(w, r, err)
-		// 	return
-		// }
+const (
+	heartbeatInterval = 15 * time.Second
 
-		// err = s.isAuthorize(r.Context(), projectID, s.app.Commands.AuthorizeEventSubscription.Handle)
-		// if err != nil {
-		// 	s.server.RespondErr(w, r, err)
-		// 	return
-		// }
+	sseDataEvent  = "data"
+	sseTokenEvent = "token"
+)
 
-		next.ServeHTTP(w, r)
-	}
+// eventView selects and formats the events written to a stream. In compact mode
+// only the text deltas of node results are sent, as raw token events, followed
+// by the terminal event. The streams of a trigger always send its terminal
+// event whatever the filter, clients would otherwise reconnect endlessly.
+type eventView struct {
+	filter         event.Filter
+	compact        bool
+	endsOnTerminal bool
 }
 
-type (
-	connectionParams struct {
-		triggerID  string
-		workflowID string
-		projectID  string
+// listenWorkflowEvents streams the events of a trigger as server-sent events.
+// Each event carries its sequence as id, so a reconnecting client resumes
+// from the Last-Event-ID header. The stream ends after the terminal event.
+// Events are selected with the type, nodeId and resultKey query parameters,
+// compact=true streams the tokens of a single result key.
+func (s *Server) listenWorkflowEvents(w http.ResponseWriter, r *http.Request) {
+	projectID, err := s.server.ParseUUID(r, "projectId")
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	workflowSSEAdapter struct {
-		listWorkflowEvents query.ListenWorkflowEventsHandler
-		connections        sync.Map
+	triggerID, err := s.server.ParseUUID(r, "triggerId")
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
-)
 
-func (p *workflowSSEAdapter) InitialStreamResponse(w http.ResponseWriter, r *http.Request) (response any, ok bool) {
-	params := &connectionParams{
-		triggerID:  chi.URLParam(r, "triggerId"),
-		workflowID: chi.URLParam(r, "workflowId"),
-		projectID:  chi.URLParam(r, "projectId"),
+	workflowID := model.WorkflowID(s.server.GetParam(r, "workflowId"))
+	if err = s.authorizeDashboard(r.Context(), projectID, workflowID, model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	p.connections.Store(r, params)
+	// executions of authenticated end-users are only streamed to them
+	err = s.authorizeTriggerListening(r.Context(), projectID, triggerID, s.eventListener(r.Context(), projectID))
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
 
-	fromSequence := uint64(0)
-	if seq := r.URL.Query().Get("sequence"); seq != "" {
-		//nolint
-		if parsed, err := strconv.ParseUint(seq, 10, 64); err == nil {
-			fromSequence = parsed
-		}
+	lastSequence, err := s.lastDeliveredSequence(r)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	triggerID, err := uuid.Parse(params.triggerID)
+	view, err := s.parseEventView(r)
 	if err != nil {
-		slog.Error("error parsing trigger ID", "error", err)
-		return nil, false
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	events, err := p.listWorkflowEvents.Handle(r.Context(), query.ListenWorkflowQuery{
+	listenQuery := query.ListenWorkflowQuery{
+		WorkflowID: workflowID,
 		TriggerID:  triggerID,
-		WorkflowID: model.WorkflowID(params.workflowID),
-		Sequence:   fromSequence,
+		Sequence:   lastSequence,
+	}
+
+	if !s.server.IsEventStream(r) {
+		listenQuery.Sequence = lastSequence + 1
+		events, listenErr := s.app.Queries.ListenWorkflowEvents.Handle(r.Context(), listenQuery)
+		if listenErr != nil {
+			s.server.RespondErr(w, r, errs.InternalError{Err: listenErr})
+			return
+		}
+		selected := make([]event.WorkflowEventMessage, 0, len(events))
+		for _, e := range events {
+			if view.filter.Match(e) {
+				selected = append(selected, e)
+			}
+		}
+		s.server.Respond(w, r, http.StatusOK, selected)
+		return
+	}
+
+	// subscribe before reading the stored events so nothing is missed in between
+	messages, err := s.events.Subscribe(r.Context(), event.InternalEventsTopic)
+	if err != nil {
+		s.server.RespondErr(w, r, errs.InternalError{Err: err})
+		return
+	}
+
+	// the stored events start at the last delivered one (inclusive),
+	// which tells whether the client already received the terminal event
+	stored, err := s.app.Queries.ListenWorkflowEvents.Handle(r.Context(), listenQuery)
+	if err != nil {
+		s.server.RespondErr(w, r, errs.InternalError{Err: err})
+		return
+	}
+
+	if len(stored) > 0 && stored[0].Sequence == lastSequence && stored[0].Type.IsTerminal() {
+		// 204 tells EventSource clients to stop reconnecting
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	stream, err := s.server.StartEventStream(w, r)
+	if err != nil {
+		s.server.RespondErr(w, r, errs.InternalError{Err: err})
+		return
+	}
+
+	replayed := lastSequence
+	for _, e := range stored {
+		if e.Sequence <= lastSequence {
+			continue
+		}
+		if err = view.send(stream, e); err != nil || e.Type.IsTerminal() {
+			return
+		}
+		replayed = e.Sequence
+	}
+
+	relayEvents(r.Context(), stream, messages, view, func(e event.WorkflowEventMessage) (bool, bool) {
+		if e.TriggerID != triggerID || e.Sequence <= replayed {
+			return false, false
+		}
+		return true, e.Type.IsTerminal()
 	})
+}
+
+// listenProjectEvents streams the live events of every execution in a project.
+func (s *Server) listenProjectEvents(w http.ResponseWriter, r *http.Request) {
+	projectID, err := s.server.ParseUUID(r, "projectId")
 	if err != nil {
-		slog.Error("error listing workflow events", "error", err)
-		return nil, false
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	go func() {
-		<-r.Context().Done()
-		p.connections.Delete(r)
-	}()
+	if err = s.authorizeDashboard(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	filter, err := s.parseEventFilter(r)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+	filter.ProjectID = projectID
+	if filter.Listener, err = s.streamListener(r.Context(), projectID); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
 
-	return events, true
+	s.streamEvents(w, r, filter)
 }
 
-func (p *workflowSSEAdapter) NextStreamResponse(r *http.Request, msg *watermillMsg.Message) (response any, ok bool) {
-	var messageHandled event.WorkflowEventMessage
-	err := json.Unmarshal(msg.Payload, &messageHandled)
+// listenSessionEvents streams the live events of every execution triggered in
+// a session, such as the turns of a conversation.
+func (s *Server) listenSessionEvents(w http.ResponseWriter, r *http.Request) {
+	projectID, err := s.server.ParseUUID(r, "projectId")
 	if err != nil {
-		slog.Error("error unmarshalling workflow event message", "error", err)
-		return nil, false
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	params, ok := p.connections.Load(r)
-	if !ok {
-		slog.Error("connection parameters not found")
-		return nil, false
+	sessionID, err := s.server.ParseUUID(r, "sessionId")
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	connParams, ok := params.(*connectionParams)
-	if !ok {
-		slog.Error("connection parameters not found")
-		return nil, false
+	if err = s.authorizeDashboard(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	if messageHandled.TriggerID.String() != connParams.triggerID {
-		return nil, false
+	filter, err := s.parseEventFilter(r)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+	filter.ProjectID = projectID
+	filter.SessionID = sessionID
+	if filter.Listener, err = s.streamListener(r.Context(), projectID); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
 	}
 
-	return messageHandled, true
+	s.streamEvents(w, r, filter)
 }
 
-type workflowSSEMarshaller struct{}
+// streamEvents streams the live events matching the filter until the client disconnects.
+// Event ids are the sequences of each trigger, so these streams do not resume
+// from Last-Event-ID, clients catch up on a trigger with listenWorkflowEvents.
+func (s *Server) streamEvents(w http.ResponseWriter, r *http.Request, filter event.Filter) {
+	messages, err := s.events.Subscribe(r.Context(), event.InternalEventsTopic)
+	if err != nil {
+		s.server.RespondErr(w, r, errs.InternalError{Err: err})
+		return
+	}
 
-func (j workflowSSEMarshaller) Marshal(_ context.Context, payload any) (watermillHTTP.ServerSentEvent, error) {
-	data, err := json.Marshal(payload)
+	stream, err := s.server.StartEventStream(w, r)
 	if err != nil {
-		return watermillHTTP.ServerSentEvent{}, err
+		s.server.RespondErr(w, r, errs.InternalError{Err: err})
+		return
 	}
 
-	if _, ok := payload.([]event.WorkflowEventMessage); ok {
-		return watermillHTTP.ServerSentEvent{
-			Event: "resume",
-			Data:  data,
-		}, nil
+	view := eventView{filter: filter, compact: false, endsOnTerminal: false}
+	relayEvents(r.Context(), stream, messages, view, func(event.WorkflowEventMessage) (bool, bool) {
+		return true, false
+	})
+}
+
+// parseEventView reads the event filter and the compact mode, which needs
+// exactly one result key to stream.
+func (s *Server) parseEventView(r *http.Request) (eventView, error) {
+	filter, err := s.parseEventFilter(r)
+	if err != nil {
+		return eventView{}, err
+	}
+
+	view := eventView{filter: filter, compact: false, endsOnTerminal: true}
+	if c := s.server.GetQueryParam(r, "compact"); c != "" {
+		view.compact, err = strconv.ParseBool(c)
+		if err != nil {
+			return eventView{}, errs.InvalidError{Field: "compact", Reason: "must be a boolean", Err: err}
+		}
+	}
+
+	if view.compact && len(filter.ResultKeys) != 1 {
+		return eventView{}, errs.InvalidError{Field: "resultKey", Reason: "compact mode streams a single result key"}
+	}
+
+	return view, nil
+}
+
+// parseEventFilter reads the type, workflowId, nodeId and resultKey query parameters,
+// each of them can be repeated or hold comma-separated values.
+func (s *Server) parseEventFilter(r *http.Request) (event.Filter, error) {
+	filter := event.Filter{
+		ProjectID:   uuid.Nil,
+		SessionID:   uuid.Nil,
+		TriggerID:   uuid.Nil,
+		Types:       nil,
+		WorkflowIDs: nil,
+		NodeIDs:     queryValues(r, "nodeId"),
+		ResultKeys:  queryValues(r, "resultKey"),
+		Listener:    nil,
+	}
+
+	for _, t := range queryValues(r, "type") {
+		filter.Types = append(filter.Types, model.WorkflowEventType(t))
+	}
+
+	for _, id := range queryValues(r, "workflowId") {
+		filter.WorkflowIDs = append(filter.WorkflowIDs, model.WorkflowID(id))
+	}
+
+	return filter, nil
+}
+
+func queryValues(r *http.Request, key string) []string {
+	var values []string
+	for _, param := range r.URL.Query()[key] {
+		for _, v := range strings.Split(param, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// relayEvents writes the live events to the stream with periodic heartbeats.
+// accept tells whether an event belongs to the stream and whether it ends it,
+// the view then selects the events actually sent.
+func relayEvents(
+	ctx context.Context,
+	stream *server.EventStream,
+	messages <-chan *message.Message,
+	view eventView,
+	accept func(e event.WorkflowEventMessage) (ok bool, last bool),
+) {
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := stream.Heartbeat(); err != nil {
+				return
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			msg.Ack()
+
+			var e event.WorkflowEventMessage
+			if err := json.Unmarshal(msg.Payload, &e); err != nil {
+				slog.Error("error unmarshalling workflow event message", "error", err)
+				continue
+			}
+
+			ok, last := accept(e)
+			if !ok {
+				continue
+			}
+
+			if err := view.send(stream, e); err != nil || last {
+				return
+			}
+		}
+	}
+}
+
+// lastDeliveredSequence returns the sequence of the last event the client received.
+// The Last-Event-ID header is sent by EventSource on reconnection, the sequence
+// query parameter is kept for clients resuming manually from a given sequence.
+func (s *Server) lastDeliveredSequence(r *http.Request) (uint64, error) {
+	if id := s.server.LastEventID(r); id != "" {
+		sequence, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return 0, errs.InvalidError{Field: "Last-Event-ID", Reason: "invalid sequence", Err: err}
+		}
+		return sequence, nil
+	}
+
+	if seq := s.server.GetQueryParam(r, "sequence"); seq != "" {
+		sequence, err := strconv.ParseUint(seq, 10, 64)
+		if err != nil {
+			return 0, errs.InvalidError{Field: "sequence", Reason: "invalid sequence", Err: err}
+		}
+		if sequence > 0 {
+			return sequence - 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// send writes the event when the view selects it.
+func (v eventView) send(stream *server.EventStream, e event.WorkflowEventMessage) error {
+	// the terminal event tells the clients the stream is complete
+	if v.endsOnTerminal && e.Type.IsTerminal() {
+		return sendWorkflowEvent(stream, e)
+	}
+
+	if !v.compact {
+		if !v.filter.Match(e) {
+			return nil
+		}
+		return sendWorkflowEvent(stream, e)
+	}
+
+	delta, ok := e.TextDelta()
+	if !ok || !v.filter.Match(e) {
+		return nil
+	}
+
+	return stream.Send(strconv.FormatUint(e.Sequence, 10), sseTokenEvent, []byte(delta))
+}
+
+func sendWorkflowEvent(stream *server.EventStream, e event.WorkflowEventMessage) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
 	}
 
-	return watermillHTTP.ServerSentEvent{
-		Event: "data",
-		Data:  data,
-	}, nil
+	return stream.Send(strconv.FormatUint(e.Sequence, 10), sseDataEvent, data)
 }
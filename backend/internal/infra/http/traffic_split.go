@@ -0,0 +1,104 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) GetTrafficSplit(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	split, err := s.app.Queries.GetTrafficSplit.Handle(r.Context(), query.GetTrafficSplitQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryTrafficSplitToDTO(split))
+}
+
+func (s *Server) SaveTrafficSplit(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	req := new(gen.SaveTrafficSplitRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	err := s.app.Commands.SaveTrafficSplit.Handle(r.Context(), command.SaveTrafficSplitCommand{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		Variants:   dtoToTrafficSplitVariants(req.Variants),
+		Enabled:    enabled,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, idResponse{
+		ID: workflowID,
+	})
+}
+
+func (s *Server) DeleteTrafficSplit(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveTrafficSplit.Handle(r.Context(), command.RemoveTrafficSplitCommand{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListVariantStats(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	params gen.ListVariantStatsParams,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	stats, err := s.app.Queries.ListVariantStats.Handle(r.Context(), query.ListVariantStatsQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		Since:      params.Since,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryVariantStatsToDTOs(stats))
+}
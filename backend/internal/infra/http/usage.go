@@ -0,0 +1,106 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) GetExecutionUsage(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	triggerID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	usage, err := s.app.Queries.GetExecutionUsage.Handle(r.Context(), query.GetExecutionUsageQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+		TriggerID:  triggerID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryExecutionUsageToDTO(usage))
+}
+
+func (s *Server) GetProjectUsage(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	params gen.GetProjectUsageParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	usage, err := s.app.Queries.GetProjectUsage.Handle(r.Context(), query.GetProjectUsageQuery{
+		ProjectID: projectID,
+		From:      params.From,
+		To:        params.To,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryProjectUsageToDTO(usage))
+}
+
+func (s *Server) ListWorkflowUsage(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	params gen.ListWorkflowUsageParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	usage, err := s.app.Queries.ListWorkflowUsage.Handle(r.Context(), query.ListWorkflowUsageQuery{
+		ProjectID: projectID,
+		From:      params.From,
+		To:        params.To,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryWorkflowUsageToDTOs(usage))
+}
+
+func (s *Server) ListCredentialUsage(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	params gen.ListCredentialUsageParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	usage, err := s.app.Queries.ListCredentialUsage.Handle(r.Context(), query.ListCredentialUsageQuery{
+		ProjectID: projectID,
+		From:      params.From,
+		To:        params.To,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryCredentialUsageToDTOs(usage))
+}
@@ -0,0 +1,393 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+	"github.com/supallm/core/internal/pkg/auth"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+// loadUser returns the current state of the user of a dashboard token,
+// rejecting the deactivated ones.
+func (s *Server) loadUser(ctx context.Context, claims *auth.Claims) (auth.User, error) {
+	user, err := s.app.Queries.GetUserByID.Handle(ctx, query.GetUserByIDQuery{
+		ID: claims.UserID,
+	})
+	if err != nil {
+		var notFound errs.NotFoundError
+		if errors.As(err, &notFound) {
+			return auth.User{}, errs.UnauthorizedError{Err: errors.New("user not found")}
+		}
+		return auth.User{}, err
+	}
+
+	if user.DeactivatedAt != nil {
+		return auth.User{}, errs.UnauthorizedError{Err: errors.New("user is deactivated")}
+	}
+
+	return auth.User{
+		ID:                 user.ID.String(),
+		Email:              user.Email,
+		Name:               user.Name,
+		Admin:              user.Admin,
+		MustChangePassword: user.MustChangePassword,
+		MFAEnabled:         user.MFAEnabled,
+		SessionID:          claims.SessionID.String(),
+	}, nil
+}
+
+// currentUserID returns the ID of the authenticated dashboard user.
+func (s *Server) currentUserID(ctx context.Context) (uuid.UUID, error) {
+	user := s.server.GetUser(ctx)
+	if user == nil {
+		return uuid.Nil, errs.UnauthorizedError{Err: errors.New("user not found")}
+	}
+
+	id, err := uuid.Parse(user.ID)
+	if err != nil {
+		return uuid.Nil, errs.UnauthorizedError{Err: err}
+	}
+	return id, nil
+}
+
+// requireAdmin returns the ID of the authenticated user when they are an admin.
+func (s *Server) requireAdmin(ctx context.Context) (uuid.UUID, error) {
+	id, err := s.currentUserID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if !s.server.GetUser(ctx).Admin {
+		return uuid.Nil, errs.ForbiddenError{Entity: "users"}
+	}
+	return id, nil
+}
+
+func (s *Server) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.ChangePasswordRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err = s.app.Commands.ChangePassword.Handle(r.Context(), command.ChangePasswordCommand{
+		UserID:          userID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListMySessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	sessions, err := s.app.Queries.ListSessions.Handle(r.Context(), query.ListSessionsQuery{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, querySessionsToDTOs(sessions, s.server.GetUser(r.Context()).SessionID))
+}
+
+func (s *Server) RevokeMySessions(w http.ResponseWriter, r *http.Request) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err = s.app.Commands.RevokeUserSessions.Handle(r.Context(), command.RevokeUserSessionsCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) RevokeMySession(w http.ResponseWriter, r *http.Request, sessionID gen.UUID) {
+	userID, err := s.currentUserID(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err = s.app.Commands.RevokeSession.Handle(r.Context(), command.RevokeSessionCommand{
+		UserID:    userID,
+		SessionID: sessionID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListUsers(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	users, err := s.app.Queries.ListUsers.Handle(r.Context(), query.ListUsersQuery{})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryUsersToDTOs(users))
+}
+
+func (s *Server) CreateUser(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.CreateUserRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.CreateUser.Handle(r.Context(), command.CreateUserCommand{
+		ID:       id,
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+		Admin:    valueOrZero(req.Admin),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) DeactivateUser(w http.ResponseWriter, r *http.Request, userID gen.UUID) {
+	actorID, err := s.requireAdmin(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err = s.app.Commands.DeactivateUser.Handle(r.Context(), command.DeactivateUserCommand{
+		UserID:  userID,
+		ActorID: actorID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ActivateUser(w http.ResponseWriter, r *http.Request, userID gen.UUID) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.ActivateUser.Handle(r.Context(), command.ActivateUserCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListUserSessions(w http.ResponseWriter, r *http.Request, userID gen.UUID) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	sessions, err := s.app.Queries.ListSessions.Handle(r.Context(), query.ListSessionsQuery{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, querySessionsToDTOs(sessions, s.server.GetUser(r.Context()).SessionID))
+}
+
+func (s *Server) RevokeUserSessions(w http.ResponseWriter, r *http.Request, userID gen.UUID) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RevokeUserSessions.Handle(r.Context(), command.RevokeUserSessionsCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) IssuePasswordReset(w http.ResponseWriter, r *http.Request, userID gen.UUID) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	issued, err := s.app.Commands.IssuePasswordReset.Handle(r.Context(), command.IssuePasswordResetCommand{
+		UserID: userID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, gen.IssuedToken{
+		Id:        nil,
+		Token:     issued.Token.String(),
+		ExpiresAt: issued.ExpiresAt,
+	})
+}
+
+func (s *Server) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.ResetPasswordRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.ResetPassword.Handle(r.Context(), command.ResetPasswordCommand{
+		Token:    auth.OneTimeToken(req.Token),
+		Password: req.Password,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	invitations, err := s.app.Queries.ListInvitations.Handle(r.Context(), query.ListInvitationsQuery{})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryInvitationsToDTOs(invitations))
+}
+
+func (s *Server) InviteUser(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.InviteUserRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	actorID, err := s.requireAdmin(r.Context())
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	issued, err := s.app.Commands.InviteUser.Handle(r.Context(), command.InviteUserCommand{
+		ID:        id,
+		Email:     req.Email,
+		Name:      valueOrZero(req.Name),
+		Admin:     valueOrZero(req.Admin),
+		InvitedBy: actorID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, gen.IssuedToken{
+		Id:        &id,
+		Token:     issued.Token.String(),
+		ExpiresAt: issued.ExpiresAt,
+	})
+}
+
+func (s *Server) RevokeInvitation(w http.ResponseWriter, r *http.Request, invitationID gen.UUID) {
+	if _, err := s.requireAdmin(r.Context()); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RevokeInvitation.Handle(r.Context(), command.RevokeInvitationCommand{
+		ID: invitationID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+// AcceptInvitation creates the account of the invited user and logs them in.
+func (s *Server) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	req := new(gen.AcceptInvitationRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	user, err := s.app.Commands.AcceptInvitation.Handle(r.Context(), command.AcceptInvitationCommand{
+		UserID:   uuid.New(),
+		Token:    auth.OneTimeToken(req.Token),
+		Name:     valueOrZero(req.Name),
+		Password: req.Password,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.login(w, r, user.Email, req.Password)
+}
@@ -0,0 +1,203 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+)
+
+func (s *Server) CreateWebhook(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	req := new(gen.CreateWebhookRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.AddWebhook.Handle(r.Context(), command.AddWebhookCommand{
+		ID:          id,
+		ProjectID:   projectID,
+		URL:         req.Url,
+		EventTypes:  toEventTypes(req.EventTypes),
+		WorkflowIDs: toWorkflowIDs(req.WorkflowIds),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) GetWebhook(w http.ResponseWriter, r *http.Request, projectID gen.UUID, webhookID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	webhook, err := s.app.Queries.GetWebhook.Handle(r.Context(), query.GetWebhookQuery{
+		ProjectID: projectID,
+		WebhookID: webhookID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryWebhookToDTO(webhook))
+}
+
+func (s *Server) UpdateWebhook(w http.ResponseWriter, r *http.Request, projectID gen.UUID, webhookID gen.UUID) {
+	req := new(gen.UpdateWebhookRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.UpdateWebhook.Handle(r.Context(), command.UpdateWebhookCommand{
+		ID:          webhookID,
+		ProjectID:   projectID,
+		URL:         req.Url,
+		EventTypes:  toEventTypes(req.EventTypes),
+		WorkflowIDs: toWorkflowIDs(req.WorkflowIds),
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.RespondWithContentLocation(w, r, http.StatusOK, "/projects/%s/webhooks/%s", projectID, webhookID)
+}
+
+func (s *Server) DeleteWebhook(w http.ResponseWriter, r *http.Request, projectID gen.UUID, webhookID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveWebhook.Handle(r.Context(), command.RemoveWebhookCommand{
+		ID:        webhookID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListWebhooks(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	webhooks, err := s.app.Queries.ListWebhooks.Handle(r.Context(), query.ListWebhooksQuery{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryWebhooksToDTOs(webhooks))
+}
+
+func (s *Server) ListWebhookDeliveries(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	webhookID gen.UUID,
+	params gen.ListWebhookDeliveriesParams,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	deliveries, err := s.app.Queries.ListWebhookDeliveries.Handle(r.Context(), query.ListWebhookDeliveriesQuery{
+		ProjectID: projectID,
+		WebhookID: webhookID,
+		Limit:     limit,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryWebhookDeliveriesToDTOs(deliveries))
+}
+
+func (s *Server) RedeliverWebhook(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	webhookID gen.UUID,
+	deliveryID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id, err := s.app.Commands.RedeliverWebhook.Handle(r.Context(), command.RedeliverWebhookCommand{
+		ProjectID:  projectID,
+		WebhookID:  webhookID,
+		DeliveryID: deliveryID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusAccepted, idResponse{
+		ID: id.String(),
+	})
+}
+
+func toEventTypes(values *[]string) []model.WorkflowEventType {
+	if values == nil {
+		return nil
+	}
+
+	eventTypes := make([]model.WorkflowEventType, len(*values))
+	for i, v := range *values {
+		eventTypes[i] = model.WorkflowEventType(v)
+	}
+	return eventTypes
+}
+
+func toWorkflowIDs(values *[]string) []model.WorkflowID {
+	if values == nil {
+		return nil
+	}
+
+	workflowIDs := make([]model.WorkflowID, len(*values))
+	for i, v := range *values {
+		workflowIDs[i] = model.WorkflowID(v)
+	}
+	return workflowIDs
+}
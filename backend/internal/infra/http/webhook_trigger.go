@@ -0,0 +1,321 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/event"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/infra/http/gen"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	maxInboundBodySize = 1 << 20
+
+	webhookTriggerCompleted = "completed"
+	webhookTriggerFailed    = "failed"
+	webhookTriggerCancelled = "cancelled"
+	webhookTriggerRunning   = "running"
+
+	resultDataKey = "result"
+	errorDataKey  = "error"
+)
+
+// webhookTriggerResponse answers synchronous webhook triggers.
+type webhookTriggerResponse struct {
+	TriggerID uuid.UUID `json:"triggerId"`
+	Status    string    `json:"status"`
+	Result    any       `json:"result,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func (s *Server) CreateWebhookTrigger(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	req := new(gen.CreateWebhookTriggerRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	id := uuid.New()
+	err := s.app.Commands.AddWebhookTrigger.Handle(r.Context(), command.AddWebhookTriggerCommand{
+		ID:              id,
+		ProjectID:       projectID,
+		WorkflowID:      model.WorkflowID(workflowID),
+		Name:            req.Name,
+		Verification:    model.WebhookVerification(req.Verification),
+		SignatureHeader: valueOrZero(req.SignatureHeader),
+		Secret:          valueOrZero(req.Secret),
+		InputMapping:    valueOrZero(req.InputMapping),
+		RespondSync:     valueOrZero(req.RespondSync),
+		SyncTimeout:     time.Duration(valueOrZero(req.SyncTimeoutSeconds)) * time.Second,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusCreated, idResponse{
+		ID: id.String(),
+	})
+}
+
+func (s *Server) GetWebhookTrigger(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	webhookTriggerID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	trigger, err := s.app.Queries.GetWebhookTrigger.Handle(r.Context(), query.GetWebhookTriggerQuery{
+		ProjectID:        projectID,
+		WorkflowID:       model.WorkflowID(workflowID),
+		WebhookTriggerID: webhookTriggerID,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryWebhookTriggerToDTO(trigger))
+}
+
+func (s *Server) UpdateWebhookTrigger(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	webhookTriggerID gen.UUID,
+) {
+	req := new(gen.UpdateWebhookTriggerRequest)
+	if err := s.server.ParseBody(r, req); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.UpdateWebhookTrigger.Handle(r.Context(), command.UpdateWebhookTriggerCommand{
+		ID:              webhookTriggerID,
+		ProjectID:       projectID,
+		WorkflowID:      model.WorkflowID(workflowID),
+		Name:            req.Name,
+		Verification:    model.WebhookVerification(req.Verification),
+		SignatureHeader: valueOrZero(req.SignatureHeader),
+		Secret:          valueOrZero(req.Secret),
+		InputMapping:    valueOrZero(req.InputMapping),
+		RespondSync:     valueOrZero(req.RespondSync),
+		SyncTimeout:     time.Duration(valueOrZero(req.SyncTimeoutSeconds)) * time.Second,
+		Enabled:         req.Enabled,
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.RespondWithContentLocation(
+		w, r, http.StatusOK,
+		"/projects/%s/workflows/%s/webhook-triggers/%s", projectID, workflowID, webhookTriggerID,
+	)
+}
+
+func (s *Server) DeleteWebhookTrigger(
+	w http.ResponseWriter,
+	r *http.Request,
+	projectID gen.UUID,
+	workflowID string,
+	webhookTriggerID gen.UUID,
+) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	err := s.app.Commands.RemoveWebhookTrigger.Handle(r.Context(), command.RemoveWebhookTriggerCommand{
+		ID:         webhookTriggerID,
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusNoContent, nil)
+}
+
+func (s *Server) ListWebhookTriggers(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionReadCredentials); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	triggers, err := s.app.Queries.ListWebhookTriggers.Handle(r.Context(), query.ListWebhookTriggersQuery{
+		ProjectID:  projectID,
+		WorkflowID: model.WorkflowID(workflowID),
+	})
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	s.server.Respond(w, r, http.StatusOK, queryWebhookTriggersToDTOs(triggers))
+}
+
+// fireWebhookTrigger receives the requests of third-party systems. They are
+// authenticated by the verification scheme of the trigger instead of the API keys.
+// Synchronous triggers answer with the workflow result, or 202 once the timeout
+// is reached, the others answer 202 as soon as the workflow is queued.
+func (s *Server) fireWebhookTrigger(w http.ResponseWriter, r *http.Request) {
+	id, err := s.server.ParseUUID(r, "webhookTriggerId")
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxInboundBodySize))
+	if err != nil {
+		s.server.RespondErr(w, r, errs.InvalidError{Field: "body", Reason: "unable to read body", Err: err})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// subscribe before the workflow is queued so no event is missed
+	messages, err := s.events.Subscribe(ctx, event.InternalEventsTopic)
+	if err != nil {
+		s.server.RespondErr(w, r, errs.InternalError{Err: err})
+		return
+	}
+
+	triggerID := uuid.New()
+	fired, err := s.app.Commands.FireWebhookTrigger.Handle(ctx, command.FireWebhookTriggerCommand{
+		ID:        id,
+		TriggerID: triggerID,
+		SessionID: uuid.New(),
+		Request: model.InboundRequest{
+			Header: r.Header,
+			Query:  r.URL.Query(),
+			Body:   body,
+		},
+		Caller: model.TriggerCaller{APIKey: "", EndUserID: "", IP: s.server.ClientIP(r), Client: false},
+	})
+	if err != nil {
+		s.respondRetryableErr(w, r, err)
+		return
+	}
+
+	if !fired.RespondSync {
+		s.server.Respond(w, r, http.StatusAccepted, idResponse{
+			ID: triggerID.String(),
+		})
+		return
+	}
+
+	ctx, cancelWait := context.WithTimeout(ctx, fired.SyncTimeout)
+	defer cancelWait()
+
+	status, res := awaitWorkflowResult(ctx, messages, triggerID)
+	s.server.Respond(w, r, status, res)
+}
+
+// awaitWorkflowResult waits for the terminal event of the trigger.
+func awaitWorkflowResult(
+	ctx context.Context,
+	messages <-chan *message.Message,
+	triggerID uuid.UUID,
+) (int, webhookTriggerResponse) {
+	running := webhookTriggerResponse{
+		TriggerID: triggerID,
+		Status:    webhookTriggerRunning,
+		Result:    nil,
+		Error:     "",
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return http.StatusAccepted, running
+		case msg, ok := <-messages:
+			if !ok {
+				return http.StatusAccepted, running
+			}
+			msg.Ack()
+
+			var e event.WorkflowEventMessage
+			if err := json.Unmarshal(msg.Payload, &e); err != nil {
+				slog.Error("error unmarshalling workflow event message", "error", err)
+				continue
+			}
+
+			if e.TriggerID != triggerID || !e.Type.IsTerminal() {
+				continue
+			}
+
+			return workflowResultResponse(e)
+		}
+	}
+}
+
+func workflowResultResponse(e event.WorkflowEventMessage) (int, webhookTriggerResponse) {
+	res := webhookTriggerResponse{
+		TriggerID: e.TriggerID,
+		Status:    "",
+		Result:    nil,
+		Error:     "",
+	}
+
+	switch e.Type {
+	case model.WorkflowCompleted:
+		res.Status = webhookTriggerCompleted
+		res.Result = e.Data[resultDataKey]
+		return http.StatusOK, res
+	case model.WorkflowCancelled:
+		res.Status = webhookTriggerCancelled
+	default:
+		res.Status = webhookTriggerFailed
+		res.Error, _ = e.Data[errorDataKey].(string)
+		if res.Error == "" {
+			res.Error = "workflow failed"
+		}
+	}
+	return http.StatusBadGateway, res
+}
+
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+func nilIfZero[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,413 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/google/uuid"
+	"github.com/supallm/core/internal/application/command"
+	"github.com/supallm/core/internal/application/domain/model"
+	"github.com/supallm/core/internal/application/event"
+	"github.com/supallm/core/internal/application/query"
+	"github.com/supallm/core/internal/pkg/errs"
+)
+
+const (
+	socketWriteTimeout = 10 * time.Second
+
+	// client → server
+	socketSubscribe   = "subscribe"
+	socketUnsubscribe = "unsubscribe"
+	socketTrigger     = "trigger"
+	socketCancel      = "cancel"
+	socketAnswer      = "answer"
+
+	// server → client
+	socketEvent = "event"
+	socketAck   = "ack"
+	socketError = "error"
+)
+
+type (
+	// socketClientMessage is sent by clients, the optional id is echoed
+	// back in the ack or error answering the message. An answer carries the
+	// id of the human input node it resumes and the inputs it is given.
+	socketClientMessage struct {
+		ID        string         `json:"id,omitempty"`
+		Type      string         `json:"type"`
+		TriggerID uuid.UUID      `json:"triggerId"`
+		SessionID *uuid.UUID     `json:"sessionId,omitempty"`
+		Sequence  uint64         `json:"sequence,omitempty"`
+		NodeID    string         `json:"nodeId,omitempty"`
+		Inputs    map[string]any `json:"inputs,omitempty"`
+	}
+
+	socketServerMessage struct {
+		ID        string                      `json:"id,omitempty"`
+		Type      string                      `json:"type"`
+		TriggerID *uuid.UUID                  `json:"triggerId,omitempty"`
+		Event     *event.WorkflowEventMessage `json:"event,omitempty"`
+		Error     *errs.ProblemJSON           `json:"error,omitempty"`
+	}
+
+	// workflowSocket is a client connection on a workflow. It streams the events
+	// of every subscribed trigger and relays client commands to the application.
+	workflowSocket struct {
+		server     *Server
+		conn       *websocket.Conn
+		projectID  uuid.UUID
+		workflowID model.WorkflowID
+
+		// endUserID is the authenticated end-user making the triggers,
+		// listener the one allowed to subscribe to them, caller the one
+		// counted against the rate limit of the workflow.
+		endUserID string
+		listener  *string
+		caller    model.TriggerCaller
+
+		// mu serializes the replay of stored events with the live ones,
+		// subscriptions holds the last sequence sent for each trigger.
+		mu            sync.Mutex
+		subscriptions map[uuid.UUID]uint64
+	}
+)
+
+// workflowWebSocket serves the same events as listenWorkflowEvents over a WebSocket.
+// A trigger can be subscribed at connection time with the triggerId and sequence
+// query parameters, the sequence being the last one received by the client.
+func (s *Server) workflowWebSocket(w http.ResponseWriter, r *http.Request) {
+	projectID, err := s.server.ParseUUID(r, "projectId")
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	// the socket triggers, cancels and answers the executions of the workflow
+	workflowID := model.WorkflowID(s.server.GetParam(r, "workflowId"))
+	if err = s.authorizeDashboard(r.Context(), projectID, workflowID, model.PermissionRun); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	initial, err := socketInitialSubscription(r)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		OriginPatterns: s.server.OriginHosts(),
+	})
+	if err != nil {
+		slog.Error("error accepting websocket", "error", err)
+		return
+	}
+	defer conn.CloseNow()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	messages, err := s.events.Subscribe(ctx, event.InternalEventsTopic)
+	if err != nil {
+		conn.Close(websocket.StatusInternalError, "unable to subscribe to events")
+		return
+	}
+
+	endUserID, _ := s.server.AuthenticatedEndUser(ctx)
+	socket := &workflowSocket{
+		server:        s,
+		conn:          conn,
+		projectID:     projectID,
+		workflowID:    workflowID,
+		endUserID:     endUserID,
+		listener:      s.eventListener(ctx, projectID),
+		caller:        s.triggerCaller(r, projectID),
+		subscriptions: map[uuid.UUID]uint64{},
+	}
+
+	if initial != nil {
+		socket.handle(ctx, *initial)
+	}
+
+	go func() {
+		defer cancel()
+		socket.readLoop(ctx)
+	}()
+
+	socket.writeLoop(ctx, messages)
+}
+
+func socketInitialSubscription(r *http.Request) (*socketClientMessage, error) {
+	trigger := r.URL.Query().Get("triggerId")
+	if trigger == "" {
+		return nil, nil
+	}
+
+	triggerID, err := uuid.Parse(trigger)
+	if err != nil {
+		return nil, errs.InvalidError{Field: "triggerId", Reason: "invalid uuid", Err: err}
+	}
+
+	var sequence uint64
+	if seq := r.URL.Query().Get("sequence"); seq != "" {
+		sequence, err = strconv.ParseUint(seq, 10, 64)
+		if err != nil {
+			return nil, errs.InvalidError{Field: "sequence", Reason: "invalid sequence", Err: err}
+		}
+	}
+
+	return &socketClientMessage{
+		ID:        "",
+		Type:      socketSubscribe,
+		TriggerID: triggerID,
+		SessionID: nil,
+		Sequence:  sequence,
+		NodeID:    "",
+		Inputs:    nil,
+	}, nil
+}
+
+func (ws *workflowSocket) readLoop(ctx context.Context) {
+	for {
+		var msg socketClientMessage
+		err := wsjson.Read(ctx, ws.conn, &msg)
+		if err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) {
+				ws.sendError(ctx, msg, errs.InvalidError{Reason: "unable to decode message", Err: err})
+				continue
+			}
+			return
+		}
+
+		ws.handle(ctx, msg)
+	}
+}
+
+func (ws *workflowSocket) writeLoop(ctx context.Context, messages <-chan *message.Message) {
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			ws.conn.Close(websocket.StatusNormalClosure, "")
+			return
+		case <-heartbeat.C:
+			pingCtx, cancel := context.WithTimeout(ctx, socketWriteTimeout)
+			err := ws.conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				return
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			msg.Ack()
+
+			var e event.WorkflowEventMessage
+			if err := json.Unmarshal(msg.Payload, &e); err != nil {
+				slog.Error("error unmarshalling workflow event message", "error", err)
+				continue
+			}
+
+			if err := ws.sendEvent(ctx, e); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (ws *workflowSocket) handle(ctx context.Context, msg socketClientMessage) {
+	var err error
+	switch msg.Type {
+	case socketSubscribe:
+		err = ws.subscribe(ctx, msg.TriggerID, msg.Sequence)
+	case socketUnsubscribe:
+		ws.unsubscribe(msg.TriggerID)
+	case socketTrigger:
+		err = ws.trigger(ctx, msg)
+	case socketCancel:
+		err = ws.server.app.Commands.CancelWorkflow.Handle(ctx, command.CancelWorkflowCommand{
+			ProjectID:  ws.projectID,
+			WorkflowID: ws.workflowID,
+			TriggerID:  msg.TriggerID,
+			EndUserID:  ws.listener,
+		})
+	case socketAnswer:
+		err = ws.server.app.Commands.AnswerHumanInput.Handle(ctx, command.AnswerHumanInputCommand{
+			ProjectID:  ws.projectID,
+			WorkflowID: ws.workflowID,
+			TriggerID:  msg.TriggerID,
+			EndUserID:  ws.listener,
+			NodeID:     msg.NodeID,
+			Inputs:     msg.Inputs,
+		})
+	default:
+		err = errs.InvalidError{Field: "type", Reason: "unknown message type " + msg.Type}
+	}
+
+	if err != nil {
+		ws.sendError(ctx, msg, err)
+		return
+	}
+
+	ws.write(ctx, socketServerMessage{
+		ID:        msg.ID,
+		Type:      socketAck,
+		TriggerID: &msg.TriggerID,
+		Event:     nil,
+		Error:     nil,
+	})
+}
+
+// trigger runs the workflow and subscribes to its events before it is queued.
+func (ws *workflowSocket) trigger(ctx context.Context, msg socketClientMessage) error {
+	if msg.TriggerID == uuid.Nil {
+		return errs.ReqMissingError{Field: "triggerId"}
+	}
+
+	sessionID := uuid.New()
+	if msg.SessionID != nil {
+		sessionID = *msg.SessionID
+	}
+
+	ws.mu.Lock()
+	ws.subscriptions[msg.TriggerID] = 0
+	ws.mu.Unlock()
+
+	err := ws.server.app.Commands.TriggerWorkflow.Handle(ctx, command.TriggerWorkflowCommand{
+		ProjectID:  ws.projectID,
+		WorkflowID: ws.workflowID,
+		TriggerID:  msg.TriggerID,
+		SessionID:  sessionID,
+		EndUserID:  ws.endUserID,
+		Caller:     ws.caller,
+		Inputs:     msg.Inputs,
+	})
+	if err != nil {
+		ws.unsubscribe(msg.TriggerID)
+		return err
+	}
+
+	return nil
+}
+
+// subscribe replays the stored events following the given sequence,
+// live events are held until the replay is done.
+func (ws *workflowSocket) subscribe(ctx context.Context, triggerID uuid.UUID, sequence uint64) error {
+	if triggerID == uuid.Nil {
+		return errs.ReqMissingError{Field: "triggerId"}
+	}
+
+	err := ws.server.authorizeTriggerListening(ctx, ws.projectID, triggerID, ws.listener)
+	if err != nil {
+		return err
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.subscriptions[triggerID] = sequence
+
+	stored, err := ws.server.app.Queries.ListenWorkflowEvents.Handle(ctx, query.ListenWorkflowQuery{
+		WorkflowID: ws.workflowID,
+		TriggerID:  triggerID,
+		Sequence:   sequence + 1,
+	})
+	if err != nil {
+		delete(ws.subscriptions, triggerID)
+		return errs.InternalError{Err: err}
+	}
+
+	for _, e := range stored {
+		if err = ws.deliver(ctx, e); err != nil {
+			return errs.InternalError{Err: err}
+		}
+	}
+
+	return nil
+}
+
+func (ws *workflowSocket) unsubscribe(triggerID uuid.UUID) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	delete(ws.subscriptions, triggerID)
+}
+
+func (ws *workflowSocket) sendEvent(ctx context.Context, e event.WorkflowEventMessage) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	return ws.deliver(ctx, e)
+}
+
+// deliver sends an event of a subscribed trigger once, mu must be held.
+func (ws *workflowSocket) deliver(ctx context.Context, e event.WorkflowEventMessage) error {
+	last, ok := ws.subscriptions[e.TriggerID]
+	if !ok || e.Sequence <= last {
+		return nil
+	}
+
+	err := ws.write(ctx, socketServerMessage{
+		ID:        "",
+		Type:      socketEvent,
+		TriggerID: &e.TriggerID,
+		Event:     &e,
+		Error:     nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	if e.Type.IsTerminal() {
+		delete(ws.subscriptions, e.TriggerID)
+		return nil
+	}
+
+	ws.subscriptions[e.TriggerID] = e.Sequence
+	return nil
+}
+
+func (ws *workflowSocket) sendError(ctx context.Context, msg socketClientMessage, err error) {
+	slog.Error("error handling websocket message", "type", msg.Type, "error", err)
+
+	var triggerID *uuid.UUID
+	if msg.TriggerID != uuid.Nil {
+		triggerID = &msg.TriggerID
+	}
+
+	ws.write(ctx, socketServerMessage{
+		ID:        msg.ID,
+		Type:      socketError,
+		TriggerID: triggerID,
+		Event:     nil,
+		Error:     errs.Problem(err),
+	})
+}
+
+// write closes the connection when the message cannot be sent, the client
+// would otherwise miss acks or events without noticing.
+func (ws *workflowSocket) write(ctx context.Context, msg socketServerMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, socketWriteTimeout)
+	defer cancel()
+
+	err := wsjson.Write(ctx, ws.conn, msg)
+	if err != nil {
+		slog.Error("error writing websocket message", "type", msg.Type, "error", err)
+		ws.conn.CloseNow()
+	}
+	return err
+}
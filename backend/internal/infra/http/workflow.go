@@ -19,6 +19,11 @@ func (s *Server) CreateWorkflow(w http.ResponseWriter, r *http.Request, projectI
 		return
 	}
 
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	builderFlow, err := json.Marshal(req.BuilderFlow)
 	if err != nil {
 		s.server.RespondErr(w, r, errs.InvalidError{Reason: "unable to marshal builder flow", Err: err})
@@ -43,6 +48,11 @@ func (s *Server) CreateWorkflow(w http.ResponseWriter, r *http.Request, projectI
 }
 
 func (s *Server) GetWorkflow(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	workflow, err := s.app.Queries.GetWorkflow.Handle(r.Context(), query.GetWorkflowQuery{
 		ProjectID:  projectID,
 		WorkflowID: model.WorkflowID(workflowID),
@@ -62,6 +72,11 @@ func (s *Server) UpdateWorkflow(w http.ResponseWriter, r *http.Request, projectI
 		return
 	}
 
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	builderFlow, err := json.Marshal(req.BuilderFlow)
 	if err != nil {
 		s.server.RespondErr(w, r, errs.InvalidError{Reason: "unable to marshal builder flow", Err: err})
@@ -83,6 +98,11 @@ func (s *Server) UpdateWorkflow(w http.ResponseWriter, r *http.Request, projectI
 }
 
 func (s *Server) DeleteWorkflow(w http.ResponseWriter, r *http.Request, projectID gen.UUID, workflowID string) {
+	if err := s.authorize(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionEdit); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	err := s.app.Commands.RemoveWorkflow.Handle(r.Context(), command.RemoveWorkflowCommand{
 		ProjectID:  projectID,
 		WorkflowID: model.WorkflowID(workflowID),
@@ -96,6 +116,11 @@ func (s *Server) DeleteWorkflow(w http.ResponseWriter, r *http.Request, projectI
 }
 
 func (s *Server) ListWorkflows(w http.ResponseWriter, r *http.Request, projectID gen.UUID) {
+	if err := s.authorize(r.Context(), projectID, "", model.PermissionView); err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
+
 	workflows, err := s.app.Queries.ListWorkflows.Handle(r.Context(), query.ListWorkflowsQuery{
 		ProjectID: projectID,
 	})
@@ -114,11 +139,11 @@ func (s *Server) TriggerWorkflow(w http.ResponseWriter, r *http.Request, project
 		return
 	}
 
-	// err := s.isAuthorize(r.Context(), projectID, s.app.Commands.AuthorizeEventSubscription.Handle)
-	// if err != nil {
-	// 	s.server.RespondErr(w, r, err)
-	// 	return
-	// }
+	err := s.authorizeDashboard(r.Context(), projectID, model.WorkflowID(workflowID), model.PermissionRun)
+	if err != nil {
+		s.server.RespondErr(w, r, err)
+		return
+	}
 
 	var sessionID uuid.UUID
 	if req.SessionId != nil {
@@ -127,15 +152,18 @@ func (s *Server) TriggerWorkflow(w http.ResponseWriter, r *http.Request, project
 		sessionID = uuid.New()
 	}
 
-	err := s.app.Commands.TriggerWorkflow.Handle(r.Context(), command.TriggerWorkflowCommand{
+	endUserID, _ := s.server.AuthenticatedEndUser(r.Context())
+	err = s.app.Commands.TriggerWorkflow.Handle(r.Context(), command.TriggerWorkflowCommand{
 		ProjectID:  projectID,
 		WorkflowID: model.WorkflowID(workflowID),
 		TriggerID:  req.TriggerId,
 		SessionID:  sessionID,
+		EndUserID:  endUserID,
+		Caller:     s.triggerCaller(r, projectID),
 		Inputs:     req.Inputs,
 	})
 	if err != nil {
-		s.server.RespondErr(w, r, err)
+		s.respondRetryableErr(w, r, err)
 		return
 	}
 
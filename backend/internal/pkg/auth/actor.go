@@ -0,0 +1,38 @@
+package auth
+
+import "context"
+
+type (
+	ActorType string
+
+	actorContextKey struct{}
+)
+
+const (
+	ActorUser      ActorType = "user"
+	ActorAPIKey    ActorType = "api_key"
+	ActorAnonymous ActorType = "anonymous"
+	// ActorSystem performs the actions made outside of a request,
+	// by the schedulers and the workers.
+	ActorSystem ActorType = "system"
+)
+
+// Actor is the author of the actions of a request, as recorded in the audit log.
+type Actor struct {
+	Type ActorType
+	// ID is the user of the user actors, empty otherwise.
+	ID        string
+	IPAddress string
+}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor of the request, the system outside of requests.
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorContextKey{}).(Actor); ok {
+		return actor
+	}
+	return Actor{Type: ActorSystem, ID: "", IPAddress: ""}
+}
@@ -1,7 +1,12 @@
 package auth
 
 type User struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	ID                 string `json:"id"`
+	Email              string `json:"email"`
+	Name               string `json:"name"`
+	Admin              bool   `json:"admin"`
+	MustChangePassword bool   `json:"mustChangePassword"`
+	MFAEnabled         bool   `json:"mfaEnabled"`
+	// SessionID is the session of the token the user authenticated with.
+	SessionID string `json:"-"`
 }
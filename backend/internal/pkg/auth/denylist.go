@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const denylistKeyPrefix = "session:denied:"
+
+// Denylist holds the revoked sessions until the access tokens issued
+// for them expire, so that they are rejected without a database lookup.
+type Denylist struct {
+	client *redis.Client
+}
+
+func NewDenylist(client *redis.Client) *Denylist {
+	return &Denylist{
+		client: client,
+	}
+}
+
+// Deny rejects the access tokens of the sessions from now on.
+func (d *Denylist) Deny(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	pipe := d.client.Pipeline()
+	for _, id := range sessionIDs {
+		pipe.Set(ctx, denylistKeyPrefix+id.String(), 1, AccessTokenTTL)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to deny sessions: %w", err)
+	}
+	return nil
+}
+
+// IsDenied tells whether the session was revoked within the lifetime of its tokens.
+func (d *Denylist) IsDenied(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	err := d.client.Get(ctx, denylistKeyPrefix+sessionID.String()).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+	return true, nil
+}
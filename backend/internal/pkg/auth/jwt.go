@@ -11,7 +11,10 @@ import (
 
 type (
 	Claims struct {
-		UserID               uuid.UUID `json:"user_id" exhaustruct:"optional"`
+		UserID uuid.UUID `json:"user_id" exhaustruct:"optional"`
+		// SessionID is the session the token was issued for, revoking
+		// the session revokes its tokens.
+		SessionID            uuid.UUID `json:"sid" exhaustruct:"optional"`
 		Email                string    `json:"email" exhaustruct:"optional"`
 		Name                 string    `json:"name" exhaustruct:"optional"`
 		jwt.RegisteredClaims `exhaustruct:"optional"`
@@ -22,27 +25,85 @@ type (
 
 const (
 	Issuer = "supallm-api"
-	expiry = 30 * 24 * time.Hour
+	// AccessTokenTTL is kept short, sessions are extended with their refresh token.
+	AccessTokenTTL = 15 * time.Minute
+	// MFAChallengeTTL is the time left to enter the second factor of a login.
+	MFAChallengeTTL = 5 * time.Minute
+
+	mfaAudience = "mfa"
 )
 
 func (t Token) String() string {
 	return string(t)
 }
 
-func GenerateToken(userID uuid.UUID, email, name, secretKey string) (Token, error) {
-	expirationTime := time.Now().Add(expiry)
+// GenerateToken issues an access token of the session, valid for AccessTokenTTL.
+func GenerateToken(userID uuid.UUID, sessionID uuid.UUID, email, name, secretKey string) (Token, error) {
+	expirationTime := time.Now().Add(AccessTokenTTL)
+
+	claims := &Claims{
+		UserID:    userID,
+		SessionID: sessionID,
+		Email:     email,
+		Name:      name,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    Issuer,
+		},
+	}
+
+	return sign(claims, secretKey)
+}
+
+// GenerateMFAChallenge issues the token of a login waiting for its second
+// factor, it is not accepted as an access token.
+func GenerateMFAChallenge(userID uuid.UUID, secretKey string) (Token, time.Time, error) {
+	expirationTime := time.Now().Add(MFAChallengeTTL)
 
 	claims := &Claims{
 		UserID: userID,
-		Email:  email,
-		Name:   name,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{mfaAudience},
 		},
 	}
 
+	token, err := sign(claims, secretKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expirationTime, nil
+}
+
+func VerifyToken(tokenString, secretKey string) (*Claims, error) {
+	claims, err := parse(tokenString, secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// tokens issued before sessions cannot be revoked and are no longer
+	// accepted, MFA challenges have no session either
+	if claims.SessionID == uuid.Nil {
+		return nil, errors.New("invalid token claims")
+	}
+
+	return claims, nil
+}
+
+// VerifyMFAChallenge returns the user of a login waiting for its second factor.
+func VerifyMFAChallenge(tokenString, secretKey string) (uuid.UUID, error) {
+	claims, err := parse(tokenString, secretKey, jwt.WithAudience(mfaAudience))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return claims.UserID, nil
+}
+
+func sign(claims *Claims, secretKey string) (Token, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	tokenString, err := token.SignedString([]byte(secretKey))
@@ -53,13 +114,13 @@ func GenerateToken(userID uuid.UUID, email, name, secretKey string) (Token, erro
 	return Token(tokenString), nil
 }
 
-func VerifyToken(tokenString, secretKey string) (*Claims, error) {
+func parse(tokenString, secretKey string, opts ...jwt.ParserOption) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, new(Claims), func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return []byte(secretKey), nil
-	})
+	}, opts...)
 
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %w", err)
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+const oneTimeTokenSize = 32
+
+// OneTimeToken is a random token handed out once, such as an invitation
+// or a password reset token. Only its digest is stored.
+type OneTimeToken string
+
+func (t OneTimeToken) String() string {
+	return string(t)
+}
+
+// Digest returns the hex SHA-256 of the token, under which it is stored.
+func (t OneTimeToken) Digest() string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+func GenerateOneTimeToken() (OneTimeToken, error) {
+	b := make([]byte, oneTimeTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return OneTimeToken(base64.RawURLEncoding.EncodeToString(b)), nil
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // TOTP authenticator apps use HMAC-SHA1
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters of RFC 6238, the defaults of the authenticator apps.
+const (
+	totpSecretSize = 20
+	totpDigits     = 6
+	totpPeriod     = 30 * time.Second
+	// totpSkew accepts the codes of the adjacent periods, for clock drifts.
+	totpSkew = 1
+
+	recoveryCodeSize = 10
+)
+
+//nolint:gochecknoglobals // secrets are encoded without padding, as expected by the apps
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random base32 secret to share with an authenticator app.
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI returns the otpauth URI of a secret, rendered as a QR code by clients.
+func TOTPURI(secret string, issuer string, account string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// VerifyTOTP checks a code of the secret and returns its time step. Codes
+// are accepted once, so only the steps following lastStep are.
+func VerifyTOTP(secret string, code string, now time.Time, lastStep int64) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(totpPeriod.Seconds())
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulus := uint32(1)
+	for range totpDigits {
+		modulus *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulus)
+}
+
+// GenerateRecoveryCode returns a code standing in for a TOTP code once,
+// such as xxxxx-xxxxx. Only its digest is stored.
+func GenerateRecoveryCode() (OneTimeToken, error) {
+	b := make([]byte, recoveryCodeSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate recovery code: %w", err)
+	}
+
+	code := strings.ToLower(totpEncoding.EncodeToString(b))[:recoveryCodeSize]
+	return OneTimeToken(code[:recoveryCodeSize/2] + "-" + code[recoveryCodeSize/2:]), nil
+}
+
+// NormalizeRecoveryCode returns a recovery code as generated, whatever its case.
+func NormalizeRecoveryCode(code string) OneTimeToken {
+	return OneTimeToken(strings.ToLower(strings.TrimSpace(code)))
+}
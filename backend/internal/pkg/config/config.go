@@ -2,10 +2,13 @@ package config
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/netip"
 	"os"
+	"strings"
 )
 
 const (
@@ -14,7 +17,14 @@ const (
 
 type (
 	Server struct {
-		Port string
+		Port       string
+		InstanceID string
+		// AllowedOrigins are the origins allowed to call the API from a
+		// browser, such as https://app.example.com, "*" allows any.
+		AllowedOrigins []string
+		// TrustedProxies are the addresses of the reverse proxies whose
+		// X-Forwarded-For header gives the address of the clients.
+		TrustedProxies []netip.Prefix
 	}
 
 	Redis struct {
@@ -37,11 +47,37 @@ type (
 		InitialUser InitialUser
 	}
 
+	// ModelPrice is the price in USD of a million tokens.
+	ModelPrice struct {
+		Input  float64 `json:"input"`
+		Output float64 `json:"output"`
+	}
+
+	Usage struct {
+		// ModelPrices override or extend the built-in price table by model name.
+		ModelPrices map[string]ModelPrice
+	}
+
+	Providers struct {
+		// BaseURLs replace the endpoints of the providers, by provider type,
+		// when testing their credentials.
+		BaseURLs map[string]string
+	}
+
+	Webhooks struct {
+		// AllowPrivateNetworks lets webhooks and the key sets of the auth
+		// providers target loopback and private addresses, for local development.
+		AllowPrivateNetworks bool
+	}
+
 	Config struct {
-		Server   Server
-		Redis    Redis
-		Postgres Postgres
-		Auth     Auth
+		Server    Server
+		Redis     Redis
+		Postgres  Postgres
+		Auth      Auth
+		Usage     Usage
+		Providers Providers
+		Webhooks  Webhooks
 	}
 )
 
@@ -66,7 +102,10 @@ func Load(_ context.Context) Config {
 
 	return Config{
 		Server: Server{
-			Port: httpPort,
+			Port:           httpPort,
+			InstanceID:     getOrDefault("INSTANCE_ID", hostname()),
+			AllowedOrigins: allowedOrigins(),
+			TrustedProxies: trustedProxies(),
 		},
 		Redis: Redis{
 			Host:     redisHost,
@@ -83,7 +122,98 @@ func Load(_ context.Context) Config {
 				Name:     getOrDefault("INITIAL_USER_NAME", "admin"),
 			},
 		},
+		Usage: Usage{
+			ModelPrices: modelPrices(),
+		},
+		Providers: Providers{
+			BaseURLs: providerBaseURLs(),
+		},
+		Webhooks: Webhooks{
+			AllowPrivateNetworks: os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS") == "true",
+		},
+	}
+}
+
+// modelPrices reads the price table from MODEL_PRICES_FILE or MODEL_PRICES,
+// a JSON object such as {"gpt-4o": {"input": 2.5, "output": 10}}.
+func modelPrices() map[string]ModelPrice {
+	raw := []byte(os.Getenv("MODEL_PRICES"))
+	if path := os.Getenv("MODEL_PRICES_FILE"); path != "" {
+		var err error
+		raw, err = os.ReadFile(path)
+		if err != nil {
+			slog.Error("unable to read model prices", "path", path, "error", err)
+			os.Exit(1)
+		}
+	}
+
+	if len(raw) == 0 {
+		return nil
+	}
+
+	prices := make(map[string]ModelPrice)
+	if err := json.Unmarshal(raw, &prices); err != nil {
+		slog.Error("invalid model prices", "error", err)
+		os.Exit(1)
 	}
+	return prices
+}
+
+// providerBaseURLs reads PROVIDER_BASE_URLS, a JSON object such as
+// {"openai": "http://localhost:8089/v1"}.
+func providerBaseURLs() map[string]string {
+	raw := os.Getenv("PROVIDER_BASE_URLS")
+	if raw == "" {
+		return nil
+	}
+
+	baseURLs := make(map[string]string)
+	if err := json.Unmarshal([]byte(raw), &baseURLs); err != nil {
+		slog.Error("invalid provider base URLs", "error", err)
+		os.Exit(1)
+	}
+	return baseURLs
+}
+
+// allowedOrigins reads ALLOWED_ORIGINS, a comma separated list of origins,
+// any origin is allowed when it is not set.
+func allowedOrigins() []string {
+	raw := os.Getenv("ALLOWED_ORIGINS")
+	if raw == "" {
+		return []string{"*"}
+	}
+
+	var origins []string
+	for _, origin := range strings.Split(raw, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// trustedProxies reads TRUSTED_PROXIES, a comma separated list of addresses
+// and networks such as 10.0.0.0/8, no proxy is trusted when it is not set.
+func trustedProxies() []netip.Prefix {
+	var proxies []netip.Prefix
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				slog.Error("invalid trusted proxy", "proxy", proxy, "error", err)
+				os.Exit(1)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies
 }
 
 func mustGet(key string) string {
@@ -95,6 +225,16 @@ func mustGet(key string) string {
 	return value
 }
 
+// hostname identifies the replica when INSTANCE_ID is not set,
+// it is unique per container in most deployments.
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
 func getOrDefault(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if value == "" {
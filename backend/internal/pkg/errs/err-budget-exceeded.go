@@ -0,0 +1,49 @@
+package errs
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ensures it implements problem at compile time.
+var _ problem = BudgetExceededError{}
+
+// BudgetExceededError is returned when a hard budget rejects a trigger.
+type BudgetExceededError struct {
+	BudgetID any     `exhaustruct:"optional"`
+	Scope    string  `exhaustruct:"optional"`
+	Period   string  `exhaustruct:"optional"`
+	LimitUSD float64 `exhaustruct:"optional"`
+	SpentUSD float64 `exhaustruct:"optional"`
+}
+
+func (e BudgetExceededError) Error() string {
+	return e.Detail()
+}
+
+func (e BudgetExceededError) Detail() string {
+	if e.Scope != "" {
+		return fmt.Sprintf("the %s %s budget of %.2f USD is exhausted", e.Period, e.Scope, e.LimitUSD)
+	}
+	return "budget exhausted"
+}
+
+// Slug implements problem.
+func (e BudgetExceededError) Slug() slug { return SlugBudgetExceeded }
+
+// Status implements problem.
+func (e BudgetExceededError) Status() int { return http.StatusPaymentRequired }
+
+// DocURL implements problem.
+func (e BudgetExceededError) DocURL() string { return "-" }
+
+// Params implements problem.
+func (e BudgetExceededError) Params() map[string]any {
+	return map[string]any{
+		"budgetId": e.BudgetID,
+		"scope":    e.Scope,
+		"period":   e.Period,
+		"limitUsd": e.LimitUSD,
+		"spentUsd": e.SpentUSD,
+	}
+}
@@ -0,0 +1,49 @@
+package errs
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+)
+
+// ensures it implements problem at compile time.
+var _ problem = LockedError{}
+
+// LockedError is returned when too many failed logins locked an account or an address out.
+type LockedError struct {
+	Scope      string        `exhaustruct:"optional"`
+	RetryAfter time.Duration `exhaustruct:"optional"`
+}
+
+func (e LockedError) Error() string {
+	return e.Detail()
+}
+
+func (e LockedError) Detail() string {
+	if e.Scope != "" {
+		return fmt.Sprintf("too many failed logins, %s locked for %ds", e.Scope, e.retryAfterSeconds())
+	}
+	return "too many failed logins"
+}
+
+// Slug implements problem.
+func (e LockedError) Slug() slug { return SlugLocked }
+
+// Status implements problem.
+func (e LockedError) Status() int { return http.StatusLocked }
+
+// DocURL implements problem.
+func (e LockedError) DocURL() string { return "-" }
+
+// Params implements problem.
+func (e LockedError) Params() map[string]any {
+	return map[string]any{
+		"scope":      e.Scope,
+		"retryAfter": e.retryAfterSeconds(),
+	}
+}
+
+func (e LockedError) retryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
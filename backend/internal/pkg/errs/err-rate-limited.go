@@ -0,0 +1,49 @@
+package errs
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+)
+
+// ensures it implements problem at compile time.
+var _ problem = RateLimitedError{}
+
+// RateLimitedError is returned when a rate limit rejects a request.
+type RateLimitedError struct {
+	Scope      string        `exhaustruct:"optional"`
+	RetryAfter time.Duration `exhaustruct:"optional"`
+}
+
+func (e RateLimitedError) Error() string {
+	return e.Detail()
+}
+
+func (e RateLimitedError) Detail() string {
+	if e.Scope != "" {
+		return fmt.Sprintf("%s rate limit exceeded, retry in %ds", e.Scope, e.retryAfterSeconds())
+	}
+	return "rate limit exceeded"
+}
+
+// Slug implements problem.
+func (e RateLimitedError) Slug() slug { return SlugRateLimited }
+
+// Status implements problem.
+func (e RateLimitedError) Status() int { return http.StatusTooManyRequests }
+
+// DocURL implements problem.
+func (e RateLimitedError) DocURL() string { return "-" }
+
+// Params implements problem.
+func (e RateLimitedError) Params() map[string]any {
+	return map[string]any{
+		"scope":      e.Scope,
+		"retryAfter": e.retryAfterSeconds(),
+	}
+}
+
+func (e RateLimitedError) retryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
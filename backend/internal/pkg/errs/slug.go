@@ -22,6 +22,9 @@ const (
 	SlugCreate         slug = "create-error"
 	SlugUpdate         slug = "update-error"
 	SlugDelete         slug = "delete-error"
+	SlugBudgetExceeded slug = "budget-exceeded"
+	SlugRateLimited    slug = "rate-limited"
+	SlugLocked         slug = "account-locked"
 
 	SlugUnknown slug = "unknown"
 )
@@ -34,6 +37,9 @@ func LogLevel(err error) slog.Level {
 		errors.Is(err, &InvalidError{}),
 		errors.Is(err, &DuplicateError{}),
 		errors.Is(err, &ConstraintError{}),
+		errors.Is(err, &BudgetExceededError{}),
+		errors.Is(err, &RateLimitedError{}),
+		errors.Is(err, &LockedError{}),
 		errors.Is(err, &CreateError{}),
 		errors.Is(err, &UpdateError{}),
 		errors.Is(err, &DeleteError{}):
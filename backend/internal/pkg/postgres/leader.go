@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Leader elects one replica with a session advisory lock. The lock is held by a
+// dedicated connection of the pool, so leadership is lost with the connection
+// and taken over by another replica.
+type Leader struct {
+	pool *pgxpool.Pool
+	key  int64
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+func NewLeader(pool *pgxpool.Pool, key int64) *Leader {
+	return &Leader{
+		pool: pool,
+		key:  key,
+		mu:   sync.Mutex{},
+		conn: nil,
+	}
+}
+
+// IsLeader reports whether this replica holds the lock, trying to acquire it when it does not.
+func (l *Leader) IsLeader(ctx context.Context) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err == nil {
+			return true
+		}
+
+		slog.Warn("leader connection lost", "key", l.key)
+		l.drop()
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		slog.Error("error acquiring leader connection", "error", err)
+		return false
+	}
+
+	var acquired bool
+	err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Release()
+		return false
+	}
+
+	slog.Info("acquired leadership", "key", l.key)
+	l.conn = conn
+	return true
+}
+
+// Resign releases the lock so another replica takes over.
+func (l *Leader) Resign(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return
+	}
+
+	_, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	if err != nil {
+		l.drop()
+		return
+	}
+
+	l.conn.Release()
+	l.conn = nil
+}
+
+// drop closes the leader connection, the server releases its lock with the session.
+func (l *Leader) drop() {
+	_ = l.conn.Conn().Close(context.Background())
+	l.conn.Release()
+	l.conn = nil
+}
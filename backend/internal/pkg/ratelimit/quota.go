@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+type recorderContextKey struct{}
+
+// Quota describes the most restrictive limit a request was counted against:
+// the requests it holds, the ones left and the wait before it is full again.
+type Quota struct {
+	Limit     int
+	Remaining int
+	Reset     time.Duration
+}
+
+// Recorder receives the quota of the request, to report it to the caller.
+type Recorder func(quota Quota)
+
+func WithRecorder(ctx context.Context, record Recorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey{}, record)
+}
+
+// Record reports the quota to the recorder of the request,
+// it is dropped outside of requests.
+func Record(ctx context.Context, quota Quota) {
+	if record, ok := ctx.Value(recorderContextKey{}).(Recorder); ok {
+		record(quota)
+	}
+}
@@ -16,6 +16,8 @@ const (
 	DBWorkflows  = 0
 	DBExecutions = 1
 	DBEvents     = 3
+	DBRateLimits = 4
+	DBSessions   = 5
 
 	maxRetries   = 3
 	poolSize     = 100
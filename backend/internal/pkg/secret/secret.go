@@ -18,6 +18,7 @@ var envKey = []byte(os.Getenv("SECRET_KEY"))
 
 const (
 	keyPrefix       = "sk_"
+	signingPrefix   = "whsec_"
 	minLengthPrefix = 4
 	minLengthSuffix = 4
 	minLength       = minLengthPrefix + minLengthSuffix
@@ -136,6 +137,18 @@ func GenerateAPIKey(secret ...[]byte) (APIKey, Encrypted, error) {
 	return apiKey, encrypted, nil
 }
 
+// GenerateSigningSecret generates a secret used to sign outgoing webhook payloads
+func GenerateSigningSecret(secret ...[]byte) (APIKey, Encrypted, error) {
+	signingSecret := APIKey(signingPrefix + shortuuid.New() + shortuuid.New())
+
+	encrypted, err := signingSecret.Encrypt(secret...)
+	if err != nil {
+		return "", "", err
+	}
+
+	return signingSecret, encrypted, nil
+}
+
 func (e Encrypted) Verify(apiKey APIKey) error {
 	decrypted, err := e.Decrypt()
 	if err != nil {
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const (
+	endUserContextKey contextKey = "end-user"
+
+	xEndUserIDHeader     string = "X-End-User-Id"
+	endUserTokenQueryKey string = "access_token"
+)
+
+// EndUserAuthenticator verifies an end-user token and returns the end-user ID.
+type EndUserAuthenticator func(r *http.Request, token string) (string, error)
+
+// EndUserAuth authenticates the end-users presenting a token issued by the
+// auth provider of the project, as a bearer token or as the access_token
+// query parameter for the clients unable to set headers, such as EventSource.
+// Requests without a token are anonymous, dashboard requests are left as is.
+func (s *Server) EndUserAuth(authenticate EndUserAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if s.IsDashboardOrigin(r.Context()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token := s.endUserToken(r)
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			endUserID, err := authenticate(r, token)
+			if err != nil {
+				s.RespondErr(w, r, err)
+				return
+			}
+
+			r = r.WithContext(context.WithValue(r.Context(), endUserContextKey, endUserID))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AuthenticatedEndUser returns the end-user authenticated by EndUserAuth.
+func (s *Server) AuthenticatedEndUser(ctx context.Context) (string, bool) {
+	endUserID, ok := ctx.Value(endUserContextKey).(string)
+	return endUserID, ok
+}
+
+// EndUserID returns the end-user the request is made for, the authenticated
+// one or else the one declared by the client.
+func (s *Server) EndUserID(r *http.Request) string {
+	if endUserID, ok := s.AuthenticatedEndUser(r.Context()); ok {
+		return endUserID
+	}
+	return r.Header.Get(xEndUserIDHeader)
+}
+
+func (s *Server) endUserToken(r *http.Request) string {
+	authHeader := r.Header.Get("Authorization")
+	if token := strings.TrimPrefix(authHeader, "Bearer "); token != authHeader {
+		return token
+	}
+	return r.URL.Query().Get(endUserTokenQueryKey)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/netip"
 	"strings"
 
 	"github.com/go-chi/chi/v5/middleware"
@@ -28,12 +30,41 @@ const (
 
 	xSecretKeyHeader     string = "X-Secret-Key"
 	xRequestOriginHeader string = "x-request-origin"
+	xForwardedForHeader  string = "X-Forwarded-For"
 
 	dashboardOrigin string = "dashboard"
 )
 
+//nolint:gochecknoglobals // routes reachable without a dashboard token
+var publicPaths = map[string]bool{
+	"/login":              true,
+	"/invitations/accept": true,
+	"/password-reset":     true,
+	"/login/mfa":          true,
+	"/token/refresh":      true,
+}
+
+//nolint:gochecknoglobals // routes left to the users who must change their password
+var passwordChangeRoutes = map[string]bool{
+	http.MethodGet + " /me":          true,
+	http.MethodPut + " /me/password": true,
+	http.MethodPost + " /logout":     true,
+}
+
+// UserLoader returns the current state of the user of a token, so that
+// deactivations and required password changes apply to the issued tokens.
+type UserLoader func(ctx context.Context, claims *auth.Claims) (auth.User, error)
+
+// SetUserLoader sets the loader of the authenticated users, their tokens
+// are trusted as is without one.
+func (s *Server) SetUserLoader(load UserLoader) {
+	s.loadUser = load
+}
+
 func (s *Server) storeUser(r *http.Request, user auth.User) *http.Request {
-	return r.WithContext(context.WithValue(r.Context(), userIDKey, user))
+	ctx := context.WithValue(r.Context(), userIDKey, user)
+	ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorUser, ID: user.ID, IPAddress: s.ClientIP(r)})
+	return r.WithContext(ctx)
 }
 
 func (s *Server) GetUser(ctx context.Context) *auth.User {
@@ -58,7 +89,58 @@ func (s *Server) IsDashboardOrigin(ctx context.Context) bool {
 }
 
 func (s *Server) storeSecretKey(r *http.Request, secretKey string) *http.Request {
-	return r.WithContext(context.WithValue(r.Context(), secretKeyContextKey, secretKey))
+	ctx := context.WithValue(r.Context(), secretKeyContextKey, secretKey)
+	ctx = auth.WithActor(ctx, auth.Actor{Type: auth.ActorAPIKey, ID: "", IPAddress: s.ClientIP(r)})
+	return r.WithContext(ctx)
+}
+
+// storeAnonymousActor attributes the actions of the request to its address
+// until it is authenticated.
+func (s *Server) storeAnonymousActor(r *http.Request) *http.Request {
+	actor := auth.Actor{Type: auth.ActorAnonymous, ID: "", IPAddress: s.ClientIP(r)}
+	return r.WithContext(auth.WithActor(r.Context(), actor))
+}
+
+// ClientIP returns the address the request came from. Behind trusted proxies
+// it is the last address of X-Forwarded-For not added by one of them, the
+// header is ignored on the requests coming from other addresses.
+func (s *Server) ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !s.trustedProxy(host) {
+		return host
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values(xForwardedForHeader), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if addr == "" {
+			continue
+		}
+		host = addr
+		if !s.trustedProxy(addr) {
+			break
+		}
+	}
+	return host
+}
+
+func (s *Server) trustedProxy(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, proxy := range s.conf.Server.TrustedProxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *Server) GetSecretKeyFromContext(ctx context.Context) (secret.APIKey, error) {
@@ -75,8 +157,9 @@ func (s *Server) applyCommonMiddleware() {
 	s.Router.Use(middleware.Recoverer)
 	s.Router.Use(middleware.Logger)
 	s.Router.Use(s.populateContext)
+	s.Router.Use(s.rateLimitHeaders)
 	s.Router.Use(cors.Handler(cors.Options{
-		AllowedOrigins: []string{"*"},
+		AllowedOrigins: s.conf.Server.AllowedOrigins,
 		AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders: []string{
 			"Accept",
@@ -85,35 +168,84 @@ func (s *Server) applyCommonMiddleware() {
 			"X-CSRF-Token",
 			xSecretKeyHeader,
 			xRequestOriginHeader,
+			xEndUserIDHeader,
+		},
+		ExposedHeaders: []string{
+			"Link",
+			"Retry-After",
+			rateLimitLimitHeader,
+			rateLimitRemainingHeader,
+			rateLimitResetHeader,
 		},
-		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: true,
 		MaxAge:           maxAge,
 	}))
 }
 
+// OriginHosts returns the hosts of the allowed origins, as matched by
+// the WebSocket handshake.
+func (s *Server) OriginHosts() []string {
+	hosts := make([]string, 0, len(s.conf.Server.AllowedOrigins))
+	for _, origin := range s.conf.Server.AllowedOrigins {
+		if _, host, ok := strings.Cut(origin, "://"); ok {
+			origin = host
+		}
+		hosts = append(hosts, origin)
+	}
+	return hosts
+}
+
 func (s *Server) JWTAuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/login" {
+		if publicPaths[r.URL.Path] {
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		claims, err := s.parseBearerToken(r)
+		user, err := s.authenticateUser(r)
 		if err != nil {
 			s.RespondErr(w, r, err)
 			return
 		}
 
-		r = s.storeUser(r, auth.User{
-			ID:    claims.UserID.String(),
-			Email: claims.Email,
-			Name:  claims.Name,
-		})
+		r = s.storeUser(r, user)
 		next.ServeHTTP(w, r)
 	})
 }
 
+// authenticateUser returns the user of the bearer token, limited to
+// changing their password when they must.
+func (s *Server) authenticateUser(r *http.Request) (auth.User, error) {
+	claims, err := s.parseBearerToken(r)
+	if err != nil {
+		return auth.User{}, err
+	}
+
+	user := auth.User{
+		ID:                 claims.UserID.String(),
+		Email:              claims.Email,
+		Name:               claims.Name,
+		Admin:              false,
+		MustChangePassword: false,
+		MFAEnabled:         false,
+		SessionID:          claims.SessionID.String(),
+	}
+	if s.loadUser != nil {
+		user, err = s.loadUser(r.Context(), claims)
+		if err != nil {
+			return auth.User{}, err
+		}
+	}
+
+	if user.MustChangePassword && !passwordChangeRoutes[r.Method+" "+r.URL.Path] {
+		return auth.User{}, errs.ForbiddenError{
+			Err: errors.New("password change required"),
+		}
+	}
+
+	return user, nil
+}
+
 func (s *Server) parseBearerToken(r *http.Request) (*auth.Claims, error) {
 	authHeader := r.Header.Get("Authorization")
 	sessionToken := strings.TrimPrefix(authHeader, "Bearer ")
@@ -131,6 +263,16 @@ func (s *Server) parseBearerToken(r *http.Request) (*auth.Claims, error) {
 		}
 	}
 
+	denied, err := s.denylist.IsDenied(r.Context(), claims.SessionID)
+	if err != nil {
+		return nil, errs.InternalError{Err: err}
+	}
+	if denied {
+		return nil, errs.UnauthorizedError{
+			Err: errors.New("session is revoked"),
+		}
+	}
+
 	return claims, nil
 }
 
@@ -139,19 +281,16 @@ func (s *Server) populateContext(next http.Handler) http.Handler {
 		//nolint
 		origin := r.Header.Get(xRequestOriginHeader)
 		r = s.storeOrigin(r, origin)
+		r = s.storeAnonymousActor(r)
 
-		if origin == dashboardOrigin {
-			claims, err := s.parseBearerToken(r)
+		if origin == dashboardOrigin && !publicPaths[r.URL.Path] {
+			user, err := s.authenticateUser(r)
 			if err != nil {
 				s.RespondErr(w, r, err)
 				return
 			}
 
-			r = s.storeUser(r, auth.User{
-				ID:    claims.UserID.String(),
-				Email: claims.Email,
-				Name:  claims.Name,
-			})
+			r = s.storeUser(r, user)
 
 			next.ServeHTTP(w, r)
 			return
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/supallm/core/internal/pkg/ratelimit"
+)
+
+const (
+	rateLimitLimitHeader     string = "RateLimit-Limit"
+	rateLimitRemainingHeader string = "RateLimit-Remaining"
+	rateLimitResetHeader     string = "RateLimit-Reset"
+)
+
+// rateLimitHeaders sets the RateLimit-* headers of the token bucket the
+// request was counted against, on the responses to the rate limited
+// requests, whether they are allowed or rejected with a 429 problem.
+func (s *Server) rateLimitHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := ratelimit.WithRecorder(r.Context(), func(quota ratelimit.Quota) {
+			w.Header().Set(rateLimitLimitHeader, strconv.Itoa(quota.Limit))
+			w.Header().Set(rateLimitRemainingHeader, strconv.Itoa(quota.Remaining))
+			w.Header().Set(rateLimitResetHeader, strconv.Itoa(ceilSeconds(quota.Reset)))
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
+	"github.com/supallm/core/internal/pkg/auth"
 	"github.com/supallm/core/internal/pkg/config"
 	"github.com/supallm/core/internal/pkg/errs"
+	redisclient "github.com/supallm/core/internal/pkg/redis"
 )
 
 const (
@@ -23,16 +25,30 @@ const (
 type Server struct {
 	Router *chi.Mux
 	conf   config.Config
+	// denylist holds the revoked sessions, whose tokens are rejected.
+	denylist *auth.Denylist
+
+	loadUser UserLoader
 }
 
-func New(conf config.Config) *Server {
+// New fails when the session denylist is unavailable, revoked tokens
+// would otherwise be accepted until they expire.
+func New(conf config.Config) (*Server, error) {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
 	r.Use(middleware.Recoverer)
 
+	denylist, err := newDenylist(conf.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("session denylist unavailable: %w", err)
+	}
+
 	s := &Server{
-		Router: r,
-		conf:   conf,
+		Router:   r,
+		conf:     conf,
+		denylist: denylist,
+
+		loadUser: nil,
 	}
 	s.applyCommonMiddleware()
 
@@ -40,7 +56,16 @@ func New(conf config.Config) *Server {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	return s
+	return s, nil
+}
+
+func newDenylist(conf config.Redis) (*auth.Denylist, error) {
+	client, err := redisclient.NewClient(conf, redisclient.DBSessions)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewDenylist(client), nil
 }
 
 func (s *Server) Start() error {
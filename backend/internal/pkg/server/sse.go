@@ -0,0 +1,89 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	lastEventIDHeader = "Last-Event-ID"
+	eventStreamType   = "text/event-stream"
+
+	// retryInterval is the reconnection delay advertised to EventSource clients.
+	retryInterval = 3 * time.Second
+)
+
+// EventStream writes server-sent events to a client, see
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+type EventStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// IsEventStream reports whether the client asked for a server-sent events stream.
+func (s *Server) IsEventStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), eventStreamType)
+}
+
+// LastEventID returns the id of the last event received by a reconnecting client.
+func (s *Server) LastEventID(r *http.Request) string {
+	return r.Header.Get(lastEventIDHeader)
+}
+
+// StartEventStream writes the stream headers and returns a stream ready to send events.
+func (s *Server) StartEventStream(w http.ResponseWriter, r *http.Request) (*EventStream, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming is not supported by the response writer")
+	}
+
+	w.Header().Set("Content-Type", eventStreamType+"; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	// disable proxy buffering for stream responses
+	w.Header().Set("X-Accel-Buffering", "no")
+	if r.ProtoMajor == 1 {
+		// connection-specific headers are forbidden in HTTP/2 (RFC 7540)
+		w.Header().Set("Connection", "keep-alive")
+	}
+	w.WriteHeader(http.StatusOK)
+
+	stream := &EventStream{w: w, flusher: flusher}
+	if err := stream.write(fmt.Sprintf("retry: %d\n\n", retryInterval.Milliseconds())); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// Send writes a single event, the id is omitted when empty.
+func (e *EventStream) Send(id string, event string, data []byte) error {
+	var b strings.Builder
+	if id != "" {
+		b.WriteString("id: " + id + "\n")
+	}
+	if event != "" {
+		b.WriteString("event: " + event + "\n")
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+
+	return e.write(b.String())
+}
+
+// Heartbeat writes a comment line, it keeps idle connections open through proxies.
+func (e *EventStream) Heartbeat() error {
+	return e.write(": heartbeat\n\n")
+}
+
+func (e *EventStream) write(s string) error {
+	if _, err := e.w.Write([]byte(s)); err != nil {
+		return err
+	}
+	e.flusher.Flush()
+	return nil
+}
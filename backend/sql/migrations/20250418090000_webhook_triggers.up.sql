@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS webhook_triggers (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id CHAR(22) NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    verification VARCHAR(20) NOT NULL,
+    signature_header VARCHAR(255) NOT NULL DEFAULT '',
+    secret_encrypted TEXT NOT NULL,
+    input_mapping JSONB NOT NULL DEFAULT '{}',
+    respond_sync BOOLEAN NOT NULL DEFAULT FALSE,
+    sync_timeout_ms INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_triggers_workflow_id ON webhook_triggers(project_id, workflow_id);
+
+CREATE TRIGGER update_webhook_triggers_timestamp
+BEFORE UPDATE ON webhook_triggers
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
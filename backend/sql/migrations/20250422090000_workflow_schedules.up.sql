@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS workflow_schedules (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id CHAR(22) NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    cron_expression VARCHAR(255) NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    inputs JSONB NOT NULL DEFAULT '{}',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    next_fire_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_fired_at TIMESTAMP WITH TIME ZONE,
+    last_trigger_id UUID,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_workflow_schedules_workflow_id ON workflow_schedules(project_id, workflow_id);
+CREATE INDEX idx_workflow_schedules_due ON workflow_schedules(next_fire_at) WHERE enabled;
+
+CREATE TRIGGER update_workflow_schedules_timestamp
+BEFORE UPDATE ON workflow_schedules
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
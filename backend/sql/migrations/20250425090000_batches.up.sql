@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS batches (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id CHAR(22) NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    concurrency INTEGER NOT NULL,
+    total INTEGER NOT NULL,
+    succeeded INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS batch_items (
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    idx INTEGER NOT NULL,
+    trigger_id UUID NOT NULL UNIQUE,
+    inputs JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    result JSONB,
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (batch_id, idx)
+);
+
+CREATE INDEX idx_batches_workflow_id ON batches(project_id, workflow_id, created_at DESC);
+CREATE INDEX idx_batches_running ON batches(created_at) WHERE status = 'running';
+CREATE INDEX idx_batch_items_status ON batch_items(batch_id, status);
+
+CREATE TRIGGER update_batches_timestamp
+BEFORE UPDATE ON batches
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS eval_cases;
+DROP TABLE IF EXISTS eval_runs;
+DROP TABLE IF EXISTS dataset_cases;
+DROP TABLE IF EXISTS datasets;
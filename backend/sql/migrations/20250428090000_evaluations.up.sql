@@ -0,0 +1,54 @@
+CREATE TABLE IF NOT EXISTS datasets (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS dataset_cases (
+    dataset_id UUID NOT NULL REFERENCES datasets(id) ON DELETE CASCADE,
+    idx INTEGER NOT NULL,
+    inputs JSONB NOT NULL DEFAULT '{}',
+    expected JSONB,
+    PRIMARY KEY (dataset_id, idx)
+);
+
+CREATE TABLE IF NOT EXISTS eval_runs (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id CHAR(22) NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    dataset_id UUID REFERENCES datasets(id) ON DELETE SET NULL,
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    revision VARCHAR(64) NOT NULL,
+    scorers JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(20) NOT NULL,
+    metrics JSONB,
+    scoring_until TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- cases snapshot the expected outputs of the dataset, inputs and results are the ones of the batch items
+CREATE TABLE IF NOT EXISTS eval_cases (
+    eval_run_id UUID NOT NULL REFERENCES eval_runs(id) ON DELETE CASCADE,
+    idx INTEGER NOT NULL,
+    expected JSONB,
+    scores JSONB NOT NULL DEFAULT '{}',
+    passed BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (eval_run_id, idx)
+);
+
+CREATE INDEX idx_eval_runs_workflow_id ON eval_runs(project_id, workflow_id, created_at DESC);
+CREATE INDEX idx_eval_runs_running ON eval_runs(scoring_until) WHERE status = 'running';
+
+CREATE TRIGGER update_datasets_timestamp
+BEFORE UPDATE ON datasets
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
+
+CREATE TRIGGER update_eval_runs_timestamp
+BEFORE UPDATE ON eval_runs
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS workflow_traffic_splits (
+    workflow_id CHAR(22) PRIMARY KEY REFERENCES workflows(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    variants JSONB NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- executions record the variant chosen for each trigger, workflow_id is the
+-- triggered workflow and variant_workflow_id the one whose definition ran.
+CREATE TABLE IF NOT EXISTS workflow_executions (
+    trigger_id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id CHAR(22) NOT NULL,
+    variant_workflow_id CHAR(22) NOT NULL,
+    revision VARCHAR(64) NOT NULL,
+    session_id UUID NOT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT 'running',
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_workflow_executions_workflow_id ON workflow_executions(project_id, workflow_id, started_at);
+
+CREATE TRIGGER update_workflow_traffic_splits_timestamp
+BEFORE UPDATE ON workflow_traffic_splits
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
+
+CREATE TRIGGER update_workflow_executions_timestamp
+BEFORE UPDATE ON workflow_executions
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,23 @@
+-- token_usage holds one row per LLM call reported by a NODE_COMPLETED event,
+-- the sequence of the stored event makes redeliveries idempotent.
+CREATE TABLE IF NOT EXISTS token_usage (
+    trigger_id UUID NOT NULL,
+    sequence BIGINT NOT NULL,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id CHAR(22) NOT NULL,
+    variant_workflow_id CHAR(22) NOT NULL,
+    node_id VARCHAR(255) NOT NULL,
+    node_type VARCHAR(255) NOT NULL DEFAULT '',
+    credential_id UUID,
+    model VARCHAR(255) NOT NULL DEFAULT '',
+    input_tokens BIGINT NOT NULL DEFAULT 0,
+    output_tokens BIGINT NOT NULL DEFAULT 0,
+    cost_usd DOUBLE PRECISION NOT NULL DEFAULT 0,
+    priced BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (trigger_id, sequence)
+);
+
+CREATE INDEX idx_token_usage_project_id ON token_usage(project_id, created_at);
+CREATE INDEX idx_token_usage_workflow_id ON token_usage(project_id, workflow_id, created_at);
+CREATE INDEX idx_token_usage_credential_id ON token_usage(project_id, credential_id, created_at);
@@ -0,0 +1,24 @@
+-- budgets cap the cost of the token usage of a project, workflow or credential
+-- over a daily or monthly period. alerted_threshold is the highest threshold
+-- already alerted in alerted_period, the start of the period it was alerted in.
+CREATE TABLE IF NOT EXISTS budgets (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    scope VARCHAR(32) NOT NULL,
+    workflow_id CHAR(22),
+    credential_id UUID,
+    period VARCHAR(32) NOT NULL,
+    limit_usd DOUBLE PRECISION NOT NULL,
+    thresholds INT[] NOT NULL DEFAULT '{}',
+    hard BOOLEAN NOT NULL DEFAULT FALSE,
+    alerted_threshold INT NOT NULL DEFAULT 0,
+    alerted_period TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_budgets_project_id ON budgets(project_id);
+
+CREATE TRIGGER update_budgets_timestamp
+BEFORE UPDATE ON budgets
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
@@ -0,0 +1,18 @@
+-- rate_limits configure the trigger rate limits of a project, rows with a
+-- workflow_id override the project row for the triggers of that workflow.
+-- A limit of 0 leaves the requests unlimited on that key.
+CREATE TABLE IF NOT EXISTS rate_limits (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow_id VARCHAR(22) NOT NULL DEFAULT '',
+    requests_per_minute INT NOT NULL DEFAULT 0,
+    api_key_requests_per_minute INT NOT NULL DEFAULT 0,
+    end_user_requests_per_minute INT NOT NULL DEFAULT 0,
+    burst INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, workflow_id)
+);
+
+CREATE TRIGGER update_rate_limits_timestamp
+BEFORE UPDATE ON rate_limits
+FOR EACH ROW EXECUTE FUNCTION update_timestamp();
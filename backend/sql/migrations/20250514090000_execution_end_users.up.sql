@@ -0,0 +1,3 @@
+-- end_user_id is the end-user authenticated by the auth provider of the
+-- project when the execution was triggered, empty for anonymous triggers.
+ALTER TABLE workflow_executions ADD COLUMN IF NOT EXISTS end_user_id TEXT NOT NULL DEFAULT '';
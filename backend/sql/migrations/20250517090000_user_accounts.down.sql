@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS password_resets;
+DROP TABLE IF EXISTS user_invitations;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deactivated_at,
+    DROP COLUMN IF EXISTS must_change_password,
+    DROP COLUMN IF EXISTS is_admin;
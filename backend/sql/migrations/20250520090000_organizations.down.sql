@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS project_members;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
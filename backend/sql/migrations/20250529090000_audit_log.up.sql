@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY,
+    -- kept without a foreign key so the events outlive their project
+    project_id UUID,
+    actor_type VARCHAR(32) NOT NULL,
+    actor_id UUID,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    action VARCHAR(64) NOT NULL,
+    resource VARCHAR(64) NOT NULL,
+    resource_id TEXT NOT NULL DEFAULT '',
+    before JSONB,
+    after JSONB,
+    details JSONB,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_project_id_occurred_at ON audit_events(project_id, occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_account_occurred_at ON audit_events(occurred_at DESC, id DESC) WHERE project_id IS NULL;
+
+-- the audit log is append-only
+CREATE OR REPLACE FUNCTION reject_audit_event_change()
+RETURNS TRIGGER AS $$
+BEGIN
+   RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
+
+CREATE TRIGGER audit_events_no_truncate
+BEFORE TRUNCATE ON audit_events
+FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_event_change();
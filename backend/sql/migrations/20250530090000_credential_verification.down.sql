@@ -0,0 +1,4 @@
+ALTER TABLE credentials
+    DROP COLUMN IF EXISTS last_verified_at,
+    DROP COLUMN IF EXISTS verification_error,
+    DROP COLUMN IF EXISTS verification_status;
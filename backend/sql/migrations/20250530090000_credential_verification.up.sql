@@ -0,0 +1,4 @@
+ALTER TABLE credentials
+    ADD COLUMN IF NOT EXISTS verification_status VARCHAR(20) NOT NULL DEFAULT 'unverified',
+    ADD COLUMN IF NOT EXISTS verification_error TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS last_verified_at TIMESTAMP WITH TIME ZONE;
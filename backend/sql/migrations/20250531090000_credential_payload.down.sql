@@ -0,0 +1,3 @@
+ALTER TABLE credentials
+    DROP COLUMN IF EXISTS payload_preview,
+    DROP COLUMN IF EXISTS payload_encrypted;
@@ -0,0 +1,5 @@
+-- credentials without a payload hold an API key only, their payload is
+-- written on their next update
+ALTER TABLE credentials
+    ADD COLUMN IF NOT EXISTS payload_encrypted TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS payload_preview JSONB NOT NULL DEFAULT '{}';
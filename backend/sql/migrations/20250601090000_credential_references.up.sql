@@ -0,0 +1,26 @@
+-- credential_references indexes the workflow nodes by the credential they
+-- use. The credentials are not referenced, their removal can be forced.
+CREATE TABLE IF NOT EXISTS credential_references (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    credential_id UUID NOT NULL,
+    workflow_id CHAR(22) NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    node_id VARCHAR(255) NOT NULL,
+    node_type VARCHAR(100) NOT NULL,
+    PRIMARY KEY (workflow_id, node_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_credential_references_credential
+    ON credential_references(project_id, credential_id);
+
+INSERT INTO credential_references (project_id, credential_id, workflow_id, node_id, node_type)
+SELECT w.project_id, (n->'data'->>'credentialId')::UUID, w.id, n->>'id', COALESCE(n->>'type', '')
+FROM workflows w,
+    jsonb_array_elements(
+        CASE WHEN jsonb_typeof(w.builder_flow->'nodes') = 'array'
+            THEN w.builder_flow->'nodes'
+            ELSE '[]'::JSONB
+        END
+    ) n
+WHERE n->>'id' IS NOT NULL
+    AND n->'data'->>'credentialId' ~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_batch_items_running;
+
+ALTER TABLE batch_items DROP COLUMN IF EXISTS queued_at;
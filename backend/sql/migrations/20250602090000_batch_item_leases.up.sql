@@ -0,0 +1,7 @@
+-- queued_at is set once the workflow of a claimed item is queued, the items
+-- claimed but never queued are claimed again, the ones queued but never
+-- finished are failed
+ALTER TABLE batch_items
+    ADD COLUMN IF NOT EXISTS queued_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_batch_items_running ON batch_items(batch_id, started_at) WHERE status = 'running';
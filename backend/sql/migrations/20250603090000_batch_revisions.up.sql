@@ -0,0 +1,3 @@
+-- revision of the workflow the batch items are pinned to
+ALTER TABLE batches
+    ADD COLUMN IF NOT EXISTS revision VARCHAR(64) NOT NULL DEFAULT '';
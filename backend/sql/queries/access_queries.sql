@@ -0,0 +1,74 @@
+-- name: createOrganization :exec
+INSERT INTO organizations (id, name)
+VALUES ($1, $2);
+
+-- name: organizationById :one
+SELECT *
+FROM organizations
+WHERE id = $1;
+
+-- name: organizationMembers :many
+SELECT *
+FROM organization_members
+WHERE organization_id = $1;
+
+-- name: saveOrganizationMember :exec
+INSERT INTO organization_members (organization_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (organization_id, user_id) DO UPDATE
+SET role = EXCLUDED.role;
+
+-- name: deleteOrganizationMember :exec
+DELETE FROM organization_members
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: organizationsByMember :many
+SELECT o.id, o.name, m.role, o.created_at, o.updated_at
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.created_at;
+
+-- name: organizationMemberUsers :many
+SELECT m.user_id, u.email, u.name, m.role, m.created_at
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = $1
+ORDER BY m.created_at;
+
+-- name: saveProjectMember :exec
+INSERT INTO project_members (project_id, user_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (project_id, user_id) DO UPDATE
+SET role = EXCLUDED.role;
+
+-- name: deleteProjectMember :execrows
+DELETE FROM project_members
+WHERE project_id = $1 AND user_id = $2;
+
+-- name: projectMemberUsers :many
+SELECT m.user_id, u.email, u.name, m.role, m.created_at
+FROM project_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.project_id = $1
+ORDER BY m.created_at;
+
+-- name: projectRoles :many
+SELECT role::text
+FROM project_members
+WHERE project_members.project_id = sqlc.arg(project_id) AND project_members.user_id::text = sqlc.arg(user_id)::text
+UNION ALL
+SELECT organization_members.role::text
+FROM projects
+JOIN organization_members ON organization_members.organization_id = projects.organization_id
+WHERE projects.id = sqlc.arg(project_id) AND organization_members.user_id::text = sqlc.arg(user_id)::text
+UNION ALL
+SELECT 'owner'::text
+FROM projects
+WHERE projects.id = sqlc.arg(project_id) AND projects.user_id = sqlc.arg(user_id)::text;
+
+-- name: projectHasWorkflow :one
+SELECT EXISTS (
+    SELECT 1 FROM workflows
+    WHERE project_id = $1 AND id = $2
+);
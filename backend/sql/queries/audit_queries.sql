@@ -0,0 +1,18 @@
+-- name: auditEvents :many
+SELECT id, project_id, actor_type, actor_id, ip_address, action, resource, resource_id, before, after, details, occurred_at
+FROM audit_events
+WHERE (project_id = sqlc.narg('project_id') OR (sqlc.narg('project_id')::uuid IS NULL AND project_id IS NULL))
+    AND (sqlc.narg('action')::text IS NULL OR action = sqlc.narg('action'))
+    AND (sqlc.narg('resource')::text IS NULL OR resource = sqlc.narg('resource'))
+    AND (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id'))
+    AND (sqlc.narg('from_at')::timestamptz IS NULL OR occurred_at >= sqlc.narg('from_at'))
+    AND (sqlc.narg('to_at')::timestamptz IS NULL OR occurred_at < sqlc.narg('to_at'))
+    AND (sqlc.narg('before_id')::uuid IS NULL OR (occurred_at, id) < (
+        SELECT e.occurred_at, e.id FROM audit_events e WHERE e.id = sqlc.narg('before_id')
+    ))
+ORDER BY occurred_at DESC, id DESC
+LIMIT sqlc.arg('row_limit');
+
+-- name: insertAuditEvent :exec
+INSERT INTO audit_events (id, project_id, actor_type, actor_id, ip_address, action, resource, resource_id, before, after, details, occurred_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
@@ -0,0 +1,94 @@
+-- name: storeBatch :exec
+INSERT INTO batches (id, project_id, workflow_id, revision, status, concurrency, total)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: storeBatchItems :exec
+INSERT INTO batch_items (batch_id, idx, trigger_id, inputs)
+SELECT $1, unnest(@indexes::int[]), unnest(@trigger_ids::uuid[]), unnest(@inputs::jsonb[]);
+
+-- name: batchById :one
+SELECT *
+FROM batches
+WHERE id = $1;
+
+-- name: batchesByWorkflowId :many
+SELECT *
+FROM batches
+WHERE project_id = $1 AND workflow_id = $2
+ORDER BY created_at DESC;
+
+-- name: runningBatchIds :many
+SELECT id
+FROM batches
+WHERE status = 'running'
+ORDER BY created_at;
+
+-- name: lockRunningBatch :one
+SELECT *
+FROM batches
+WHERE id = $1 AND status = 'running'
+FOR UPDATE SKIP LOCKED;
+
+-- name: countRunningBatchItems :one
+SELECT COUNT(*)
+FROM batch_items
+WHERE batch_id = $1 AND status = 'running';
+
+-- name: claimBatchItems :many
+UPDATE batch_items
+SET status = 'running',
+    started_at = NOW(),
+    queued_at = NULL
+WHERE batch_id = $1 AND idx IN (
+    SELECT idx
+    FROM batch_items
+    WHERE batch_id = $1 AND status = 'pending'
+    ORDER BY idx
+    LIMIT $2
+)
+RETURNING *;
+
+-- name: markBatchItemQueued :exec
+UPDATE batch_items
+SET queued_at = NOW()
+WHERE trigger_id = $1 AND status = 'running';
+
+-- name: requeueBatchItems :execrows
+UPDATE batch_items
+SET status = 'pending',
+    started_at = NULL
+WHERE batch_id = $1 AND status = 'running' AND queued_at IS NULL AND started_at < @claimed_before;
+
+-- name: timeoutBatchItems :execrows
+UPDATE batch_items
+SET status = 'failed',
+    error = @error,
+    finished_at = NOW()
+WHERE batch_id = $1 AND status = 'running' AND queued_at < @queued_before;
+
+-- name: batchItemByTriggerId :one
+SELECT *
+FROM batch_items
+WHERE trigger_id = $1;
+
+-- name: finishBatchItem :execrows
+UPDATE batch_items
+SET status = $2,
+    result = $3,
+    error = $4,
+    finished_at = $5
+WHERE trigger_id = $1 AND status = 'running';
+
+-- name: countBatchItem :exec
+UPDATE batches
+SET succeeded = succeeded + @succeeded::int,
+    failed = failed + @failed::int,
+    status = CASE WHEN succeeded + failed + @succeeded::int + @failed::int >= total THEN 'completed' ELSE status END,
+    completed_at = CASE WHEN succeeded + failed + @succeeded::int + @failed::int >= total THEN NOW() ELSE completed_at END
+WHERE id = @id;
+
+-- name: batchItemsByBatchId :many
+SELECT *
+FROM batch_items
+WHERE batch_id = $1
+ORDER BY idx;
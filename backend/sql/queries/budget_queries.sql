@@ -0,0 +1,48 @@
+-- name: budgetById :one
+SELECT id, project_id, scope, workflow_id, credential_id, period, limit_usd, thresholds, hard, alerted_threshold, alerted_period, created_at, updated_at
+FROM budgets
+WHERE id = $1;
+
+-- name: budgetSpends :many
+SELECT s.budget_id::uuid AS budget_id, COALESCE(SUM(u.cost_usd), 0)::float8 AS spent_usd
+FROM unnest(@budget_ids::uuid[], @from_ats::timestamptz[]) AS s(budget_id, from_at)
+JOIN budgets b ON b.id = s.budget_id
+LEFT JOIN token_usage u ON u.project_id = b.project_id
+    AND u.created_at >= s.from_at
+    AND (b.workflow_id IS NULL OR u.workflow_id = b.workflow_id OR u.variant_workflow_id = b.workflow_id)
+    AND (b.credential_id IS NULL OR u.credential_id = b.credential_id)
+GROUP BY s.budget_id;
+
+-- name: budgetsByProjectId :many
+SELECT id, project_id, scope, workflow_id, credential_id, period, limit_usd, thresholds, hard, alerted_threshold, alerted_period, created_at, updated_at
+FROM budgets
+WHERE project_id = $1
+ORDER BY created_at;
+
+-- name: deleteBudget :exec
+DELETE FROM budgets
+WHERE id = $1;
+
+-- name: markBudgetAlerted :execrows
+UPDATE budgets
+SET alerted_threshold = $2,
+    alerted_period = $3
+WHERE id = $1 AND (alerted_period IS DISTINCT FROM $3 OR alerted_threshold < $2);
+
+-- name: storeBudget :exec
+INSERT INTO budgets (id, project_id, scope, workflow_id, credential_id, period, limit_usd, thresholds, hard)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: storeBudgetAlertDelivery :exec
+INSERT INTO webhook_deliveries (id, webhook_id, event_type, trigger_id, payload, status, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: updateBudget :exec
+UPDATE budgets
+SET period = $2,
+    limit_usd = $3,
+    thresholds = $4,
+    hard = $5,
+    alerted_threshold = $6,
+    alerted_period = $7
+WHERE id = $1;
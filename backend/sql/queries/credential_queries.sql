@@ -1,16 +1,28 @@
 -- name: storeCredential :exec
-INSERT INTO credentials (id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated)
-VALUES ($1, $2, $3, $4, $5, $6);
+INSERT INTO credentials (
+    id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated,
+    payload_encrypted, payload_preview
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
 -- name: upsertCredential :exec
-INSERT INTO credentials (id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO credentials (
+    id, project_id, name, provider_type, api_key_encrypted, api_key_obfuscated,
+    verification_status, verification_error, last_verified_at,
+    payload_encrypted, payload_preview
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT (id) 
 DO UPDATE SET
     name = EXCLUDED.name,
     provider_type = EXCLUDED.provider_type,
     api_key_encrypted = EXCLUDED.api_key_encrypted,
     api_key_obfuscated = EXCLUDED.api_key_obfuscated,
+    verification_status = EXCLUDED.verification_status,
+    verification_error = EXCLUDED.verification_error,
+    last_verified_at = EXCLUDED.last_verified_at,
+    payload_encrypted = EXCLUDED.payload_encrypted,
+    payload_preview = EXCLUDED.payload_preview,
     updated_at = NOW();
 
 -- name: deleteCredential :exec
@@ -25,4 +37,27 @@ WHERE project_id = $1;
 -- name: credentialById :one
 SELECT *
 FROM credentials
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: updateCredentialVerification :exec
+UPDATE credentials
+SET verification_status = $2,
+    verification_error = $3,
+    last_verified_at = $4
+WHERE id = $1 AND api_key_encrypted = $5;
+
+-- name: deleteWorkflowCredentialReferences :exec
+DELETE FROM credential_references
+WHERE workflow_id = $1;
+
+-- name: storeCredentialReference :exec
+INSERT INTO credential_references (project_id, credential_id, workflow_id, node_id, node_type)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (workflow_id, node_id) DO NOTHING;
+
+-- name: credentialReferences :many
+SELECT r.credential_id, r.workflow_id, w.name AS workflow_name, r.node_id, r.node_type
+FROM credential_references r
+JOIN workflows w ON w.id = r.workflow_id
+WHERE r.project_id = $1 AND r.credential_id = $2
+ORDER BY w.name, r.workflow_id, r.node_id;
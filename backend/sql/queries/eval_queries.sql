@@ -0,0 +1,102 @@
+-- name: storeDataset :exec
+INSERT INTO datasets (id, project_id, name, description)
+VALUES ($1, $2, $3, $4);
+
+-- name: updateDataset :exec
+UPDATE datasets
+SET name = $2,
+    description = $3
+WHERE id = $1;
+
+-- name: deleteDataset :exec
+DELETE FROM datasets
+WHERE id = $1;
+
+-- name: datasetById :one
+SELECT *
+FROM datasets
+WHERE id = $1;
+
+-- name: datasetsByProjectId :many
+SELECT *
+FROM datasets
+WHERE project_id = $1
+ORDER BY name;
+
+-- name: countDatasetCases :one
+SELECT COUNT(*)
+FROM dataset_cases
+WHERE dataset_id = $1;
+
+-- name: storeDatasetCases :exec
+INSERT INTO dataset_cases (dataset_id, idx, inputs, expected)
+SELECT @dataset_id, (SELECT COALESCE(MAX(idx) + 1, 0) FROM dataset_cases WHERE dataset_id = @dataset_id) + c.ord - 1, c.inputs, c.expected
+FROM unnest(@inputs::jsonb[], @expected::jsonb[]) WITH ORDINALITY AS c(inputs, expected, ord);
+
+-- name: datasetCasesByDatasetId :many
+SELECT *
+FROM dataset_cases
+WHERE dataset_id = $1
+ORDER BY idx;
+
+-- name: storeEvalRun :exec
+INSERT INTO eval_runs (id, project_id, workflow_id, dataset_id, batch_id, revision, scorers, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: storeEvalBatch :exec
+INSERT INTO batches (id, project_id, workflow_id, revision, status, concurrency, total)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: storeEvalBatchItems :exec
+INSERT INTO batch_items (batch_id, idx, trigger_id, inputs)
+SELECT $1, unnest(@indexes::int[]), unnest(@trigger_ids::uuid[]), unnest(@inputs::jsonb[]);
+
+-- name: storeEvalCases :exec
+INSERT INTO eval_cases (eval_run_id, idx, expected)
+SELECT $1, unnest(@indexes::int[]), unnest(@expected::jsonb[]);
+
+-- name: evalRunById :one
+SELECT *
+FROM eval_runs
+WHERE id = $1;
+
+-- name: evalRunsByWorkflowId :many
+SELECT *
+FROM eval_runs
+WHERE project_id = $1 AND workflow_id = $2
+ORDER BY created_at DESC;
+
+-- name: claimScorableEvalRuns :many
+UPDATE eval_runs
+SET scoring_until = $1
+WHERE id IN (
+    SELECT r.id
+    FROM eval_runs r
+    JOIN batches b ON b.id = r.batch_id
+    WHERE r.status = 'running' AND b.status = 'completed' AND r.scoring_until <= NOW()
+    ORDER BY r.scoring_until
+    LIMIT $2
+    FOR UPDATE OF r SKIP LOCKED
+)
+RETURNING *;
+
+-- name: evalCasesByRunId :many
+SELECT c.idx, i.inputs, c.expected, i.status, i.result, i.error, i.started_at, i.finished_at, c.scores, c.passed
+FROM eval_cases c
+JOIN eval_runs r ON r.id = c.eval_run_id
+JOIN batch_items i ON i.batch_id = r.batch_id AND i.idx = c.idx
+WHERE c.eval_run_id = $1
+ORDER BY c.idx;
+
+-- name: scoreEvalCase :exec
+UPDATE eval_cases
+SET scores = $3,
+    passed = $4
+WHERE eval_run_id = $1 AND idx = $2;
+
+-- name: completeEvalRun :exec
+UPDATE eval_runs
+SET status = 'completed',
+    metrics = $2,
+    completed_at = NOW()
+WHERE id = $1;
@@ -1,6 +1,6 @@
 -- name: storeProject :exec
-INSERT INTO projects (id, user_id, name)
-VALUES ($1, $2, $3);
+INSERT INTO projects (id, user_id, name, organization_id)
+VALUES ($1, $2, $3, $4);
 
 -- name: updateProject :exec
 UPDATE projects
@@ -16,10 +16,13 @@ SELECT *
 FROM projects
 WHERE id = $1;
 
--- name: projectsByUserId :many
+-- name: projectsByMember :many
 SELECT *
 FROM projects
-WHERE user_id = $1;
+WHERE user_id = sqlc.arg(user_id)::text
+   OR id IN (SELECT project_id FROM project_members WHERE project_members.user_id::text = sqlc.arg(user_id)::text)
+   OR organization_id IN (SELECT organization_id FROM organization_members WHERE organization_members.user_id::text = sqlc.arg(user_id)::text)
+ORDER BY created_at;
 
 -- name: deleteProject :exec
 DELETE FROM projects
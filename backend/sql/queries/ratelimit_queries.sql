@@ -0,0 +1,25 @@
+-- name: deleteRateLimit :execrows
+DELETE FROM rate_limits
+WHERE project_id = $1 AND workflow_id = $2;
+
+-- name: rateLimitByWorkflowId :one
+SELECT project_id, workflow_id, requests_per_minute, api_key_requests_per_minute, end_user_requests_per_minute, burst, created_at, updated_at
+FROM rate_limits
+WHERE project_id = $1 AND workflow_id IN ($2, '')
+ORDER BY workflow_id DESC
+LIMIT 1;
+
+-- name: rateLimitsByProjectId :many
+SELECT project_id, workflow_id, requests_per_minute, api_key_requests_per_minute, end_user_requests_per_minute, burst, created_at, updated_at
+FROM rate_limits
+WHERE project_id = $1
+ORDER BY workflow_id;
+
+-- name: upsertRateLimit :exec
+INSERT INTO rate_limits (project_id, workflow_id, requests_per_minute, api_key_requests_per_minute, end_user_requests_per_minute, burst)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (project_id, workflow_id) DO UPDATE
+SET requests_per_minute = EXCLUDED.requests_per_minute,
+    api_key_requests_per_minute = EXCLUDED.api_key_requests_per_minute,
+    end_user_requests_per_minute = EXCLUDED.end_user_requests_per_minute,
+    burst = EXCLUDED.burst;
@@ -0,0 +1,56 @@
+-- name: upsertTrafficSplit :exec
+INSERT INTO workflow_traffic_splits (workflow_id, project_id, variants, enabled)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (workflow_id) DO UPDATE
+SET variants = EXCLUDED.variants,
+    enabled = EXCLUDED.enabled;
+
+-- name: deleteTrafficSplit :exec
+DELETE FROM workflow_traffic_splits
+WHERE workflow_id = $1;
+
+-- name: trafficSplitByWorkflowId :one
+SELECT *
+FROM workflow_traffic_splits
+WHERE project_id = $1 AND workflow_id = $2;
+
+-- name: storeExecution :exec
+INSERT INTO workflow_executions (trigger_id, project_id, workflow_id, variant_workflow_id, revision, session_id, end_user_id, status, started_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: executionByTriggerId :one
+SELECT *
+FROM workflow_executions
+WHERE trigger_id = $1;
+
+-- name: finishExecution :execrows
+UPDATE workflow_executions
+SET status = $2,
+    error = $3,
+    finished_at = $4
+WHERE trigger_id = $1 AND status = 'running';
+
+-- name: executionsByTriggerIds :many
+SELECT *
+FROM workflow_executions
+WHERE trigger_id = ANY(sqlc.arg(trigger_ids)::uuid[]);
+
+-- name: variantStatsByWorkflowId :many
+SELECT e.variant_workflow_id,
+    e.revision,
+    COUNT(*) AS executions,
+    COUNT(*) FILTER (WHERE e.status = 'succeeded') AS succeeded,
+    COUNT(*) FILTER (WHERE e.status = 'failed') AS failed,
+    COALESCE(AVG(EXTRACT(EPOCH FROM e.finished_at - e.started_at) * 1000), 0)::float8 AS avg_latency_ms,
+    COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM e.finished_at - e.started_at) * 1000), 0)::float8 AS p95_latency_ms,
+    MIN(e.started_at)::timestamptz AS first_started_at,
+    MAX(e.started_at)::timestamptz AS last_started_at,
+    COUNT(c.idx) AS eval_cases,
+    COUNT(c.idx) FILTER (WHERE c.passed) AS eval_passed
+FROM workflow_executions e
+LEFT JOIN batch_items i ON i.trigger_id = e.trigger_id
+LEFT JOIN eval_runs r ON r.batch_id = i.batch_id AND r.status = 'completed'
+LEFT JOIN eval_cases c ON c.eval_run_id = r.id AND c.idx = i.idx
+WHERE e.project_id = $1 AND e.workflow_id = $2 AND e.started_at >= $3
+GROUP BY e.variant_workflow_id, e.revision
+ORDER BY last_started_at DESC;
@@ -0,0 +1,56 @@
+-- name: storeSchedule :exec
+INSERT INTO workflow_schedules (id, project_id, workflow_id, cron_expression, timezone, inputs, enabled, next_fire_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: updateSchedule :exec
+UPDATE workflow_schedules
+SET cron_expression = $2,
+    timezone = $3,
+    inputs = $4,
+    enabled = $5,
+    next_fire_at = $6,
+    last_fired_at = $7,
+    last_trigger_id = $8,
+    last_error = $9
+WHERE id = $1;
+
+-- name: recordScheduleFire :execrows
+UPDATE workflow_schedules
+SET next_fire_at = $2,
+    last_fired_at = $3,
+    last_trigger_id = $4,
+    last_error = ''
+WHERE id = $1 AND enabled AND next_fire_at = $5;
+
+-- name: recordScheduleError :exec
+UPDATE workflow_schedules
+SET last_error = $2
+WHERE id = $1;
+
+-- name: disableSchedule :exec
+UPDATE workflow_schedules
+SET enabled = false,
+    last_error = $2
+WHERE id = $1 AND next_fire_at = $3;
+
+-- name: deleteSchedule :exec
+DELETE FROM workflow_schedules
+WHERE id = $1;
+
+-- name: scheduleById :one
+SELECT *
+FROM workflow_schedules
+WHERE id = $1;
+
+-- name: schedulesByWorkflowId :many
+SELECT *
+FROM workflow_schedules
+WHERE project_id = $1 AND workflow_id = $2
+ORDER BY created_at;
+
+-- name: dueSchedules :many
+SELECT *
+FROM workflow_schedules
+WHERE enabled AND next_fire_at <= $1
+ORDER BY next_fire_at
+LIMIT $2;
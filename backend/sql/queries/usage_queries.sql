@@ -0,0 +1,81 @@
+-- name: storeTokenUsage :exec
+INSERT INTO token_usage (trigger_id, sequence, project_id, workflow_id, variant_workflow_id, node_id, node_type, credential_id, model, input_tokens, output_tokens, cost_usd, priced)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT (trigger_id, sequence) DO NOTHING;
+
+-- name: usageByCredential :many
+SELECT credential_id,
+    COUNT(DISTINCT trigger_id) AS executions,
+    COUNT(*) AS calls,
+    COALESCE(SUM(input_tokens), 0)::bigint AS input_tokens,
+    COALESCE(SUM(output_tokens), 0)::bigint AS output_tokens,
+    COALESCE(SUM(cost_usd), 0)::float8 AS cost_usd,
+    COUNT(*) FILTER (WHERE NOT priced) AS unpriced
+FROM token_usage
+WHERE project_id = @project_id AND created_at >= @from_at AND created_at < @to_at
+GROUP BY 1
+ORDER BY cost_usd DESC;
+
+-- name: usageByDay :many
+SELECT date_trunc('day', created_at)::timestamptz AS day,
+    COUNT(DISTINCT trigger_id) AS executions,
+    COUNT(*) AS calls,
+    COALESCE(SUM(input_tokens), 0)::bigint AS input_tokens,
+    COALESCE(SUM(output_tokens), 0)::bigint AS output_tokens,
+    COALESCE(SUM(cost_usd), 0)::float8 AS cost_usd,
+    COUNT(*) FILTER (WHERE NOT priced) AS unpriced
+FROM token_usage
+WHERE project_id = @project_id AND created_at >= @from_at AND created_at < @to_at
+GROUP BY 1
+ORDER BY 1;
+
+-- name: usageByModel :many
+SELECT model,
+    COUNT(DISTINCT trigger_id) AS executions,
+    COUNT(*) AS calls,
+    COALESCE(SUM(input_tokens), 0)::bigint AS input_tokens,
+    COALESCE(SUM(output_tokens), 0)::bigint AS output_tokens,
+    COALESCE(SUM(cost_usd), 0)::float8 AS cost_usd,
+    COUNT(*) FILTER (WHERE NOT priced) AS unpriced
+FROM token_usage
+WHERE project_id = @project_id AND created_at >= @from_at AND created_at < @to_at
+GROUP BY 1
+ORDER BY cost_usd DESC;
+
+-- name: usageByTriggerId :many
+SELECT node_id,
+    node_type,
+    model,
+    credential_id,
+    COUNT(*) AS calls,
+    COALESCE(SUM(input_tokens), 0)::bigint AS input_tokens,
+    COALESCE(SUM(output_tokens), 0)::bigint AS output_tokens,
+    COALESCE(SUM(cost_usd), 0)::float8 AS cost_usd,
+    COUNT(*) FILTER (WHERE NOT priced) AS unpriced
+FROM token_usage
+WHERE project_id = $1 AND workflow_id = $2 AND trigger_id = $3
+GROUP BY node_id, node_type, model, credential_id
+ORDER BY node_id, model;
+
+-- name: usageByWorkflow :many
+SELECT workflow_id,
+    COUNT(DISTINCT trigger_id) AS executions,
+    COUNT(*) AS calls,
+    COALESCE(SUM(input_tokens), 0)::bigint AS input_tokens,
+    COALESCE(SUM(output_tokens), 0)::bigint AS output_tokens,
+    COALESCE(SUM(cost_usd), 0)::float8 AS cost_usd,
+    COUNT(*) FILTER (WHERE NOT priced) AS unpriced
+FROM token_usage
+WHERE project_id = @project_id AND created_at >= @from_at AND created_at < @to_at
+GROUP BY 1
+ORDER BY cost_usd DESC;
+
+-- name: usageTotals :one
+SELECT COUNT(DISTINCT trigger_id) AS executions,
+    COUNT(*) AS calls,
+    COALESCE(SUM(input_tokens), 0)::bigint AS input_tokens,
+    COALESCE(SUM(output_tokens), 0)::bigint AS output_tokens,
+    COALESCE(SUM(cost_usd), 0)::float8 AS cost_usd,
+    COUNT(*) FILTER (WHERE NOT priced) AS unpriced
+FROM token_usage
+WHERE project_id = @project_id AND created_at >= @from_at AND created_at < @to_at;
@@ -3,9 +3,11 @@ INSERT INTO users (
     id,
     email,
     name,
-    password_hash
+    password_hash,
+    is_admin,
+    must_change_password
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6
 ) RETURNING *;
 
 -- name: getUserByEmail :one
@@ -23,10 +25,134 @@ SELECT * FROM users
 WHERE id = $1
 LIMIT 1;
 
+-- name: listUsers :many
+SELECT * FROM users
+ORDER BY created_at;
+
 -- name: updateUser :exec
 UPDATE users
 SET
-    name = COALESCE(sqlc.narg(name), name),
-    email = COALESCE(sqlc.narg(email), email),
-    password_hash = COALESCE(sqlc.narg(password_hash), password_hash)
-WHERE id = $1;
\ No newline at end of file
+    name = $2,
+    email = $3,
+    password_hash = $4,
+    is_admin = $5,
+    must_change_password = $6,
+    deactivated_at = $7,
+    totp_secret = $8,
+    totp_enabled_at = $9,
+    totp_last_step = $10,
+    recovery_code_digests = $11
+WHERE id = $1;
+
+-- name: useTOTPStep :execrows
+UPDATE users
+SET totp_last_step = $2
+WHERE id = $1 AND totp_last_step < $2 AND deactivated_at IS NULL;
+
+-- name: useRecoveryCode :execrows
+UPDATE users
+SET recovery_code_digests = array_remove(recovery_code_digests, @digest::text)
+WHERE id = @id AND @digest::text = ANY(recovery_code_digests) AND deactivated_at IS NULL;
+
+-- name: createInvitation :exec
+INSERT INTO user_invitations (
+    id,
+    email,
+    name,
+    is_admin,
+    token_digest,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: invitationByTokenDigest :one
+SELECT * FROM user_invitations
+WHERE token_digest = $1
+LIMIT 1;
+
+-- name: pendingInvitations :many
+SELECT * FROM user_invitations
+WHERE accepted_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: acceptInvitation :execrows
+UPDATE user_invitations
+SET accepted_at = $2
+WHERE id = $1 AND accepted_at IS NULL;
+
+-- name: deleteInvitation :execrows
+DELETE FROM user_invitations
+WHERE id = $1 AND accepted_at IS NULL;
+
+-- name: createPasswordReset :exec
+INSERT INTO password_resets (
+    token_digest,
+    user_id,
+    expires_at
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: deletePendingPasswordResets :exec
+DELETE FROM password_resets
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: passwordResetByTokenDigest :one
+SELECT * FROM password_resets
+WHERE token_digest = $1
+LIMIT 1;
+
+-- name: usePasswordReset :execrows
+UPDATE password_resets
+SET used_at = $2
+WHERE token_digest = $1 AND used_at IS NULL;
+
+-- name: createSession :exec
+INSERT INTO user_sessions (
+    id,
+    user_id,
+    token_digest,
+    user_agent,
+    ip_address,
+    expires_at,
+    last_used_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: sessionById :one
+SELECT * FROM user_sessions
+WHERE id = $1
+LIMIT 1;
+
+-- name: sessionByTokenDigest :one
+SELECT * FROM user_sessions
+WHERE token_digest = $1 OR previous_token_digest = $1
+LIMIT 1;
+
+-- name: activeUserSessions :many
+SELECT * FROM user_sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_used_at DESC;
+
+-- name: rotateSession :execrows
+UPDATE user_sessions
+SET
+    token_digest = $2,
+    previous_token_digest = $3,
+    ip_address = $4,
+    expires_at = $5,
+    last_used_at = $6
+WHERE id = $1 AND token_digest = $3 AND revoked_at IS NULL;
+
+-- name: revokeSession :exec
+UPDATE user_sessions
+SET revoked_at = $2
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: revokeUserSessions :exec
+UPDATE user_sessions
+SET revoked_at = $2
+WHERE user_id = $1 AND revoked_at IS NULL;
@@ -0,0 +1,71 @@
+-- name: storeWebhook :exec
+INSERT INTO webhooks (id, project_id, url, event_types, workflow_ids, secret_encrypted, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: updateWebhook :exec
+UPDATE webhooks
+SET url = $2,
+    event_types = $3,
+    workflow_ids = $4,
+    enabled = $5
+WHERE id = $1;
+
+-- name: deleteWebhook :exec
+DELETE FROM webhooks
+WHERE id = $1;
+
+-- name: webhookById :one
+SELECT *
+FROM webhooks
+WHERE id = $1;
+
+-- name: webhooksByProjectId :many
+SELECT *
+FROM webhooks
+WHERE project_id = $1
+ORDER BY created_at;
+
+-- name: enabledWebhooksByProjectId :many
+SELECT *
+FROM webhooks
+WHERE project_id = $1 AND enabled;
+
+-- name: storeDelivery :exec
+INSERT INTO webhook_deliveries (id, webhook_id, event_type, trigger_id, payload, status, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: updateDelivery :exec
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    response_status = $5,
+    response_body = $6,
+    error = $7,
+    delivered_at = $8
+WHERE id = $1;
+
+-- name: deliveryById :one
+SELECT *
+FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: deliveriesByWebhookId :many
+SELECT *
+FROM webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: claimDueDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'pending' AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
@@ -0,0 +1,30 @@
+-- name: storeWebhookTrigger :exec
+INSERT INTO webhook_triggers (id, project_id, workflow_id, name, verification, signature_header, secret_encrypted, input_mapping, respond_sync, sync_timeout_ms, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: updateWebhookTrigger :exec
+UPDATE webhook_triggers
+SET name = $2,
+    verification = $3,
+    signature_header = $4,
+    secret_encrypted = $5,
+    input_mapping = $6,
+    respond_sync = $7,
+    sync_timeout_ms = $8,
+    enabled = $9
+WHERE id = $1;
+
+-- name: deleteWebhookTrigger :exec
+DELETE FROM webhook_triggers
+WHERE id = $1;
+
+-- name: webhookTriggerById :one
+SELECT *
+FROM webhook_triggers
+WHERE id = $1;
+
+-- name: webhookTriggersByWorkflowId :many
+SELECT *
+FROM webhook_triggers
+WHERE project_id = $1 AND workflow_id = $2
+ORDER BY created_at;
@@ -16,6 +16,7 @@ import { CryptoService } from "../../services/secret/crypto-service";
 import { ToolConfig } from "../../tools";
 import { ToolRegistry } from "../../tools/tool-registry";
 import { logger } from "../../utils/logger";
+import { LLMUtils } from "../llm/llm-utils";
 import {
   INode,
   NodeDefinition,
@@ -159,6 +160,16 @@ export class Agent implements INode {
         let finalResponse = "";
 
         for await (const event of stream) {
+          if (event.event === "on_chat_model_end") {
+            const usage = LLMUtils.parseUsage(event.data.output);
+            if (usage) {
+              options.onUsage({
+                model: definition.config["model"],
+                ...usage,
+              });
+            }
+          }
+
           if (event.event === "on_chat_model_stream") {
             const content = event.data.chunk.text;
             if (typeof content === "string") {
@@ -0,0 +1,35 @@
+import { Result } from "typescript-result";
+import {
+  INode,
+  NodeDefinition,
+  NodeInput,
+  NodeOptions,
+  NodeOutput,
+  NodeType,
+} from "../types";
+
+const DEFAULT_TIMEOUT_SECONDS = 60 * 60;
+
+export class HumanInputNode implements INode {
+  type: NodeType;
+
+  constructor() {
+    this.type = "human-input";
+  }
+
+  async execute(
+    _nodeId: string,
+    definition: NodeDefinition,
+    inputs: NodeInput,
+    options: NodeOptions,
+  ): Promise<Result<NodeOutput, Error>> {
+    // the workflow waits for a client to answer the prompt,
+    // the inputs of the answer are the outputs of the node
+    return options.requestHumanInput({
+      prompt: definition.config?.prompt ?? "",
+      inputs,
+      timeoutSeconds:
+        definition.config?.timeoutSeconds ?? DEFAULT_TIMEOUT_SECONDS,
+    });
+  }
+}
@@ -90,6 +90,10 @@ export class GeminiProvider implements INode {
     const outputField = resolvedOutputs.result_key;
 
     for await (const data of generateResult) {
+      if (data.usage) {
+        options.onUsage({ model: config.model, ...data.usage });
+      }
+
       const chunkContent = LLMUtils.formatChunkContent(data);
 
       if (chunkContent) {
@@ -16,8 +16,13 @@ import {
   ProviderAPIError,
 } from "./llm.errors";
 
+export interface TokenCount {
+  inputTokens: number;
+  outputTokens: number;
+}
+
 export type GenerateResult = Result<
-  AsyncIterable<{ content: string }>,
+  AsyncIterable<{ content: string; usage?: TokenCount }>,
   LLMExecutionError
 >;
 
@@ -112,7 +117,10 @@ export class LLMUtils {
               "content" in chunk
             ) {
               const contentStr = String(chunk.content);
-              yield { content: contentStr };
+              yield {
+                content: contentStr,
+                usage: LLMUtils.parseUsage(chunk),
+              };
             }
           }
         },
@@ -133,10 +141,11 @@ export class LLMUtils {
     try {
       const response = await invokeMethod(model, messages);
       const responseContent = LLMUtils.parseModelResponse(response);
+      const usage = LLMUtils.parseUsage(response);
 
       return Result.ok({
         [Symbol.asyncIterator]: async function* () {
-          yield { content: responseContent };
+          yield { content: responseContent, usage };
         },
       });
     } catch (error) {
@@ -240,6 +249,22 @@ export class LLMUtils {
     }
   }
 
+  // parseUsage reads the usage metadata langchain attaches to chat messages,
+  // streamed chunks only carry the tokens counted since the previous one.
+  static parseUsage(message: any): TokenCount | undefined {
+    const usage = message?.usage_metadata;
+    if (typeof usage !== "object" || usage === null) {
+      return undefined;
+    }
+
+    const inputTokens = Number(usage.input_tokens) || 0;
+    const outputTokens = Number(usage.output_tokens) || 0;
+    if (inputTokens === 0 && outputTokens === 0) {
+      return undefined;
+    }
+    return { inputTokens, outputTokens };
+  }
+
   static formatChunkContent(data: { content: string | object }): string {
     return typeof data.content === "string"
       ? data.content
@@ -25,22 +25,37 @@ interface OllamaOptions extends LLMOptions {
 export class OllamaProvider implements INode {
   type: NodeType = "chat-ollama";
   private utils: LLMUtils;
+  private cryptoService: CryptoService;
 
   constructor() {
-    this.utils = new LLMUtils(new CryptoService());
+    this.cryptoService = new CryptoService();
+    this.utils = new LLMUtils(this.cryptoService);
   }
 
   private prepareOllamaOptions(
     config: LLMOptions,
     definition: NodeDefinition,
   ): Result<OllamaOptions, Error> {
-    if (!definition.config["baseUrl"]) {
+    let baseUrl = definition.config["baseUrl"];
+
+    // the base URL of the node defaults to the one of its credential
+    if (!baseUrl && definition.config["credentialPayload"]) {
+      const [payload, payloadError] = this.cryptoService
+        .decryptPayload(definition.config["credentialPayload"])
+        .toTuple();
+      if (payloadError) {
+        return Result.error(payloadError);
+      }
+      baseUrl = payload.baseUrl;
+    }
+
+    if (!baseUrl) {
       return Result.error(new Error("baseUrl is required"));
     }
 
     return Result.ok({
       ...config,
-      baseUrl: definition.config["baseUrl"],
+      baseUrl,
       temperature: definition.config["temperature"],
       maxTokens: definition.config["maxTokens"],
       outputMode: definition.config["outputMode"],
@@ -96,6 +111,10 @@ export class OllamaProvider implements INode {
     const outputField = resolvedOutputs.result_key;
 
     for await (const data of generateResult) {
+      if (data.usage) {
+        options.onUsage({ model: config.model, ...data.usage });
+      }
+
       const chunkContent = LLMUtils.formatChunkContent(data);
 
       if (chunkContent) {
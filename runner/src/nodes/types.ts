@@ -21,7 +21,8 @@ export type NodeType =
   | "entrypoint"
   | "result"
   | "code-executor"
-  | "ai-agent";
+  | "ai-agent"
+  | "human-input";
 
 export type NodeIOType = "text" | "image" | "any";
 
@@ -62,17 +63,39 @@ export type NodeEventType = Extract<
 // Type pour les événements de node sans workflowId et triggerId
 export type NodeEvent<T extends NodeEventType> = Omit<
   WorkflowEvent<T>,
-  "workflowId" | "triggerId"
+  "workflowId" | "projectId" | "triggerId"
 >;
 
+// Tokens consumed by a model call of a node.
+export interface NodeUsage {
+  model: string;
+  inputTokens: number;
+  outputTokens: number;
+}
+
+// HumanInputRequest is shown to the clients listening to the trigger,
+// the node waits for one of them to answer it.
+export interface HumanInputRequest {
+  prompt: string;
+  inputs: NodeInput;
+  timeoutSeconds: number;
+}
+
 export type NodeOptions = {
   sessionId: string;
+  // the usage reported by the node is summed and sent with NODE_COMPLETED
+  onUsage: (usage: NodeUsage) => void;
   onEvent: <T extends NodeEventType>(
     type: T,
     data: Omit<NodeEvent<T>, "type" | "sessionId" | "nodeType" | "nodeId"> & {
       nodeId?: string;
     },
   ) => Promise<void>;
+  // requestHumanInput emits HUMAN_INPUT_REQUESTED and resolves with the
+  // inputs of the answer, it fails once the timeout is reached.
+  requestHumanInput: (
+    request: HumanInputRequest,
+  ) => Promise<Result<NodeInput, Error>>;
 };
 
 export interface INode {
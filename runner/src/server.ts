@@ -8,8 +8,11 @@ import {
   WorkflowEventType,
 } from "./services/notifier";
 import {
+  IControlConsumer,
   IQueueConsumer,
+  RedisControlConsumer,
   RedisQueueConsumer,
+  WorkflowControlMessage,
   WorkflowMessage,
 } from "./services/queue";
 import { WorkflowExecutor } from "./services/workflow/workflow.executor";
@@ -18,6 +21,7 @@ import { logger } from "./utils/logger";
 
 export class RunnerServer {
   private readonly queueConsumer: IQueueConsumer;
+  private readonly controlConsumer: IControlConsumer;
   private readonly notifier: INotifier;
   private readonly nodeManager: NodeManager;
   private readonly contextService: IContextService;
@@ -27,6 +31,7 @@ export class RunnerServer {
     this.queueConsumer = new RedisQueueConsumer(config.redis, {
       maxParallelJobs: config.maxConcurrentJobs,
     });
+    this.controlConsumer = new RedisControlConsumer(config.redis);
     this.notifier = new RedisNotifier(config.redis);
     this.nodeManager = new NodeManager();
     this.contextService = new RedisContextService(config.redis);
@@ -39,9 +44,14 @@ export class RunnerServer {
   async start(): Promise<void> {
     try {
       await this.queueConsumer.initialize();
+      await this.controlConsumer.initialize();
       await this.notifier.initialize();
       logger.info("runner server started");
 
+      this.controlConsumer.consumeControlStream(
+        this.handleWorkflowControl.bind(this),
+      );
+
       this.queueConsumer.consumeWorkflowQueue(
         this.handleWorkflowExecution.bind(this),
       );
@@ -54,6 +64,7 @@ export class RunnerServer {
   async stop(): Promise<void> {
     this.executor.removeAllListeners();
     await this.queueConsumer.close();
+    await this.controlConsumer.close();
     await this.notifier.close();
     logger.info("runner server stopped");
   }
@@ -82,11 +93,47 @@ export class RunnerServer {
     }
   }
 
+  private async handleWorkflowControl(
+    message: WorkflowControlMessage,
+  ): Promise<void> {
+    switch (message.type) {
+      case "cancel":
+        if (
+          this.executor.cancel(
+            message.trigger_id,
+            message.workflow_id,
+            message.project_id,
+          )
+        ) {
+          logger.info(`cancelling trigger ${message.trigger_id}`);
+        }
+        break;
+      case "human_input":
+        if (
+          this.executor.answer(
+            message.trigger_id,
+            message.workflow_id,
+            message.project_id,
+            message.node_id ?? "",
+            message.inputs ?? {},
+          )
+        ) {
+          logger.info(
+            `answering node ${message.node_id} of trigger ${message.trigger_id}`,
+          );
+        }
+        break;
+      default:
+        logger.warn(`unknown control message ${JSON.stringify(message)}`);
+    }
+  }
+
   private setupEventListeners(): void {
     const allEvents = [
       WorkflowEvents.WORKFLOW_STARTED,
       WorkflowEvents.WORKFLOW_COMPLETED,
       WorkflowEvents.WORKFLOW_FAILED,
+      WorkflowEvents.WORKFLOW_CANCELLED,
       WorkflowEvents.NODE_STARTED,
       WorkflowEvents.NODE_COMPLETED,
       WorkflowEvents.NODE_FAILED,
@@ -96,6 +143,7 @@ export class RunnerServer {
       WorkflowEvents.TOOL_COMPLETED,
       WorkflowEvents.TOOL_FAILED,
       WorkflowEvents.AGENT_NOTIFICATION,
+      WorkflowEvents.HUMAN_INPUT_REQUESTED,
     ] as const;
 
     for (const eventType of allEvents) {
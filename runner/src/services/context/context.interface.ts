@@ -23,6 +23,7 @@ export interface NodeExecution {
 
 export interface ExecutionContext {
   workflowId: string;
+  projectId: string;
   sessionId: string;
   triggerId: string;
   workflowInputs: WorkflowInputs;
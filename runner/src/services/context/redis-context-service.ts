@@ -73,6 +73,7 @@ export class RedisContextService implements ContextService {
   ): ExecutionContext {
     return {
       workflowId,
+      projectId: options.projectId,
       sessionId: options.sessionId,
       triggerId: options.triggerId,
       workflowInputs: options.inputs,
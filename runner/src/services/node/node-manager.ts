@@ -1,6 +1,7 @@
 import { Result } from "typescript-result";
 import { Agent } from "../../nodes/agent/agent";
 import { EntrypointNode } from "../../nodes/base/entrypoint-node";
+import { HumanInputNode } from "../../nodes/base/human-input-node";
 import { ResultNode } from "../../nodes/base/result-node";
 import { CodeExecutorNode } from "../../nodes/code-executors/code-executor-node";
 import { AnthropicProvider } from "../../nodes/llm/anthropic-provider";
@@ -35,6 +36,7 @@ export class NodeManager {
     this.registerNode(new ResultNode());
     this.registerNode(new CodeExecutorNode());
     this.registerNode(new Agent());
+    this.registerNode(new HumanInputNode());
   }
 
   private registerNode(node: INode): void {
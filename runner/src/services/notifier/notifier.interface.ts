@@ -1,9 +1,15 @@
-import { NodeInput, NodeIOType, NodeOutput } from "../../nodes/types";
+import {
+  NodeInput,
+  NodeIOType,
+  NodeOutput,
+  NodeUsage,
+} from "../../nodes/types";
 
 export const WorkflowEvents = {
   WORKFLOW_STARTED: "WORKFLOW_STARTED",
   WORKFLOW_COMPLETED: "WORKFLOW_COMPLETED",
   WORKFLOW_FAILED: "WORKFLOW_FAILED",
+  WORKFLOW_CANCELLED: "WORKFLOW_CANCELLED",
 
   NODE_STARTED: "NODE_STARTED",
   NODE_COMPLETED: "NODE_COMPLETED",
@@ -16,6 +22,7 @@ export const WorkflowEvents = {
   NODE_RESULT: "NODE_RESULT",
   NODE_LOG: "NODE_LOG",
   AGENT_NOTIFICATION: "AGENT_NOTIFICATION",
+  HUMAN_INPUT_REQUESTED: "HUMAN_INPUT_REQUESTED",
 } as const;
 
 // Type helper pour extraire le type littéral des événements
@@ -25,6 +32,7 @@ export type WorkflowEventType =
 // Base commune pour tous les événements
 interface BaseEventData {
   workflowId: string;
+  projectId: string;
   sessionId: string;
   triggerId: string;
 }
@@ -45,12 +53,14 @@ export type EventPayloadMap = {
   [WorkflowEvents.WORKFLOW_FAILED]: {
     error: string;
   };
+  [WorkflowEvents.WORKFLOW_CANCELLED]: Record<string, never>;
   [WorkflowEvents.NODE_STARTED]: {
     inputs: NodeInput;
   };
   [WorkflowEvents.NODE_COMPLETED]: {
     inputs: NodeInput;
     output: NodeOutput;
+    usage?: NodeUsage;
   };
   [WorkflowEvents.NODE_FAILED]: {
     error: string;
@@ -84,6 +94,11 @@ export type EventPayloadMap = {
     ioType: NodeIOType;
     data: string;
   };
+  [WorkflowEvents.HUMAN_INPUT_REQUESTED]: {
+    prompt: string;
+    inputs: NodeInput;
+    timeoutSeconds: number;
+  };
 };
 
 // Type qui combine le type d'événement avec son payload correspondant
@@ -100,6 +115,7 @@ export type WorkflowEvent<T extends WorkflowEventType = WorkflowEventType> = {
       | typeof WorkflowEvents.NODE_RESULT
       | typeof WorkflowEvents.NODE_LOG
       | typeof WorkflowEvents.AGENT_NOTIFICATION
+      | typeof WorkflowEvents.HUMAN_INPUT_REQUESTED
     ? BaseNodeEventData & EventPayloadMap[T]
     : BaseEventData & EventPayloadMap[T]
   : never);
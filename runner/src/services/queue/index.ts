@@ -1,3 +1,8 @@
+export { RedisControlConsumer } from "./redis-control-consumer";
 export { RedisQueueConsumer } from "./redis-queue-consumer";
 export type { IQueueConsumer } from "./queuer.interface";
-export type { WorkflowMessage } from "./types";
\ No newline at end of file
+export type {
+  IControlConsumer,
+  WorkflowControlMessage,
+  WorkflowMessage,
+} from "./types";
@@ -0,0 +1,115 @@
+import Redis from "ioredis";
+import { dbRedis, RedisConfig } from "../../utils/config";
+import { logger } from "../../utils/logger";
+import { IControlConsumer, WorkflowControlMessage } from "./types";
+
+type RedisStreamResponse = [
+  streamName: string,
+  messages: [messageId: string, fields: string[]][],
+][];
+
+const BLOCK_TIMEOUT = 0; // 0 = infini
+
+// RedisControlConsumer reads the control messages of the running workflows.
+// Every runner reads the whole stream, without a consumer group, since only
+// the runner executing the trigger knows it.
+export class RedisControlConsumer implements IControlConsumer {
+  private redis: Redis;
+  private readonly CONTROL_TOPIC = "workflows:downstream:control";
+  private lastId = "$";
+  private isRunning = true;
+
+  constructor(config: RedisConfig) {
+    this.redis = this.initializeRedisClient(config);
+  }
+
+  private initializeRedisClient(config: RedisConfig): Redis {
+    const redisOptions = {
+      family: 0,
+      db: dbRedis.EXECUTIONS,
+      password: config.password,
+      retryStrategy: (times: number) => {
+        return Math.min(times * 100, 3000); // retry with an increasing delay
+      },
+      maxRetriesPerRequest: 3,
+    };
+    const redis = new Redis(config.url, redisOptions);
+
+    redis.on("error", (err) => {
+      logger.error(`redis error: ${err}`);
+    });
+
+    return redis;
+  }
+
+  async initialize(): Promise<void> {
+    try {
+      await this.redis.ping();
+      logger.info("redis control consumer initialized successfully");
+    } catch (err) {
+      logger.error(`failed to initialize redis control consumer: ${err}`);
+      throw err;
+    }
+  }
+
+  async consumeControlStream(
+    handler: (message: WorkflowControlMessage) => Promise<void>,
+  ): Promise<void> {
+    logger.info(`consuming topic ${this.CONTROL_TOPIC}`);
+    while (this.isRunning) {
+      let result: RedisStreamResponse | null;
+      try {
+        result = (await this.redis.xread(
+          "BLOCK",
+          BLOCK_TIMEOUT,
+          "STREAMS",
+          this.CONTROL_TOPIC,
+          this.lastId,
+        )) as RedisStreamResponse | null;
+      } catch (err) {
+        if (!this.isRunning) return;
+        logger.error(`error reading ${this.CONTROL_TOPIC}: ${err}`);
+        continue;
+      }
+      if (!result) continue;
+
+      for (const [, messages] of result) {
+        for (const [id, fields] of messages) {
+          this.lastId = id;
+          const message = this.extractMessage(fields);
+          if (!message) continue;
+
+          try {
+            await handler(message);
+          } catch (err) {
+            logger.error(`error processing control message ${id}: ${err}`);
+          }
+        }
+      }
+    }
+  }
+
+  private extractMessage(fields: string[]): WorkflowControlMessage | null {
+    const payload = fields.find((_, index) => fields[index - 1] === "payload");
+    if (!payload) {
+      logger.error("no payload found in control message");
+      return null;
+    }
+    try {
+      return JSON.parse(payload);
+    } catch (err) {
+      logger.error(`invalid control message: ${err}`);
+      return null;
+    }
+  }
+
+  async stop(): Promise<void> {
+    this.isRunning = false;
+    await this.close();
+  }
+
+  async close(): Promise<void> {
+    this.isRunning = false;
+    await this.redis.quit();
+  }
+}
@@ -7,6 +7,18 @@ export interface WorkflowMessage {
   inputs: Record<string, any>;
 }
 
+// WorkflowControlMessage targets a running workflow, the runner executing
+// the trigger applies it and the others ignore it. A human input answer
+// carries the node it resumes and its inputs.
+export interface WorkflowControlMessage {
+  type: "cancel" | "human_input";
+  workflow_id: string;
+  trigger_id: string;
+  project_id: string;
+  node_id?: string;
+  inputs?: Record<string, any>;
+}
+
 // format: [messageId, consumerName, idleTime, deliveryCount]
 export type PendingMessageInfo = [string, string, number, number];
 
@@ -17,3 +29,11 @@ export interface IQueueConsumer {
   ): Promise<void>;
   close(): Promise<void>;
 }
+
+export interface IControlConsumer {
+  initialize(): Promise<void>;
+  consumeControlStream(
+    handler: (message: WorkflowControlMessage) => Promise<void>
+  ): Promise<void>;
+  close(): Promise<void>;
+}
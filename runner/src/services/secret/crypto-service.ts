@@ -53,6 +53,23 @@ export class CryptoService {
       return Result.error(new CryptoError(`decryption failed`));
     }
   }
+
+  // decryptPayload decrypts the credentialPayload of a node, the JSON of
+  // all the fields of its credential.
+  decryptPayload(
+    encryptedPayload: string,
+  ): Result<Record<string, any>, CryptoError> {
+    const [decrypted, error] = this.decrypt(encryptedPayload).toTuple();
+    if (error) {
+      return Result.error(error);
+    }
+
+    try {
+      return Result.ok(JSON.parse(decrypted));
+    } catch {
+      return Result.error(new CryptoError("invalid credential payload"));
+    }
+  }
 }
 
 export class CryptoError extends Error {
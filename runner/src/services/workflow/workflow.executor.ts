@@ -1,6 +1,12 @@
 import { EventEmitter } from "events";
 import { Result } from "typescript-result";
-import { NodeDefinition, NodeInput, NodeOutput } from "../../nodes/types";
+import {
+  HumanInputRequest,
+  NodeDefinition,
+  NodeInput,
+  NodeOutput,
+  NodeUsage,
+} from "../../nodes/types";
 import { logger } from "../../utils/logger";
 import {
   ExecutionContext,
@@ -12,9 +18,42 @@ import { NodeManager } from "../node/node-manager";
 import { WorkflowEvent, WorkflowEvents, WorkflowEventType } from "../notifier";
 import { WorkflowDefinition, WorkflowExecutionOptions } from "./types";
 
+const PENDING_CANCELLATION_TTL = 10 * 60 * 1000; // ms
+
+export class WorkflowCancelledError extends Error {
+  constructor() {
+    super("workflow cancelled");
+    this.name = "WorkflowCancelledError";
+  }
+}
+
+// ExecutionTarget identifies the execution a control message applies to,
+// the trigger is only acted on when its workflow and project match.
+interface ExecutionTarget {
+  workflowId: string;
+  projectId: string;
+}
+
+interface RunningExecution extends ExecutionTarget {
+  controller: AbortController;
+}
+
+interface PendingCancellation extends ExecutionTarget {
+  timeout: NodeJS.Timeout;
+}
+
 export class WorkflowExecutor extends EventEmitter {
   private readonly nodeManager: NodeManager;
   private readonly contextService: IContextService;
+  // running holds the cancellation of the executions by trigger id,
+  // pending the triggers cancelled before any runner started them,
+  // humanInputs the answers awaited by trigger and node id.
+  private readonly running = new Map<string, RunningExecution>();
+  private readonly pending = new Map<string, PendingCancellation>();
+  private readonly humanInputs = new Map<
+    string,
+    (inputs: NodeInput) => void
+  >();
 
   constructor(nodeManager: NodeManager, contextService: IContextService) {
     super();
@@ -33,13 +72,25 @@ export class WorkflowExecutor extends EventEmitter {
       options,
     );
 
+    const controller = new AbortController();
+    const target = { workflowId, projectId: options.projectId };
+    this.running.set(options.triggerId, { controller, ...target });
+    const pending = this.pending.get(options.triggerId);
+    if (pending) {
+      clearTimeout(pending.timeout);
+      this.pending.delete(options.triggerId);
+      if (this.matches(pending, target)) {
+        controller.abort();
+      }
+    }
+
     try {
       await this.emitEvent(WorkflowEvents.WORKFLOW_STARTED, context, {
         inputs: context.get.workflowInputs,
       });
 
       const [output, outputError] = (
-        await this.executeWorkflow(context, definition)
+        await this.executeWorkflow(context, definition, controller.signal)
       ).toTuple();
       if (outputError) {
         throw outputError;
@@ -49,21 +100,85 @@ export class WorkflowExecutor extends EventEmitter {
         result: output,
       });
     } catch (error) {
+      if (error instanceof WorkflowCancelledError) {
+        logger.info(`workflow ${workflowId} cancelled`);
+        await this.emitEvent(WorkflowEvents.WORKFLOW_CANCELLED, context, {});
+        return;
+      }
+
       logger.error(`error executing workflow ${workflowId}: ${error}`);
       await this.emitEvent(WorkflowEvents.WORKFLOW_FAILED, context, {
         error: (error as Error).message,
       });
+    } finally {
+      this.running.delete(options.triggerId);
+    }
+  }
+
+  // cancel stops the execution of the trigger if this runner executes it,
+  // the nodes running are abandoned and their events dropped. A trigger not
+  // running yet is remembered for a while, in case it is still queued.
+  cancel(triggerId: string, workflowId: string, projectId: string): boolean {
+    const target = { workflowId, projectId };
+    const execution = this.running.get(triggerId);
+    if (!execution) {
+      clearTimeout(this.pending.get(triggerId)?.timeout);
+      const timeout = setTimeout(
+        () => this.pending.delete(triggerId),
+        PENDING_CANCELLATION_TTL,
+      );
+      timeout.unref();
+      this.pending.set(triggerId, { timeout, ...target });
+      return false;
     }
+    if (!this.matches(execution, target)) {
+      return false;
+    }
+    execution.controller.abort();
+    return true;
+  }
+
+  // answer resumes the human input node of the trigger if this runner
+  // executes it and the node is waiting for an answer.
+  answer(
+    triggerId: string,
+    workflowId: string,
+    projectId: string,
+    nodeId: string,
+    inputs: NodeInput,
+  ): boolean {
+    const execution = this.running.get(triggerId);
+    if (!execution || !this.matches(execution, { workflowId, projectId })) {
+      return false;
+    }
+    const resume = this.humanInputs.get(humanInputKey(triggerId, nodeId));
+    if (!resume) {
+      return false;
+    }
+    resume(inputs);
+    return true;
+  }
+
+  private matches(execution: ExecutionTarget, target: ExecutionTarget) {
+    return (
+      execution.workflowId === target.workflowId &&
+      execution.projectId === target.projectId
+    );
   }
 
   private async executeWorkflow(
     managedContext: ManagedExecutionContext,
     definition: WorkflowDefinition,
+    signal: AbortSignal,
   ): Promise<Result<Record<string, any> | null, Error>> {
     const { dependencies } = this.buildDependencyGraph(definition);
     while (
       managedContext.get.completedNodes.size < managedContext.get.allNodes.size
     ) {
+      if (signal.aborted) {
+        return Result.error(new WorkflowCancelledError());
+      }
+
       // find nodes ready to execute (all dependencies completed)
       const readyNodes = managedContext.findReadyNodes(dependencies);
 
@@ -89,7 +204,12 @@ export class WorkflowExecutor extends EventEmitter {
         managedContext,
         readyNodes,
         definition,
+        signal,
       );
+      if (signal.aborted) {
+        return Result.error(new WorkflowCancelledError());
+      }
+
       const errors = results.filter((r) => !r.success);
       if (errors.length > 0) {
         return Result.error(
@@ -141,6 +261,7 @@ export class WorkflowExecutor extends EventEmitter {
     managedContext: ManagedExecutionContext,
     readyNodes: string[],
     definition: WorkflowDefinition,
+    signal: AbortSignal,
   ): Promise<Array<{ nodeId: string; success: boolean; error?: any }>> {
     const nodePromises = readyNodes.map(async (nodeId) => {
       const node = definition.nodes[nodeId];
@@ -154,7 +275,7 @@ export class WorkflowExecutor extends EventEmitter {
 
       const inputs = managedContext.resolveInputs(nodeId, node);
       const [output, outputError] = (
-        await this.executeNode(nodeId, node, inputs, managedContext)
+        await this.executeNode(nodeId, node, inputs, managedContext, signal)
       ).toTuple();
 
       const success = outputError ? false : true;
@@ -179,6 +300,7 @@ export class WorkflowExecutor extends EventEmitter {
     node: NodeDefinition,
     inputs: NodeInput,
     managedContext: ManagedExecutionContext,
+    signal: AbortSignal,
   ): Promise<Result<NodeOutput, Error>> {
     await this.emitEvent(WorkflowEvents.NODE_STARTED, managedContext, {
       nodeId,
@@ -186,23 +308,46 @@ export class WorkflowExecutor extends EventEmitter {
       inputs,
     });
 
+    let usage: NodeUsage | undefined;
+    const execution = this.nodeManager.executeNode(nodeId, node, inputs, {
+      sessionId: managedContext.get.sessionId,
+      onUsage: (reported) => {
+        usage = {
+          model: reported.model,
+          inputTokens: (usage?.inputTokens ?? 0) + reported.inputTokens,
+          outputTokens: (usage?.outputTokens ?? 0) + reported.outputTokens,
+        };
+      },
+      onEvent: async (type, data) => {
+        // the execution is over once cancelled
+        if (signal.aborted) {
+          return;
+        }
+        const { nodeId: eventNodeId, ...eventData } = data;
+        await this.emitEvent(type, managedContext, {
+          nodeId: eventNodeId ?? nodeId,
+          nodeType: node.type,
+          ...eventData,
+        } as unknown as Omit<
+          WorkflowEvent<typeof type>,
+          "type" | "workflowId" | "projectId" | "triggerId" | "sessionId"
+        >);
+      },
+      requestHumanInput: (request) =>
+        this.requestHumanInput(nodeId, node, request, managedContext, signal),
+    });
+
+    // a node abandoned on cancellation may still fail, unobserved
+    execution.catch(() => undefined);
+
     const [output, outputError] = (
-      await this.nodeManager.executeNode(nodeId, node, inputs, {
-        sessionId: managedContext.get.sessionId,
-        onEvent: async (type, data) => {
-          const { nodeId: eventNodeId, ...eventData } = data;
-          await this.emitEvent(type, managedContext, {
-            nodeId: eventNodeId ?? nodeId,
-            nodeType: node.type,
-            ...eventData,
-          } as unknown as Omit<
-            WorkflowEvent<typeof type>,
-            "type" | "workflowId" | "triggerId" | "sessionId"
-          >);
-        },
-      })
+      await Promise.race([execution, this.cancelled(signal)])
     ).toTuple();
 
+    if (signal.aborted) {
+      return Result.error(new WorkflowCancelledError());
+    }
+
     if (outputError) {
       await this.emitEvent(WorkflowEvents.NODE_FAILED, managedContext, {
         nodeId,
@@ -217,10 +362,69 @@ export class WorkflowExecutor extends EventEmitter {
       nodeType: node.type,
       inputs,
       output,
+      usage,
     });
     return Result.ok(output);
   }
 
+  // requestHumanInput waits for the answer relayed by the control stream,
+  // the waiter is set before the event is emitted so no answer is missed.
+  private async requestHumanInput(
+    nodeId: string,
+    node: NodeDefinition,
+    request: HumanInputRequest,
+    managedContext: ManagedExecutionContext,
+    signal: AbortSignal,
+  ): Promise<Result<NodeInput, Error>> {
+    if (signal.aborted) {
+      return Result.error(new WorkflowCancelledError());
+    }
+
+    const key = humanInputKey(managedContext.get.triggerId, nodeId);
+    const answer = new Promise<Result<NodeInput, Error>>((resolve) => {
+      const done = (result: Result<NodeInput, Error>) => {
+        clearTimeout(timeout);
+        signal.removeEventListener("abort", abort);
+        this.humanInputs.delete(key);
+        resolve(result);
+      };
+      const abort = () => done(Result.error(new WorkflowCancelledError()));
+      const timeout = setTimeout(
+        () =>
+          done(
+            Result.error(
+              new Error(
+                `no human input received within ${request.timeoutSeconds}s`,
+              ),
+            ),
+          ),
+        request.timeoutSeconds * 1000,
+      );
+
+      signal.addEventListener("abort", abort, { once: true });
+      this.humanInputs.set(key, (inputs) => done(Result.ok(inputs)));
+    });
+
+    await this.emitEvent(WorkflowEvents.HUMAN_INPUT_REQUESTED, managedContext, {
+      nodeId,
+      nodeType: node.type,
+      ...request,
+    });
+
+    return answer;
+  }
+
+  private cancelled(signal: AbortSignal): Promise<Result<NodeOutput, Error>> {
+    return new Promise((resolve) => {
+      const cancel = () => resolve(Result.error(new WorkflowCancelledError()));
+      if (signal.aborted) {
+        cancel();
+        return;
+      }
+      signal.addEventListener("abort", cancel, { once: true });
+    });
+  }
+
   private buildDependencyGraph(definition: WorkflowDefinition): {
     dependencies: Record<string, string[]>;
   } {
@@ -278,6 +482,7 @@ export class WorkflowExecutor extends EventEmitter {
   private createBaseEventData(context: ExecutionContext) {
     return {
       workflowId: context.workflowId,
+      projectId: context.projectId,
       triggerId: context.triggerId,
       sessionId: context.sessionId,
     };
@@ -288,7 +493,7 @@ export class WorkflowExecutor extends EventEmitter {
     context: ManagedExecutionContext,
     eventData: Omit<
       WorkflowEvent<T>,
-      "type" | "workflowId" | "triggerId" | "sessionId"
+      "type" | "workflowId" | "projectId" | "triggerId" | "sessionId"
     >,
   ): Promise<void> {
     const baseEvent = {
@@ -305,7 +510,8 @@ export class WorkflowExecutor extends EventEmitter {
       eventType === WorkflowEvents.TOOL_FAILED ||
       eventType === WorkflowEvents.NODE_RESULT ||
       eventType === WorkflowEvents.NODE_LOG ||
-      eventType === WorkflowEvents.AGENT_NOTIFICATION;
+      eventType === WorkflowEvents.AGENT_NOTIFICATION ||
+      eventType === WorkflowEvents.HUMAN_INPUT_REQUESTED;
 
     // Extract common fields
     const { nodeId, nodeType, ...specificData } = eventData as any;
@@ -336,3 +542,7 @@ export class WorkflowExecutor extends EventEmitter {
     return super.on(event, listener);
   }
 }
+
+function humanInputKey(triggerId: string, nodeId: string): string {
+  return `${triggerId}:${nodeId}`;
+}
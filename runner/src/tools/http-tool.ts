@@ -1,6 +1,7 @@
 import { Result } from "typescript-result";
 import { z } from "zod";
 import { NodeOptions } from "../nodes/types";
+import { CryptoService } from "../services/secret/crypto-service";
 import { logger } from "../utils/logger";
 import { Http, Tool, ToolOutput } from "./tool.interface";
 
@@ -30,6 +31,22 @@ export class HttpTool implements Tool<"http_request"> {
     this.url = definition.config.url;
     this.headers = definition.config.headers;
 
+    // the credential holds the base URL the url is resolved against and
+    // the headers sent along with those of the tool
+    if (definition.config.credentialPayload) {
+      const [payload, payloadError] = new CryptoService()
+        .decryptPayload(definition.config.credentialPayload)
+        .toTuple();
+      if (payloadError) {
+        throw payloadError;
+      }
+
+      this.url = this.url
+        ? new URL(this.url, payload.baseUrl).toString()
+        : payload.baseUrl;
+      this.headers = { ...payload.headers, ...this.headers };
+    }
+
     if (!this.url) {
       throw new Error("URL is required");
     }
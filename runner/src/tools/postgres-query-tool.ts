@@ -1,4 +1,4 @@
-import { Client } from "pg";
+import { Client, ClientConfig } from "pg";
 import { Result } from "typescript-result";
 import { z } from "zod";
 import { NodeOptions } from "../nodes/types";
@@ -46,7 +46,7 @@ export class PostgresQueryTool implements Tool<"postgres-query-tool"> {
   readonly type = "postgres-query-tool";
   readonly id: string;
   private cryptoService: CryptoService;
-  private databaseUrl: string;
+  private connection: ClientConfig;
 
   readonly name: string;
   readonly description: string;
@@ -85,18 +85,49 @@ export class PostgresQueryTool implements Tool<"postgres-query-tool"> {
 
     this.cryptoService = new CryptoService();
 
-    if (!definition.config.apiKey) {
-      throw new Error("Api Key (aka Database URL) is required");
+    this.connection = this.connectionConfig(definition);
+  }
+
+  // connectionConfig reads the database URL of the credential, or else
+  // its host, user and password fields.
+  private connectionConfig(definition: PostgresQuery): ClientConfig {
+    if (definition.config.apiKey) {
+      const [databaseUrl, databaseUrlError] = this.cryptoService
+        .decrypt(definition.config.apiKey)
+        .toTuple();
+      if (databaseUrlError) {
+        throw databaseUrlError;
+      }
+      return { connectionString: databaseUrl, ssl: false };
     }
 
-    const [decryptedDatabaseUrlResult, decryptedDatabaseUrlError] =
-      this.cryptoService.decrypt(definition.config.apiKey).toTuple();
+    if (!definition.config.credentialPayload) {
+      throw new Error("a database URL or host is required");
+    }
 
-    if (decryptedDatabaseUrlError) {
-      throw decryptedDatabaseUrlError;
+    const [payload, payloadError] = this.cryptoService
+      .decryptPayload(definition.config.credentialPayload)
+      .toTuple();
+    if (payloadError) {
+      throw payloadError;
     }
 
-    this.databaseUrl = decryptedDatabaseUrlResult;
+    if (!payload.host) {
+      throw new Error("a database URL or host is required");
+    }
+
+    const sslMode = payload.sslMode ?? "disable";
+    return {
+      host: payload.host,
+      port: payload.port,
+      database: payload.database,
+      user: payload.user,
+      password: payload.password,
+      ssl:
+        sslMode === "disable"
+          ? false
+          : { rejectUnauthorized: sslMode === "verify-full" },
+    };
   }
 
   async run(
@@ -115,15 +146,7 @@ export class PostgresQueryTool implements Tool<"postgres-query-tool"> {
         nodeId: this.id,
       });
 
-      logger.debug("DATABASE URL", this.databaseUrl);
-      console.log("DB URL", this.databaseUrl);
-
-      console.log("PARAMS", params);
-
-      const client = new Client({
-        connectionString: this.databaseUrl,
-        ssl: false,
-      });
+      const client = new Client(this.connection);
 
       try {
         await client.connect();
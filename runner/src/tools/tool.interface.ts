@@ -38,6 +38,7 @@ export interface Http extends Base {
   config: {
     headers: Record<string, string>;
     url: string;
+    credentialPayload?: string;
   };
 }
 
@@ -65,6 +66,7 @@ export interface PostgresQuery extends Base {
   config: {
     query: string;
     apiKey: string;
+    credentialPayload?: string;
     variables: { name: string; description: string }[];
   };
 }